@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	cosmosevmtypes "github.com/cosmos/evm/ante/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -25,7 +26,7 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
+func NewDynamicFeeChecker(ek anteinterfaces.EVMKeeper, feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -38,7 +39,7 @@ func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFee
 			return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 		}
 		denom := evmtypes.GetEVMCoinDenom()
-		ethCfg := evmtypes.GetEthChainConfig()
+		ethCfg := ek.GetEthChainConfig(ctx)
 
 		return FeeChecker(ctx, feemarketParams, denom, ethCfg, feeTx)
 	}
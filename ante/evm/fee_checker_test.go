@@ -258,7 +258,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 				cfg.LondonBlock = big.NewInt(0)
 			}
 			feemarketParams := tc.feemarketParamsFn()
-			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(NewExtendedEVMKeeper(), &feemarketParams)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
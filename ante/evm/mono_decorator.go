@@ -5,10 +5,10 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/txpool"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/mempool/txpool"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -112,13 +112,14 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		Difficulty: big.NewInt(0),
 	}
 
-	chainConfig := evmtypes.GetEthChainConfig()
+	chainConfig := md.evmKeeper.GetEthChainConfig(ctx)
 
 	if err := txpool.ValidateTransaction(ethTx, &header, decUtils.Signer, &txpool.ValidationOptions{
-		Config:  chainConfig,
-		Accept:  AcceptedTxType,
-		MaxSize: math.MaxUint64, // tx size is checked in cometbft
-		MinTip:  new(big.Int),
+		Config:          chainConfig,
+		Accept:          AcceptedTxType,
+		MaxSize:         math.MaxUint64, // tx size is checked in cometbft
+		MaxInitCodeSize: decUtils.EvmParams.InitCodeSizeLimit(),
+		MinTip:          new(big.Int),
 	}); err != nil {
 		return ctx, err
 	}
@@ -46,7 +46,7 @@ func NewMonoDecoratorUtils(
 	evmParams *evmtypes.Params,
 	feemarketParams *feemarkettypes.Params,
 ) (*DecoratorUtils, error) {
-	ethCfg := evmtypes.GetEthChainConfig()
+	ethCfg := ek.GetEthChainConfig(ctx)
 	evmDenom := evmtypes.GetEVMCoinDenom()
 	blockHeight := big.NewInt(ctx.BlockHeight())
 	rules := ethCfg.Rules(blockHeight, true, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
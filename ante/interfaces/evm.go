@@ -5,6 +5,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -24,6 +25,7 @@ type EVMKeeper interface {
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	SpendableCoin(ctx sdk.Context, addr common.Address) *uint256.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
+	GetEthChainConfig(ctx sdk.Context) *params.ChainConfig
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
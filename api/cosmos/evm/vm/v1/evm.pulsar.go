@@ -152,6 +152,57 @@ func (x *_Params_9_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*OpcodeGasOverride
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OpcodeGasOverride)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OpcodeGasOverride)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(OpcodeGasOverride)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(OpcodeGasOverride)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                           protoreflect.MessageDescriptor
 	fd_Params_evm_denom                 protoreflect.FieldDescriptor
@@ -161,6 +212,10 @@ var (
 	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
 	fd_Params_history_serve_window      protoreflect.FieldDescriptor
 	fd_Params_extended_denom_options    protoreflect.FieldDescriptor
+	fd_Params_opcode_gas_overrides      protoreflect.FieldDescriptor
+	fd_Params_max_code_size             protoreflect.FieldDescriptor
+	fd_Params_max_init_code_size        protoreflect.FieldDescriptor
+	fd_Params_refund_quotient           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -173,6 +228,10 @@ func init() {
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
 	fd_Params_history_serve_window = md_Params.Fields().ByName("history_serve_window")
 	fd_Params_extended_denom_options = md_Params.Fields().ByName("extended_denom_options")
+	fd_Params_opcode_gas_overrides = md_Params.Fields().ByName("opcode_gas_overrides")
+	fd_Params_max_code_size = md_Params.Fields().ByName("max_code_size")
+	fd_Params_max_init_code_size = md_Params.Fields().ByName("max_init_code_size")
+	fd_Params_refund_quotient = md_Params.Fields().ByName("refund_quotient")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -282,6 +341,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.OpcodeGasOverrides) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.OpcodeGasOverrides})
+		if !f(fd_Params_opcode_gas_overrides, value) {
+			return
+		}
+	}
+	if x.MaxCodeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCodeSize)
+		if !f(fd_Params_max_code_size, value) {
+			return
+		}
+	}
+	if x.MaxInitCodeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxInitCodeSize)
+		if !f(fd_Params_max_init_code_size, value) {
+			return
+		}
+	}
+	if x.RefundQuotient != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RefundQuotient)
+		if !f(fd_Params_refund_quotient, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -311,6 +394,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.HistoryServeWindow != uint64(0)
 	case "cosmos.evm.vm.v1.Params.extended_denom_options":
 		return x.ExtendedDenomOptions != nil
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		return len(x.OpcodeGasOverrides) != 0
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		return x.MaxCodeSize != uint64(0)
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		return x.MaxInitCodeSize != uint64(0)
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		return x.RefundQuotient != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -341,6 +432,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.HistoryServeWindow = uint64(0)
 	case "cosmos.evm.vm.v1.Params.extended_denom_options":
 		x.ExtendedDenomOptions = nil
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		x.OpcodeGasOverrides = nil
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		x.MaxCodeSize = uint64(0)
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		x.MaxInitCodeSize = uint64(0)
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		x.RefundQuotient = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -387,6 +486,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.evm.vm.v1.Params.extended_denom_options":
 		value := x.ExtendedDenomOptions
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		if len(x.OpcodeGasOverrides) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.OpcodeGasOverrides}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		value := x.MaxCodeSize
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		value := x.MaxInitCodeSize
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		value := x.RefundQuotient
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -427,6 +541,16 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.HistoryServeWindow = value.Uint()
 	case "cosmos.evm.vm.v1.Params.extended_denom_options":
 		x.ExtendedDenomOptions = value.Message().Interface().(*ExtendedDenomOptions)
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.OpcodeGasOverrides = *clv.list
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		x.MaxCodeSize = value.Uint()
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		x.MaxInitCodeSize = value.Uint()
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		x.RefundQuotient = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -475,10 +599,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.ExtendedDenomOptions = new(ExtendedDenomOptions)
 		}
 		return protoreflect.ValueOfMessage(x.ExtendedDenomOptions.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		if x.OpcodeGasOverrides == nil {
+			x.OpcodeGasOverrides = []*OpcodeGasOverride{}
+		}
+		value := &_Params_12_list{list: &x.OpcodeGasOverrides}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.evm.vm.v1.Params.evm_denom":
 		panic(fmt.Errorf("field evm_denom of message cosmos.evm.vm.v1.Params is not mutable"))
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		panic(fmt.Errorf("field history_serve_window of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		panic(fmt.Errorf("field max_code_size of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		panic(fmt.Errorf("field max_init_code_size of message cosmos.evm.vm.v1.Params is not mutable"))
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		panic(fmt.Errorf("field refund_quotient of message cosmos.evm.vm.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -511,6 +647,15 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cosmos.evm.vm.v1.Params.extended_denom_options":
 		m := new(ExtendedDenomOptions)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.opcode_gas_overrides":
+		list := []*OpcodeGasOverride{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.max_code_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.max_init_code_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.refund_quotient":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -614,6 +759,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.ExtendedDenomOptions)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.OpcodeGasOverrides) > 0 {
+			for _, e := range x.OpcodeGasOverrides {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxCodeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCodeSize))
+		}
+		if x.MaxInitCodeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxInitCodeSize))
+		}
+		if x.RefundQuotient != 0 {
+			n += 1 + runtime.Sov(uint64(x.RefundQuotient))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -643,6 +803,37 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RefundQuotient != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RefundQuotient))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxInitCodeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxInitCodeSize))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.MaxCodeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCodeSize))
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.OpcodeGasOverrides) > 0 {
+			for iNdEx := len(x.OpcodeGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OpcodeGasOverrides[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if x.ExtendedDenomOptions != nil {
 			encoded, err := options.Marshal(x.ExtendedDenomOptions)
 			if err != nil {
@@ -1034,6 +1225,97 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OpcodeGasOverrides = append(x.OpcodeGasOverrides, &OpcodeGasOverride{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OpcodeGasOverrides[len(x.OpcodeGasOverrides)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+				}
+				x.MaxCodeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCodeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+				}
+				x.MaxInitCodeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxInitCodeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
+				}
+				x.RefundQuotient = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RefundQuotient |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1070,25 +1352,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ExtendedDenomOptions                protoreflect.MessageDescriptor
-	fd_ExtendedDenomOptions_extended_denom protoreflect.FieldDescriptor
+	md_OpcodeGasOverride              protoreflect.MessageDescriptor
+	fd_OpcodeGasOverride_opcode       protoreflect.FieldDescriptor
+	fd_OpcodeGasOverride_constant_gas protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_ExtendedDenomOptions = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ExtendedDenomOptions")
-	fd_ExtendedDenomOptions_extended_denom = md_ExtendedDenomOptions.Fields().ByName("extended_denom")
+	md_OpcodeGasOverride = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("OpcodeGasOverride")
+	fd_OpcodeGasOverride_opcode = md_OpcodeGasOverride.Fields().ByName("opcode")
+	fd_OpcodeGasOverride_constant_gas = md_OpcodeGasOverride.Fields().ByName("constant_gas")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExtendedDenomOptions)(nil)
+var _ protoreflect.Message = (*fastReflection_OpcodeGasOverride)(nil)
 
-type fastReflection_ExtendedDenomOptions ExtendedDenomOptions
+type fastReflection_OpcodeGasOverride OpcodeGasOverride
 
-func (x *ExtendedDenomOptions) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExtendedDenomOptions)(x)
+func (x *OpcodeGasOverride) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OpcodeGasOverride)(x)
 }
 
-func (x *ExtendedDenomOptions) slowProtoReflect() protoreflect.Message {
+func (x *OpcodeGasOverride) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1100,43 +1384,43 @@ func (x *ExtendedDenomOptions) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExtendedDenomOptions_messageType fastReflection_ExtendedDenomOptions_messageType
-var _ protoreflect.MessageType = fastReflection_ExtendedDenomOptions_messageType{}
+var _fastReflection_OpcodeGasOverride_messageType fastReflection_OpcodeGasOverride_messageType
+var _ protoreflect.MessageType = fastReflection_OpcodeGasOverride_messageType{}
 
-type fastReflection_ExtendedDenomOptions_messageType struct{}
+type fastReflection_OpcodeGasOverride_messageType struct{}
 
-func (x fastReflection_ExtendedDenomOptions_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExtendedDenomOptions)(nil)
+func (x fastReflection_OpcodeGasOverride_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OpcodeGasOverride)(nil)
 }
-func (x fastReflection_ExtendedDenomOptions_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExtendedDenomOptions)
+func (x fastReflection_OpcodeGasOverride_messageType) New() protoreflect.Message {
+	return new(fastReflection_OpcodeGasOverride)
 }
-func (x fastReflection_ExtendedDenomOptions_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExtendedDenomOptions
+func (x fastReflection_OpcodeGasOverride_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OpcodeGasOverride
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExtendedDenomOptions) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExtendedDenomOptions
+func (x *fastReflection_OpcodeGasOverride) Descriptor() protoreflect.MessageDescriptor {
+	return md_OpcodeGasOverride
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExtendedDenomOptions) Type() protoreflect.MessageType {
-	return _fastReflection_ExtendedDenomOptions_messageType
+func (x *fastReflection_OpcodeGasOverride) Type() protoreflect.MessageType {
+	return _fastReflection_OpcodeGasOverride_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExtendedDenomOptions) New() protoreflect.Message {
-	return new(fastReflection_ExtendedDenomOptions)
+func (x *fastReflection_OpcodeGasOverride) New() protoreflect.Message {
+	return new(fastReflection_OpcodeGasOverride)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExtendedDenomOptions) Interface() protoreflect.ProtoMessage {
-	return (*ExtendedDenomOptions)(x)
+func (x *fastReflection_OpcodeGasOverride) Interface() protoreflect.ProtoMessage {
+	return (*OpcodeGasOverride)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1144,10 +1428,16 @@ func (x *fastReflection_ExtendedDenomOptions) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExtendedDenomOptions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ExtendedDenom != "" {
-		value := protoreflect.ValueOfString(x.ExtendedDenom)
-		if !f(fd_ExtendedDenomOptions_extended_denom, value) {
+func (x *fastReflection_OpcodeGasOverride) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Opcode != "" {
+		value := protoreflect.ValueOfString(x.Opcode)
+		if !f(fd_OpcodeGasOverride_opcode, value) {
+			return
+		}
+	}
+	if x.ConstantGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ConstantGas)
+		if !f(fd_OpcodeGasOverride_constant_gas, value) {
 			return
 		}
 	}
@@ -1164,15 +1454,17 @@ func (x *fastReflection_ExtendedDenomOptions) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExtendedDenomOptions) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OpcodeGasOverride) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
-		return x.ExtendedDenom != ""
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
+		return x.Opcode != ""
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		return x.ConstantGas != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1182,15 +1474,17 @@ func (x *fastReflection_ExtendedDenomOptions) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExtendedDenomOptions) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OpcodeGasOverride) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
-		x.ExtendedDenom = ""
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
+		x.Opcode = ""
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		x.ConstantGas = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1200,16 +1494,19 @@ func (x *fastReflection_ExtendedDenomOptions) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExtendedDenomOptions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OpcodeGasOverride) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
-		value := x.ExtendedDenom
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
+		value := x.Opcode
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		value := x.ConstantGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1223,15 +1520,17 @@ func (x *fastReflection_ExtendedDenomOptions) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExtendedDenomOptions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OpcodeGasOverride) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
-		x.ExtendedDenom = value.Interface().(string)
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
+		x.Opcode = value.Interface().(string)
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		x.ConstantGas = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1245,40 +1544,44 @@ func (x *fastReflection_ExtendedDenomOptions) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExtendedDenomOptions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OpcodeGasOverride) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
-		panic(fmt.Errorf("field extended_denom of message cosmos.evm.vm.v1.ExtendedDenomOptions is not mutable"))
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
+		panic(fmt.Errorf("field opcode of message cosmos.evm.vm.v1.OpcodeGasOverride is not mutable"))
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		panic(fmt.Errorf("field constant_gas of message cosmos.evm.vm.v1.OpcodeGasOverride is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExtendedDenomOptions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OpcodeGasOverride) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.opcode":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.OpcodeGasOverride.constant_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.OpcodeGasOverride"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.OpcodeGasOverride does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExtendedDenomOptions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OpcodeGasOverride) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ExtendedDenomOptions", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.OpcodeGasOverride", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1286,7 +1589,7 @@ func (x *fastReflection_ExtendedDenomOptions) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExtendedDenomOptions) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OpcodeGasOverride) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1297,7 +1600,7 @@ func (x *fastReflection_ExtendedDenomOptions) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExtendedDenomOptions) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OpcodeGasOverride) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1309,7 +1612,7 @@ func (x *fastReflection_ExtendedDenomOptions) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExtendedDenomOptions) IsValid() bool {
+func (x *fastReflection_OpcodeGasOverride) IsValid() bool {
 	return x != nil
 }
 
@@ -1319,9 +1622,9 @@ func (x *fastReflection_ExtendedDenomOptions) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OpcodeGasOverride) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExtendedDenomOptions)
+		x := input.Message.Interface().(*OpcodeGasOverride)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1333,10 +1636,13 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.ExtendedDenom)
+		l = len(x.Opcode)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ConstantGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ConstantGas))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1347,7 +1653,7 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExtendedDenomOptions)
+		x := input.Message.Interface().(*OpcodeGasOverride)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1366,10 +1672,15 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ExtendedDenom) > 0 {
-			i -= len(x.ExtendedDenom)
-			copy(dAtA[i:], x.ExtendedDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedDenom)))
+		if x.ConstantGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConstantGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Opcode) > 0 {
+			i -= len(x.Opcode)
+			copy(dAtA[i:], x.Opcode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Opcode)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1384,7 +1695,7 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExtendedDenomOptions)
+		x := input.Message.Interface().(*OpcodeGasOverride)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1416,15 +1727,15 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtendedDenomOptions: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OpcodeGasOverride: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtendedDenomOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OpcodeGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1452,16 +1763,35 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+				x.Opcode = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+				}
+				x.ConstantGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConstantGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -1490,27 +1820,25 @@ func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_AccessControl        protoreflect.MessageDescriptor
-	fd_AccessControl_create protoreflect.FieldDescriptor
-	fd_AccessControl_call   protoreflect.FieldDescriptor
+	md_ExtendedDenomOptions                protoreflect.MessageDescriptor
+	fd_ExtendedDenomOptions_extended_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
-	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
-	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+	md_ExtendedDenomOptions = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ExtendedDenomOptions")
+	fd_ExtendedDenomOptions_extended_denom = md_ExtendedDenomOptions.Fields().ByName("extended_denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
+var _ protoreflect.Message = (*fastReflection_ExtendedDenomOptions)(nil)
 
-type fastReflection_AccessControl AccessControl
+type fastReflection_ExtendedDenomOptions ExtendedDenomOptions
 
-func (x *AccessControl) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControl)(x)
+func (x *ExtendedDenomOptions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExtendedDenomOptions)(x)
 }
 
-func (x *AccessControl) slowProtoReflect() protoreflect.Message {
+func (x *ExtendedDenomOptions) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1522,43 +1850,43 @@ func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
+var _fastReflection_ExtendedDenomOptions_messageType fastReflection_ExtendedDenomOptions_messageType
+var _ protoreflect.MessageType = fastReflection_ExtendedDenomOptions_messageType{}
 
-type fastReflection_AccessControl_messageType struct{}
+type fastReflection_ExtendedDenomOptions_messageType struct{}
 
-func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControl)(nil)
+func (x fastReflection_ExtendedDenomOptions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExtendedDenomOptions)(nil)
 }
-func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x fastReflection_ExtendedDenomOptions_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExtendedDenomOptions)
 }
-func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x fastReflection_ExtendedDenomOptions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExtendedDenomOptions
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x *fastReflection_ExtendedDenomOptions) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExtendedDenomOptions
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControl_messageType
+func (x *fastReflection_ExtendedDenomOptions) Type() protoreflect.MessageType {
+	return _fastReflection_ExtendedDenomOptions_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControl) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x *fastReflection_ExtendedDenomOptions) New() protoreflect.Message {
+	return new(fastReflection_ExtendedDenomOptions)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
-	return (*AccessControl)(x)
+func (x *fastReflection_ExtendedDenomOptions) Interface() protoreflect.ProtoMessage {
+	return (*ExtendedDenomOptions)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1566,16 +1894,10 @@ func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Create != nil {
-		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-		if !f(fd_AccessControl_create, value) {
-			return
-		}
-	}
-	if x.Call != nil {
-		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
-		if !f(fd_AccessControl_call, value) {
+func (x *fastReflection_ExtendedDenomOptions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExtendedDenom != "" {
+		value := protoreflect.ValueOfString(x.ExtendedDenom)
+		if !f(fd_ExtendedDenomOptions_extended_denom, value) {
 			return
 		}
 	}
@@ -1592,17 +1914,15 @@ func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExtendedDenomOptions) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		return x.Create != nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		return x.Call != nil
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		return x.ExtendedDenom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1612,17 +1932,15 @@ func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExtendedDenomOptions) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = nil
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		x.ExtendedDenom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1632,19 +1950,16 @@ func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExtendedDenomOptions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		value := x.Create
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		value := x.Call
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		value := x.ExtendedDenom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1658,17 +1973,15 @@ func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ExtendedDenomOptions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = value.Message().Interface().(*AccessControlType)
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		x.ExtendedDenom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1682,52 +1995,40 @@ func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExtendedDenomOptions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		if x.Create == nil {
-			x.Create = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		if x.Call == nil {
-			x.Call = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		panic(fmt.Errorf("field extended_denom of message cosmos.evm.vm.v1.ExtendedDenomOptions is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExtendedDenomOptions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.ExtendedDenomOptions.extended_denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ExtendedDenomOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ExtendedDenomOptions does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExtendedDenomOptions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ExtendedDenomOptions", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1735,7 +2036,7 @@ func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExtendedDenomOptions) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1746,7 +2047,7 @@ func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExtendedDenomOptions) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1758,7 +2059,7 @@ func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControl) IsValid() bool {
+func (x *fastReflection_ExtendedDenomOptions) IsValid() bool {
 	return x != nil
 }
 
@@ -1768,9 +2069,9 @@ func (x *fastReflection_AccessControl) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExtendedDenomOptions) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ExtendedDenomOptions)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1782,12 +2083,8 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Create != nil {
-			l = options.Size(x.Create)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Call != nil {
-			l = options.Size(x.Call)
+		l = len(x.ExtendedDenom)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -1800,7 +2097,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ExtendedDenomOptions)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1819,31 +2116,10 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Call != nil {
-			encoded, err := options.Marshal(x.Call)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Create != nil {
-			encoded, err := options.Marshal(x.Create)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.ExtendedDenom) > 0 {
+			i -= len(x.ExtendedDenom)
+			copy(dAtA[i:], x.ExtendedDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExtendedDenom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1858,7 +2134,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*ExtendedDenomOptions)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1890,17 +2166,17 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtendedDenomOptions: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExtendedDenomOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1910,72 +2186,32 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Create == nil {
-					x.Create = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
+				x.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Call == nil {
-					x.Call = &AccessControlType{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -2003,74 +2239,81 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
+var _ protoreflect.List = (*_AccessControl_3_list)(nil)
 
-type _AccessControlType_2_list struct {
-	list *[]string
+type _AccessControl_3_list struct {
+	list *[]*ContractAccessControl
 }
 
-func (x *_AccessControlType_2_list) Len() int {
+func (x *_AccessControl_3_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_AccessControl_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_AccessControl_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractAccessControl)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_AccessControl_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractAccessControl)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
+func (x *_AccessControl_3_list) AppendMutable() protoreflect.Value {
+	v := new(ContractAccessControl)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_AccessControlType_2_list) Truncate(n int) {
+func (x *_AccessControl_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_AccessControl_3_list) NewElement() protoreflect.Value {
+	v := new(ContractAccessControl)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_AccessControlType_2_list) IsValid() bool {
+func (x *_AccessControl_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_AccessControlType                     protoreflect.MessageDescriptor
-	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
-	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+	md_AccessControl           protoreflect.MessageDescriptor
+	fd_AccessControl_create    protoreflect.FieldDescriptor
+	fd_AccessControl_call      protoreflect.FieldDescriptor
+	fd_AccessControl_contracts protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
-	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
-	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
+	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
+	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+	fd_AccessControl_contracts = md_AccessControl.Fields().ByName("contracts")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
 
-type fastReflection_AccessControlType AccessControlType
+type fastReflection_AccessControl AccessControl
 
-func (x *AccessControlType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(x)
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControl)(x)
 }
 
-func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2082,43 +2325,43 @@ func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
 
-type fastReflection_AccessControlType_messageType struct{}
+type fastReflection_AccessControl_messageType struct{}
 
-func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(nil)
+func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControl)(nil)
 }
-func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
-func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControlType_messageType
+func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControl_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControlType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x *fastReflection_AccessControl) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
-	return (*AccessControlType)(x)
+func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
+	return (*AccessControl)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2126,16 +2369,22 @@ func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AccessType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
-		if !f(fd_AccessControlType_access_type, value) {
+func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Create != nil {
+		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+		if !f(fd_AccessControl_create, value) {
 			return
 		}
 	}
-	if len(x.AccessControlList) != 0 {
-		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
-		if !f(fd_AccessControlType_access_control_list, value) {
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_AccessControl_call, value) {
+			return
+		}
+	}
+	if len(x.Contracts) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControl_3_list{list: &x.Contracts})
+		if !f(fd_AccessControl_contracts, value) {
 			return
 		}
 	}
@@ -2152,17 +2401,19 @@ func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return x.AccessType != 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		return len(x.AccessControlList) != 0
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		return x.Create != nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		return x.Call != nil
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
+		return len(x.Contracts) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2172,17 +2423,19 @@ func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		x.AccessControlList = nil
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = nil
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
+		x.Contracts = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2192,22 +2445,25 @@ func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		value := x.AccessType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if len(x.AccessControlList) == 0 {
-			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		value := x.Create
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
+		if len(x.Contracts) == 0 {
+			return protoreflect.ValueOfList(&_AccessControl_3_list{})
 		}
-		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
+		listValue := &_AccessControl_3_list{list: &x.Contracts}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2221,19 +2477,21 @@ func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = (AccessType)(value.Enum())
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
 		lv := value.List()
-		clv := lv.(*_AccessControlType_2_list)
-		x.AccessControlList = *clv.list
+		clv := lv.(*_AccessControl_3_list)
+		x.Contracts = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2247,49 +2505,61 @@ func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if x.AccessControlList == nil {
-			x.AccessControlList = []string{}
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		if x.Create == nil {
+			x.Create = new(AccessControlType)
 		}
-		value := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
+		if x.Contracts == nil {
+			x.Contracts = []*ContractAccessControl{}
+		}
+		value := &_AccessControl_3_list{list: &x.Contracts}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.contracts":
+		list := []*ContractAccessControl{}
+		return protoreflect.ValueOfList(&_AccessControl_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2297,7 +2567,7 @@ func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2308,7 +2578,7 @@ func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2320,7 +2590,7 @@ func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControlType) IsValid() bool {
+func (x *fastReflection_AccessControl) IsValid() bool {
 	return x != nil
 }
 
@@ -2330,9 +2600,9 @@ func (x *fastReflection_AccessControlType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2344,12 +2614,17 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.AccessType != 0 {
-			n += 1 + runtime.Sov(uint64(x.AccessType))
+		if x.Create != nil {
+			l = options.Size(x.Create)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.AccessControlList) > 0 {
-			for _, s := range x.AccessControlList {
-				l = len(s)
+		if x.Call != nil {
+			l = options.Size(x.Call)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Contracts) > 0 {
+			for _, e := range x.Contracts {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -2363,7 +2638,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2382,19 +2657,49 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AccessControlList) > 0 {
-			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AccessControlList[iNdEx])
-				copy(dAtA[i:], x.AccessControlList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+		if len(x.Contracts) > 0 {
+			for iNdEx := len(x.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x1a
 			}
 		}
-		if x.AccessType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x12
+		}
+		if x.Create != nil {
+			encoded, err := options.Marshal(x.Create)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2407,7 +2712,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2439,17 +2744,17 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
 				}
-				x.AccessType = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2459,16 +2764,33 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AccessType |= AccessType(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Create == nil {
+					x.Create = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2478,23 +2800,61 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contracts = append(x.Contracts, &ContractAccessControl{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contracts[len(x.Contracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2531,72 +2891,81 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ContractAccessControl_3_list)(nil)
+
+type _ContractAccessControl_3_list struct {
+	list *[]*FunctionAccessControl
+}
+
+func (x *_ContractAccessControl_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ContractAccessControl_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ContractAccessControl_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FunctionAccessControl)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ContractAccessControl_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FunctionAccessControl)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ContractAccessControl_3_list) AppendMutable() protoreflect.Value {
+	v := new(FunctionAccessControl)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ContractAccessControl_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ContractAccessControl_3_list) NewElement() protoreflect.Value {
+	v := new(FunctionAccessControl)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ContractAccessControl_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ChainConfig                      protoreflect.MessageDescriptor
-	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
-	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
-	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
-	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
-	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
-	fd_ChainConfig_denom                protoreflect.FieldDescriptor
-	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
-	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
-	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+	md_ContractAccessControl           protoreflect.MessageDescriptor
+	fd_ContractAccessControl_address   protoreflect.FieldDescriptor
+	fd_ContractAccessControl_call      protoreflect.FieldDescriptor
+	fd_ContractAccessControl_functions protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
-	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
-	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
-	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
-	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
-	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
-	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
-	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
-	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
-	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
-	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
-	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
-	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
-	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
-	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
-	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
-	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
-	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
-	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
-	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
-	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
-	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
-	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
-	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
-	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+	md_ContractAccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ContractAccessControl")
+	fd_ContractAccessControl_address = md_ContractAccessControl.Fields().ByName("address")
+	fd_ContractAccessControl_call = md_ContractAccessControl.Fields().ByName("call")
+	fd_ContractAccessControl_functions = md_ContractAccessControl.Fields().ByName("functions")
 }
 
-var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_ContractAccessControl)(nil)
 
-type fastReflection_ChainConfig ChainConfig
+type fastReflection_ContractAccessControl ContractAccessControl
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(x)
+func (x *ContractAccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractAccessControl)(x)
 }
 
-func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+func (x *ContractAccessControl) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2608,43 +2977,43 @@ func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+var _fastReflection_ContractAccessControl_messageType fastReflection_ContractAccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_ContractAccessControl_messageType{}
 
-type fastReflection_ChainConfig_messageType struct{}
+type fastReflection_ContractAccessControl_messageType struct{}
 
-func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(nil)
+func (x fastReflection_ContractAccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractAccessControl)(nil)
 }
-func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x fastReflection_ContractAccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractAccessControl)
 }
-func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x fastReflection_ContractAccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractAccessControl
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x *fastReflection_ContractAccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractAccessControl
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ChainConfig_messageType
+func (x *fastReflection_ContractAccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_ContractAccessControl_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ChainConfig) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x *fastReflection_ContractAccessControl) New() protoreflect.Message {
+	return new(fastReflection_ContractAccessControl)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
-	return (*ChainConfig)(x)
+func (x *fastReflection_ContractAccessControl) Interface() protoreflect.ProtoMessage {
+	return (*ContractAccessControl)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2652,148 +3021,22 @@ func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HomesteadBlock != "" {
-		value := protoreflect.ValueOfString(x.HomesteadBlock)
-		if !f(fd_ChainConfig_homestead_block, value) {
+func (x *fastReflection_ContractAccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ContractAccessControl_address, value) {
 			return
 		}
 	}
-	if x.DaoForkBlock != "" {
-		value := protoreflect.ValueOfString(x.DaoForkBlock)
-		if !f(fd_ChainConfig_dao_fork_block, value) {
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_ContractAccessControl_call, value) {
 			return
 		}
 	}
-	if x.DaoForkSupport != false {
-		value := protoreflect.ValueOfBool(x.DaoForkSupport)
-		if !f(fd_ChainConfig_dao_fork_support, value) {
-			return
-		}
-	}
-	if x.Eip150Block != "" {
-		value := protoreflect.ValueOfString(x.Eip150Block)
-		if !f(fd_ChainConfig_eip150_block, value) {
-			return
-		}
-	}
-	if x.Eip155Block != "" {
-		value := protoreflect.ValueOfString(x.Eip155Block)
-		if !f(fd_ChainConfig_eip155_block, value) {
-			return
-		}
-	}
-	if x.Eip158Block != "" {
-		value := protoreflect.ValueOfString(x.Eip158Block)
-		if !f(fd_ChainConfig_eip158_block, value) {
-			return
-		}
-	}
-	if x.ByzantiumBlock != "" {
-		value := protoreflect.ValueOfString(x.ByzantiumBlock)
-		if !f(fd_ChainConfig_byzantium_block, value) {
-			return
-		}
-	}
-	if x.ConstantinopleBlock != "" {
-		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
-		if !f(fd_ChainConfig_constantinople_block, value) {
-			return
-		}
-	}
-	if x.PetersburgBlock != "" {
-		value := protoreflect.ValueOfString(x.PetersburgBlock)
-		if !f(fd_ChainConfig_petersburg_block, value) {
-			return
-		}
-	}
-	if x.IstanbulBlock != "" {
-		value := protoreflect.ValueOfString(x.IstanbulBlock)
-		if !f(fd_ChainConfig_istanbul_block, value) {
-			return
-		}
-	}
-	if x.MuirGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
-		if !f(fd_ChainConfig_muir_glacier_block, value) {
-			return
-		}
-	}
-	if x.BerlinBlock != "" {
-		value := protoreflect.ValueOfString(x.BerlinBlock)
-		if !f(fd_ChainConfig_berlin_block, value) {
-			return
-		}
-	}
-	if x.LondonBlock != "" {
-		value := protoreflect.ValueOfString(x.LondonBlock)
-		if !f(fd_ChainConfig_london_block, value) {
-			return
-		}
-	}
-	if x.ArrowGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
-		if !f(fd_ChainConfig_arrow_glacier_block, value) {
-			return
-		}
-	}
-	if x.GrayGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
-		if !f(fd_ChainConfig_gray_glacier_block, value) {
-			return
-		}
-	}
-	if x.MergeNetsplitBlock != "" {
-		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
-		if !f(fd_ChainConfig_merge_netsplit_block, value) {
-			return
-		}
-	}
-	if x.ChainId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ChainId)
-		if !f(fd_ChainConfig_chain_id, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ChainConfig_denom, value) {
-			return
-		}
-	}
-	if x.Decimals != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Decimals)
-		if !f(fd_ChainConfig_decimals, value) {
-			return
-		}
-	}
-	if x.ShanghaiTime != "" {
-		value := protoreflect.ValueOfString(x.ShanghaiTime)
-		if !f(fd_ChainConfig_shanghai_time, value) {
-			return
-		}
-	}
-	if x.CancunTime != "" {
-		value := protoreflect.ValueOfString(x.CancunTime)
-		if !f(fd_ChainConfig_cancun_time, value) {
-			return
-		}
-	}
-	if x.PragueTime != "" {
-		value := protoreflect.ValueOfString(x.PragueTime)
-		if !f(fd_ChainConfig_prague_time, value) {
-			return
-		}
-	}
-	if x.VerkleTime != "" {
-		value := protoreflect.ValueOfString(x.VerkleTime)
-		if !f(fd_ChainConfig_verkle_time, value) {
-			return
-		}
-	}
-	if x.OsakaTime != "" {
-		value := protoreflect.ValueOfString(x.OsakaTime)
-		if !f(fd_ChainConfig_osaka_time, value) {
+	if len(x.Functions) != 0 {
+		value := protoreflect.ValueOfList(&_ContractAccessControl_3_list{list: &x.Functions})
+		if !f(fd_ContractAccessControl_functions, value) {
 			return
 		}
 	}
@@ -2810,61 +3053,19 @@ func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ContractAccessControl) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return x.HomesteadBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return x.DaoForkBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return x.DaoForkSupport != false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return x.Eip150Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return x.Eip155Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return x.Eip158Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return x.ByzantiumBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return x.ConstantinopleBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return x.PetersburgBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return x.IstanbulBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return x.MuirGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return x.BerlinBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return x.LondonBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return x.ArrowGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return x.GrayGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return x.MergeNetsplitBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return x.ChainId != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return x.Denom != ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return x.Decimals != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return x.ShanghaiTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return x.CancunTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return x.PragueTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return x.VerkleTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		return x.OsakaTime != ""
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		return x.Call != nil
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		return len(x.Functions) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2874,61 +3075,19 @@ func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ContractAccessControl) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = ""
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		x.Call = nil
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		x.Functions = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2938,85 +3097,25 @@ func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		value := x.HomesteadBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		value := x.DaoForkBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		value := x.DaoForkSupport
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		value := x.Eip150Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		value := x.Eip155Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		value := x.Eip158Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		value := x.ByzantiumBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		value := x.ConstantinopleBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		value := x.PetersburgBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		value := x.IstanbulBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		value := x.MuirGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		value := x.BerlinBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		value := x.LondonBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		value := x.ArrowGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		value := x.GrayGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		value := x.MergeNetsplitBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		value := x.ShanghaiTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		value := x.CancunTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		value := x.PragueTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		value := x.VerkleTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		value := x.OsakaTime
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		if len(x.Functions) == 0 {
+			return protoreflect.ValueOfList(&_ContractAccessControl_3_list{})
+		}
+		listValue := &_ContractAccessControl_3_list{list: &x.Functions}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3030,61 +3129,21 @@ func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ContractAccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = value.Bool()
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		lv := value.List()
+		clv := lv.(*_ContractAccessControl_3_list)
+		x.Functions = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3098,132 +3157,57 @@ func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		if x.Functions == nil {
+			x.Functions = []*FunctionAccessControl{}
+		}
+		value := &_ContractAccessControl_3_list{list: &x.Functions}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.ContractAccessControl is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+	case "cosmos.evm.vm.v1.ContractAccessControl.address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ContractAccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccessControl.functions":
+		list := []*FunctionAccessControl{}
+		return protoreflect.ValueOfList(&_ContractAccessControl_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ContractAccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ContractAccessControl", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3231,7 +3215,7 @@ func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ContractAccessControl) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3242,7 +3226,7 @@ func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ContractAccessControl) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3254,7 +3238,7 @@ func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ChainConfig) IsValid() bool {
+func (x *fastReflection_ContractAccessControl) IsValid() bool {
 	return x != nil
 }
 
@@ -3264,9 +3248,9 @@ func (x *fastReflection_ChainConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ContractAccessControl) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*ContractAccessControl)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3278,110 +3262,31 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.HomesteadBlock)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DaoForkBlock)
-		if l > 0 {
+		if x.Call != nil {
+			l = options.Size(x.Call)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.DaoForkSupport {
-			n += 2
-		}
-		l = len(x.Eip150Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Functions) > 0 {
+			for _, e := range x.Functions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Eip155Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		l = len(x.Eip158Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ByzantiumBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConstantinopleBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PetersburgBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.IstanbulBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MuirGlacierBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BerlinBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.LondonBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ArrowGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GrayGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MergeNetsplitBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 2 + runtime.Sov(uint64(x.ChainId))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.Decimals != 0 {
-			n += 2 + runtime.Sov(uint64(x.Decimals))
-		}
-		l = len(x.ShanghaiTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CancunTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PragueTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VerkleTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OsakaTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*ContractAccessControl)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3400,214 +3305,60 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.OsakaTime) > 0 {
-			i -= len(x.OsakaTime)
-			copy(dAtA[i:], x.OsakaTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xfa
-		}
-		if len(x.VerkleTime) > 0 {
-			i -= len(x.VerkleTime)
-			copy(dAtA[i:], x.VerkleTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xf2
-		}
-		if len(x.PragueTime) > 0 {
-			i -= len(x.PragueTime)
-			copy(dAtA[i:], x.PragueTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
-		}
-		if len(x.CancunTime) > 0 {
-			i -= len(x.CancunTime)
-			copy(dAtA[i:], x.CancunTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
-		if len(x.ShanghaiTime) > 0 {
-			i -= len(x.ShanghaiTime)
-			copy(dAtA[i:], x.ShanghaiTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
-		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xd0
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xc0
-		}
-		if len(x.MergeNetsplitBlock) > 0 {
-			i -= len(x.MergeNetsplitBlock)
-			copy(dAtA[i:], x.MergeNetsplitBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
-		if len(x.GrayGlacierBlock) > 0 {
-			i -= len(x.GrayGlacierBlock)
-			copy(dAtA[i:], x.GrayGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
-		if len(x.ArrowGlacierBlock) > 0 {
-			i -= len(x.ArrowGlacierBlock)
-			copy(dAtA[i:], x.ArrowGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.LondonBlock) > 0 {
-			i -= len(x.LondonBlock)
-			copy(dAtA[i:], x.LondonBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BerlinBlock) > 0 {
-			i -= len(x.BerlinBlock)
-			copy(dAtA[i:], x.BerlinBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
-			i--
-			dAtA[i] = 0x6a
+		if len(x.Functions) > 0 {
+			for iNdEx := len(x.Functions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Functions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.MuirGlacierBlock) > 0 {
-			i -= len(x.MuirGlacierBlock)
-			copy(dAtA[i:], x.MuirGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x12
 		}
-		if len(x.IstanbulBlock) > 0 {
-			i -= len(x.IstanbulBlock)
-			copy(dAtA[i:], x.IstanbulBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0xa
 		}
-		if len(x.PetersburgBlock) > 0 {
-			i -= len(x.PetersburgBlock)
-			copy(dAtA[i:], x.PetersburgBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
-			i--
-			dAtA[i] = 0x52
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if len(x.ConstantinopleBlock) > 0 {
-			i -= len(x.ConstantinopleBlock)
-			copy(dAtA[i:], x.ConstantinopleBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ByzantiumBlock) > 0 {
-			i -= len(x.ByzantiumBlock)
-			copy(dAtA[i:], x.ByzantiumBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Eip158Block) > 0 {
-			i -= len(x.Eip158Block)
-			copy(dAtA[i:], x.Eip158Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Eip155Block) > 0 {
-			i -= len(x.Eip155Block)
-			copy(dAtA[i:], x.Eip155Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Eip150Block) > 0 {
-			i -= len(x.Eip150Block)
-			copy(dAtA[i:], x.Eip150Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.DaoForkSupport {
-			i--
-			if x.DaoForkSupport {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DaoForkBlock) > 0 {
-			i -= len(x.DaoForkBlock)
-			copy(dAtA[i:], x.DaoForkBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.HomesteadBlock) > 0 {
-			i -= len(x.HomesteadBlock)
-			copy(dAtA[i:], x.HomesteadBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractAccessControl)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -3634,15 +3385,15 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractAccessControl: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3670,65 +3421,13 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DaoForkSupport = bool(v != 0)
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3738,61 +3437,33 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip150Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Eip155Block = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3802,95 +3473,2448 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip158Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Functions = append(x.Functions, &FunctionAccessControl{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Functions[len(x.Functions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.ByzantiumBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ConstantinopleBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FunctionAccessControl          protoreflect.MessageDescriptor
+	fd_FunctionAccessControl_selector protoreflect.FieldDescriptor
+	fd_FunctionAccessControl_call     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_FunctionAccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("FunctionAccessControl")
+	fd_FunctionAccessControl_selector = md_FunctionAccessControl.Fields().ByName("selector")
+	fd_FunctionAccessControl_call = md_FunctionAccessControl.Fields().ByName("call")
+}
+
+var _ protoreflect.Message = (*fastReflection_FunctionAccessControl)(nil)
+
+type fastReflection_FunctionAccessControl FunctionAccessControl
+
+func (x *FunctionAccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FunctionAccessControl)(x)
+}
+
+func (x *FunctionAccessControl) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FunctionAccessControl_messageType fastReflection_FunctionAccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_FunctionAccessControl_messageType{}
+
+type fastReflection_FunctionAccessControl_messageType struct{}
+
+func (x fastReflection_FunctionAccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FunctionAccessControl)(nil)
+}
+func (x fastReflection_FunctionAccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_FunctionAccessControl)
+}
+func (x fastReflection_FunctionAccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunctionAccessControl
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FunctionAccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunctionAccessControl
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FunctionAccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_FunctionAccessControl_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FunctionAccessControl) New() protoreflect.Message {
+	return new(fastReflection_FunctionAccessControl)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FunctionAccessControl) Interface() protoreflect.ProtoMessage {
+	return (*FunctionAccessControl)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FunctionAccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Selector != "" {
+		value := protoreflect.ValueOfString(x.Selector)
+		if !f(fd_FunctionAccessControl_selector, value) {
+			return
+		}
+	}
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_FunctionAccessControl_call, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FunctionAccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		return x.Selector != ""
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		return x.Call != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionAccessControl) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		x.Selector = ""
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		x.Call = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FunctionAccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		value := x.Selector
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionAccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		x.Selector = value.Interface().(string)
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionAccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		panic(fmt.Errorf("field selector of message cosmos.evm.vm.v1.FunctionAccessControl is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FunctionAccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.FunctionAccessControl.selector":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.FunctionAccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.FunctionAccessControl"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.FunctionAccessControl does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FunctionAccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.FunctionAccessControl", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FunctionAccessControl) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionAccessControl) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FunctionAccessControl) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FunctionAccessControl) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FunctionAccessControl)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Selector)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Call != nil {
+			l = options.Size(x.Call)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FunctionAccessControl)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Selector) > 0 {
+			i -= len(x.Selector)
+			copy(dAtA[i:], x.Selector)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Selector)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FunctionAccessControl)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunctionAccessControl: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunctionAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Selector = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
+
+type _AccessControlType_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessControlType_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
+}
+
+func (x *_AccessControlType_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessControlType_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AccessControlType                     protoreflect.MessageDescriptor
+	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
+	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
+	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
+	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+
+type fastReflection_AccessControlType AccessControlType
+
+func (x *AccessControlType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(x)
+}
+
+func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+
+type fastReflection_AccessControlType_messageType struct{}
+
+func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(nil)
+}
+func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControlType_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccessControlType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
+	return (*AccessControlType)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AccessType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
+		if !f(fd_AccessControlType_access_type, value) {
+			return
+		}
+	}
+	if len(x.AccessControlList) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
+		if !f(fd_AccessControlType_access_control_list, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return x.AccessType != 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		return len(x.AccessControlList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		x.AccessControlList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		value := x.AccessType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if len(x.AccessControlList) == 0 {
+			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
+		}
+		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = (AccessType)(value.Enum())
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		lv := value.List()
+		clv := lv.(*_AccessControlType_2_list)
+		x.AccessControlList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if x.AccessControlList == nil {
+			x.AccessControlList = []string{}
+		}
+		value := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccessControlType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AccessType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccessType))
+		}
+		if len(x.AccessControlList) > 0 {
+			for _, s := range x.AccessControlList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AccessControlList) > 0 {
+			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccessControlList[iNdEx])
+				copy(dAtA[i:], x.AccessControlList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.AccessType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				}
+				x.AccessType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccessType |= AccessType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChainConfig                      protoreflect.MessageDescriptor
+	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
+	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
+	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
+	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
+	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
+	fd_ChainConfig_denom                protoreflect.FieldDescriptor
+	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
+	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
+	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
+	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
+	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
+	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
+	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
+	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
+	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
+	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
+	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
+	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
+	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
+	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
+	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
+	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
+	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
+	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
+	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
+	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
+	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
+	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
+	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
+	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
+	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+
+type fastReflection_ChainConfig ChainConfig
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(x)
+}
+
+func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+
+type fastReflection_ChainConfig_messageType struct{}
+
+func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(nil)
+}
+func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ChainConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChainConfig) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
+	return (*ChainConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HomesteadBlock != "" {
+		value := protoreflect.ValueOfString(x.HomesteadBlock)
+		if !f(fd_ChainConfig_homestead_block, value) {
+			return
+		}
+	}
+	if x.DaoForkBlock != "" {
+		value := protoreflect.ValueOfString(x.DaoForkBlock)
+		if !f(fd_ChainConfig_dao_fork_block, value) {
+			return
+		}
+	}
+	if x.DaoForkSupport != false {
+		value := protoreflect.ValueOfBool(x.DaoForkSupport)
+		if !f(fd_ChainConfig_dao_fork_support, value) {
+			return
+		}
+	}
+	if x.Eip150Block != "" {
+		value := protoreflect.ValueOfString(x.Eip150Block)
+		if !f(fd_ChainConfig_eip150_block, value) {
+			return
+		}
+	}
+	if x.Eip155Block != "" {
+		value := protoreflect.ValueOfString(x.Eip155Block)
+		if !f(fd_ChainConfig_eip155_block, value) {
+			return
+		}
+	}
+	if x.Eip158Block != "" {
+		value := protoreflect.ValueOfString(x.Eip158Block)
+		if !f(fd_ChainConfig_eip158_block, value) {
+			return
+		}
+	}
+	if x.ByzantiumBlock != "" {
+		value := protoreflect.ValueOfString(x.ByzantiumBlock)
+		if !f(fd_ChainConfig_byzantium_block, value) {
+			return
+		}
+	}
+	if x.ConstantinopleBlock != "" {
+		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
+		if !f(fd_ChainConfig_constantinople_block, value) {
+			return
+		}
+	}
+	if x.PetersburgBlock != "" {
+		value := protoreflect.ValueOfString(x.PetersburgBlock)
+		if !f(fd_ChainConfig_petersburg_block, value) {
+			return
+		}
+	}
+	if x.IstanbulBlock != "" {
+		value := protoreflect.ValueOfString(x.IstanbulBlock)
+		if !f(fd_ChainConfig_istanbul_block, value) {
+			return
+		}
+	}
+	if x.MuirGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
+		if !f(fd_ChainConfig_muir_glacier_block, value) {
+			return
+		}
+	}
+	if x.BerlinBlock != "" {
+		value := protoreflect.ValueOfString(x.BerlinBlock)
+		if !f(fd_ChainConfig_berlin_block, value) {
+			return
+		}
+	}
+	if x.LondonBlock != "" {
+		value := protoreflect.ValueOfString(x.LondonBlock)
+		if !f(fd_ChainConfig_london_block, value) {
+			return
+		}
+	}
+	if x.ArrowGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
+		if !f(fd_ChainConfig_arrow_glacier_block, value) {
+			return
+		}
+	}
+	if x.GrayGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
+		if !f(fd_ChainConfig_gray_glacier_block, value) {
+			return
+		}
+	}
+	if x.MergeNetsplitBlock != "" {
+		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
+		if !f(fd_ChainConfig_merge_netsplit_block, value) {
+			return
+		}
+	}
+	if x.ChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChainId)
+		if !f(fd_ChainConfig_chain_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ChainConfig_denom, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_ChainConfig_decimals, value) {
+			return
+		}
+	}
+	if x.ShanghaiTime != "" {
+		value := protoreflect.ValueOfString(x.ShanghaiTime)
+		if !f(fd_ChainConfig_shanghai_time, value) {
+			return
+		}
+	}
+	if x.CancunTime != "" {
+		value := protoreflect.ValueOfString(x.CancunTime)
+		if !f(fd_ChainConfig_cancun_time, value) {
+			return
+		}
+	}
+	if x.PragueTime != "" {
+		value := protoreflect.ValueOfString(x.PragueTime)
+		if !f(fd_ChainConfig_prague_time, value) {
+			return
+		}
+	}
+	if x.VerkleTime != "" {
+		value := protoreflect.ValueOfString(x.VerkleTime)
+		if !f(fd_ChainConfig_verkle_time, value) {
+			return
+		}
+	}
+	if x.OsakaTime != "" {
+		value := protoreflect.ValueOfString(x.OsakaTime)
+		if !f(fd_ChainConfig_osaka_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return x.HomesteadBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return x.DaoForkBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return x.DaoForkSupport != false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return x.Eip150Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return x.Eip155Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return x.Eip158Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return x.ByzantiumBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return x.ConstantinopleBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return x.PetersburgBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return x.IstanbulBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return x.MuirGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return x.BerlinBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return x.LondonBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return x.ArrowGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return x.GrayGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return x.MergeNetsplitBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return x.ChainId != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return x.Denom != ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return x.Decimals != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return x.ShanghaiTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return x.CancunTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return x.PragueTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return x.VerkleTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return x.OsakaTime != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		value := x.HomesteadBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		value := x.DaoForkBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		value := x.DaoForkSupport
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		value := x.Eip150Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		value := x.Eip155Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		value := x.Eip158Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		value := x.ByzantiumBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		value := x.ConstantinopleBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		value := x.PetersburgBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		value := x.IstanbulBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		value := x.MuirGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		value := x.BerlinBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		value := x.LondonBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		value := x.ArrowGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		value := x.GrayGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		value := x.MergeNetsplitBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		value := x.ShanghaiTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		value := x.CancunTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		value := x.PragueTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		value := x.VerkleTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		value := x.OsakaTime
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = value.Bool()
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChainConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.HomesteadBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DaoForkBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DaoForkSupport {
+			n += 2
+		}
+		l = len(x.Eip150Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip155Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip158Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByzantiumBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConstantinopleBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PetersburgBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IstanbulBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MuirGlacierBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BerlinBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LondonBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ArrowGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrayGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeNetsplitBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 2 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.ShanghaiTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CancunTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PragueTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerkleTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OsakaTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OsakaTime) > 0 {
+			i -= len(x.OsakaTime)
+			copy(dAtA[i:], x.OsakaTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.VerkleTime) > 0 {
+			i -= len(x.VerkleTime)
+			copy(dAtA[i:], x.VerkleTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.PragueTime) > 0 {
+			i -= len(x.PragueTime)
+			copy(dAtA[i:], x.PragueTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.CancunTime) > 0 {
+			i -= len(x.CancunTime)
+			copy(dAtA[i:], x.CancunTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.ShanghaiTime) > 0 {
+			i -= len(x.ShanghaiTime)
+			copy(dAtA[i:], x.ShanghaiTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.MergeNetsplitBlock) > 0 {
+			i -= len(x.MergeNetsplitBlock)
+			copy(dAtA[i:], x.MergeNetsplitBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.GrayGlacierBlock) > 0 {
+			i -= len(x.GrayGlacierBlock)
+			copy(dAtA[i:], x.GrayGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.ArrowGlacierBlock) > 0 {
+			i -= len(x.ArrowGlacierBlock)
+			copy(dAtA[i:], x.ArrowGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LondonBlock) > 0 {
+			i -= len(x.LondonBlock)
+			copy(dAtA[i:], x.LondonBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BerlinBlock) > 0 {
+			i -= len(x.BerlinBlock)
+			copy(dAtA[i:], x.BerlinBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MuirGlacierBlock) > 0 {
+			i -= len(x.MuirGlacierBlock)
+			copy(dAtA[i:], x.MuirGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.IstanbulBlock) > 0 {
+			i -= len(x.IstanbulBlock)
+			copy(dAtA[i:], x.IstanbulBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PetersburgBlock) > 0 {
+			i -= len(x.PetersburgBlock)
+			copy(dAtA[i:], x.PetersburgBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ConstantinopleBlock) > 0 {
+			i -= len(x.ConstantinopleBlock)
+			copy(dAtA[i:], x.ConstantinopleBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ByzantiumBlock) > 0 {
+			i -= len(x.ByzantiumBlock)
+			copy(dAtA[i:], x.ByzantiumBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Eip158Block) > 0 {
+			i -= len(x.Eip158Block)
+			copy(dAtA[i:], x.Eip158Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Eip155Block) > 0 {
+			i -= len(x.Eip155Block)
+			copy(dAtA[i:], x.Eip155Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Eip150Block) > 0 {
+			i -= len(x.Eip150Block)
+			copy(dAtA[i:], x.Eip150Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DaoForkSupport {
+			i--
+			if x.DaoForkSupport {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DaoForkBlock) > 0 {
+			i -= len(x.DaoForkBlock)
+			copy(dAtA[i:], x.DaoForkBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.HomesteadBlock) > 0 {
+			i -= len(x.HomesteadBlock)
+			copy(dAtA[i:], x.HomesteadBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DaoForkSupport = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip155Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip158Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ByzantiumBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ByzantiumBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConstantinopleBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConstantinopleBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PetersburgBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
 					if iNdEx >= l {
@@ -4186,65 +6210,678 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				}
+				x.Decimals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Decimals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ShanghaiTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CancunTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PragueTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerkleTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OsakaTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_HardFork            protoreflect.MessageDescriptor
+	fd_HardFork_name       protoreflect.FieldDescriptor
+	fd_HardFork_activation protoreflect.FieldDescriptor
+	fd_HardFork_time_based protoreflect.FieldDescriptor
+	fd_HardFork_active     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_HardFork = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("HardFork")
+	fd_HardFork_name = md_HardFork.Fields().ByName("name")
+	fd_HardFork_activation = md_HardFork.Fields().ByName("activation")
+	fd_HardFork_time_based = md_HardFork.Fields().ByName("time_based")
+	fd_HardFork_active = md_HardFork.Fields().ByName("active")
+}
+
+var _ protoreflect.Message = (*fastReflection_HardFork)(nil)
+
+type fastReflection_HardFork HardFork
+
+func (x *HardFork) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_HardFork)(x)
+}
+
+func (x *HardFork) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_HardFork_messageType fastReflection_HardFork_messageType
+var _ protoreflect.MessageType = fastReflection_HardFork_messageType{}
+
+type fastReflection_HardFork_messageType struct{}
+
+func (x fastReflection_HardFork_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_HardFork)(nil)
+}
+func (x fastReflection_HardFork_messageType) New() protoreflect.Message {
+	return new(fastReflection_HardFork)
+}
+func (x fastReflection_HardFork_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_HardFork
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_HardFork) Descriptor() protoreflect.MessageDescriptor {
+	return md_HardFork
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_HardFork) Type() protoreflect.MessageType {
+	return _fastReflection_HardFork_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_HardFork) New() protoreflect.Message {
+	return new(fastReflection_HardFork)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_HardFork) Interface() protoreflect.ProtoMessage {
+	return (*HardFork)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_HardFork) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_HardFork_name, value) {
+			return
+		}
+	}
+	if x.Activation != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Activation)
+		if !f(fd_HardFork_activation, value) {
+			return
+		}
+	}
+	if x.TimeBased != false {
+		value := protoreflect.ValueOfBool(x.TimeBased)
+		if !f(fd_HardFork_time_based, value) {
+			return
+		}
+	}
+	if x.Active != false {
+		value := protoreflect.ValueOfBool(x.Active)
+		if !f(fd_HardFork_active, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_HardFork) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		return x.Name != ""
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		return x.Activation != uint64(0)
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		return x.TimeBased != false
+	case "cosmos.evm.vm.v1.HardFork.active":
+		return x.Active != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HardFork) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		x.Name = ""
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		x.Activation = uint64(0)
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		x.TimeBased = false
+	case "cosmos.evm.vm.v1.HardFork.active":
+		x.Active = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_HardFork) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		value := x.Activation
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		value := x.TimeBased
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.HardFork.active":
+		value := x.Active
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HardFork) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		x.Activation = value.Uint()
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		x.TimeBased = value.Bool()
+	case "cosmos.evm.vm.v1.HardFork.active":
+		x.Active = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HardFork) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.HardFork is not mutable"))
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		panic(fmt.Errorf("field activation of message cosmos.evm.vm.v1.HardFork is not mutable"))
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		panic(fmt.Errorf("field time_based of message cosmos.evm.vm.v1.HardFork is not mutable"))
+	case "cosmos.evm.vm.v1.HardFork.active":
+		panic(fmt.Errorf("field active of message cosmos.evm.vm.v1.HardFork is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_HardFork) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.HardFork.name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.HardFork.activation":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.HardFork.time_based":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.HardFork.active":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.HardFork"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.HardFork does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_HardFork) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.HardFork", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_HardFork) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_HardFork) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_HardFork) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_HardFork) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*HardFork)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Activation != 0 {
+			n += 1 + runtime.Sov(uint64(x.Activation))
+		}
+		if x.TimeBased {
+			n += 2
+		}
+		if x.Active {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*HardFork)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Active {
+			i--
+			if x.Active {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TimeBased {
+			i--
+			if x.TimeBased {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Activation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Activation))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*HardFork)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 26:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-				}
-				x.Decimals = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Decimals |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 27:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShanghaiTime", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.ShanghaiTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 28:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HardFork: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: HardFork: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CancunTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4272,13 +6909,13 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CancunTime = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 29:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Activation", wireType)
 				}
-				var stringLen uint64
+				x.Activation = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4288,29 +6925,16 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Activation |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PragueTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 30:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeBased", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4320,29 +6944,17 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.VerkleTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 31:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+				x.TimeBased = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4352,24 +6964,12 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.OsakaTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
+				x.Active = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4427,7 +7027,7 @@ func (x *State) ProtoReflect() protoreflect.Message {
 }
 
 func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4962,7 +7562,7 @@ func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5531,7 +8131,7 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6461,7 +9061,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7232,7 +9832,7 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7748,7 +10348,7 @@ func (x *TraceConfig) ProtoReflect() protoreflect.Message {
 }
 
 func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8725,7 +11325,7 @@ func (x *Preinstall) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Preinstall) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9275,7 +11875,7 @@ func (x *EvmCoinInfo) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EvmCoinInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9931,6 +12531,21 @@ type Params struct {
 	ActiveStaticPrecompiles []string              `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64                `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 	ExtendedDenomOptions    *ExtendedDenomOptions `protobuf:"bytes,11,opt,name=extended_denom_options,json=extendedDenomOptions,proto3" json:"extended_denom_options,omitempty"`
+	// opcode_gas_overrides defines the constant gas of the opcodes, overriding
+	// the gas schedule of the hard fork and of the extra EIPs
+	OpcodeGasOverrides []*OpcodeGasOverride `protobuf:"bytes,12,rep,name=opcode_gas_overrides,json=opcodeGasOverrides,proto3" json:"opcode_gas_overrides,omitempty"`
+	// max_code_size defines the maximum size of the code deployed by a contract
+	// creation transaction (EIP-170). Zero means the Ethereum limit, which can
+	// only be lowered and bounds the CREATE and CREATE2 opcodes.
+	MaxCodeSize uint64 `protobuf:"varint,13,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size defines the maximum size of the init code of a
+	// contract creation transaction (EIP-3860). Zero means the Ethereum limit,
+	// which can only be lowered and bounds the CREATE and CREATE2 opcodes.
+	MaxInitCodeSize uint64 `protobuf:"varint,14,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
+	// refund_quotient defines the cap on the gas refund as the quotient of the
+	// gas used, which is at least the EIP-3529 one. Zero means the quotient of
+	// the hard fork.
+	RefundQuotient uint64 `protobuf:"varint,15,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -9981,27 +12596,102 @@ func (x *Params) GetAccessControl() *AccessControl {
 	return nil
 }
 
-func (x *Params) GetActiveStaticPrecompiles() []string {
+func (x *Params) GetActiveStaticPrecompiles() []string {
+	if x != nil {
+		return x.ActiveStaticPrecompiles
+	}
+	return nil
+}
+
+func (x *Params) GetHistoryServeWindow() uint64 {
+	if x != nil {
+		return x.HistoryServeWindow
+	}
+	return 0
+}
+
+func (x *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
+	if x != nil {
+		return x.ExtendedDenomOptions
+	}
+	return nil
+}
+
+func (x *Params) GetOpcodeGasOverrides() []*OpcodeGasOverride {
+	if x != nil {
+		return x.OpcodeGasOverrides
+	}
+	return nil
+}
+
+func (x *Params) GetMaxCodeSize() uint64 {
+	if x != nil {
+		return x.MaxCodeSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxInitCodeSize() uint64 {
+	if x != nil {
+		return x.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (x *Params) GetRefundQuotient() uint64 {
+	if x != nil {
+		return x.RefundQuotient
+	}
+	return 0
+}
+
+// OpcodeGasOverride defines the constant gas of an opcode
+type OpcodeGasOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// opcode is the name of the opcode, e.g. SSTORE
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged for the opcode, in addition to
+	// its dynamic gas
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (x *OpcodeGasOverride) Reset() {
+	*x = OpcodeGasOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpcodeGasOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpcodeGasOverride) ProtoMessage() {}
+
+// Deprecated: Use OpcodeGasOverride.ProtoReflect.Descriptor instead.
+func (*OpcodeGasOverride) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpcodeGasOverride) GetOpcode() string {
 	if x != nil {
-		return x.ActiveStaticPrecompiles
+		return x.Opcode
 	}
-	return nil
+	return ""
 }
 
-func (x *Params) GetHistoryServeWindow() uint64 {
+func (x *OpcodeGasOverride) GetConstantGas() uint64 {
 	if x != nil {
-		return x.HistoryServeWindow
+		return x.ConstantGas
 	}
 	return 0
 }
 
-func (x *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
-	if x != nil {
-		return x.ExtendedDenomOptions
-	}
-	return nil
-}
-
 type ExtendedDenomOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -10013,7 +12703,7 @@ type ExtendedDenomOptions struct {
 func (x *ExtendedDenomOptions) Reset() {
 	*x = ExtendedDenomOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10027,7 +12717,7 @@ func (*ExtendedDenomOptions) ProtoMessage() {}
 
 // Deprecated: Use ExtendedDenomOptions.ProtoReflect.Descriptor instead.
 func (*ExtendedDenomOptions) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ExtendedDenomOptions) GetExtendedDenom() string {
@@ -10048,12 +12738,15 @@ type AccessControl struct {
 	Create *AccessControlType `protobuf:"bytes,1,opt,name=create,proto3" json:"create,omitempty"`
 	// call defines the permission policy for calling contracts
 	Call *AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+	// contracts defines the permission policies for calling specific contracts,
+	// which apply in addition to the call permission policy
+	Contracts []*ContractAccessControl `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 }
 
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10067,7 +12760,7 @@ func (*AccessControl) ProtoMessage() {}
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AccessControl) GetCreate() *AccessControlType {
@@ -10084,6 +12777,120 @@ func (x *AccessControl) GetCall() *AccessControlType {
 	return nil
 }
 
+func (x *AccessControl) GetContracts() []*ContractAccessControl {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+// ContractAccessControl defines the permission policies for calling a target
+// contract
+type ContractAccessControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the hex address of the target contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// call defines the permission policy for the calls to the contract that
+	// don't match any of the function policies
+	Call *AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+	// functions defines the permission policies for calling specific functions
+	// of the contract. They apply to the transaction calls and to the calls of
+	// the other contracts, matched by the selector in their input.
+	Functions []*FunctionAccessControl `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions,omitempty"`
+}
+
+func (x *ContractAccessControl) Reset() {
+	*x = ContractAccessControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractAccessControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractAccessControl) ProtoMessage() {}
+
+// Deprecated: Use ContractAccessControl.ProtoReflect.Descriptor instead.
+func (*ContractAccessControl) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContractAccessControl) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ContractAccessControl) GetCall() *AccessControlType {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *ContractAccessControl) GetFunctions() []*FunctionAccessControl {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+// FunctionAccessControl defines the permission policy for calling a function of
+// a target contract
+type FunctionAccessControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// selector is the hex encoded 4 bytes selector of the function, e.g.
+	// 0x40c10f19 for mint(address,uint256)
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// call defines the permission policy for the calls to the function
+	Call *AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *FunctionAccessControl) Reset() {
+	*x = FunctionAccessControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionAccessControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionAccessControl) ProtoMessage() {}
+
+// Deprecated: Use FunctionAccessControl.ProtoReflect.Descriptor instead.
+func (*FunctionAccessControl) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FunctionAccessControl) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *FunctionAccessControl) GetCall() *AccessControlType {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
 // AccessControlType defines the permission type for policies
 type AccessControlType struct {
 	state         protoimpl.MessageState
@@ -10105,7 +12912,7 @@ type AccessControlType struct {
 func (x *AccessControlType) Reset() {
 	*x = AccessControlType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10119,7 +12926,7 @@ func (*AccessControlType) ProtoMessage() {}
 
 // Deprecated: Use AccessControlType.ProtoReflect.Descriptor instead.
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AccessControlType) GetAccessType() AccessType {
@@ -10206,7 +13013,7 @@ type ChainConfig struct {
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10220,7 +13027,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -10391,6 +13198,72 @@ func (x *ChainConfig) GetOsakaTime() string {
 	return ""
 }
 
+// HardFork defines an EVM hard fork of the chain config and its activation.
+type HardFork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the hard fork, as the chain config field without its block or time
+	// suffix (e.g. "prague")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// activation is the block height or, for the time based hard forks, the block
+	// timestamp from which the hard fork is active
+	Activation uint64 `protobuf:"varint,2,opt,name=activation,proto3" json:"activation,omitempty"`
+	// time_based is true if the activation is a block timestamp
+	TimeBased bool `protobuf:"varint,3,opt,name=time_based,json=timeBased,proto3" json:"time_based,omitempty"`
+	// active is true if the hard fork is active at the queried height
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *HardFork) Reset() {
+	*x = HardFork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HardFork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardFork) ProtoMessage() {}
+
+// Deprecated: Use HardFork.ProtoReflect.Descriptor instead.
+func (*HardFork) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HardFork) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HardFork) GetActivation() uint64 {
+	if x != nil {
+		return x.Activation
+	}
+	return 0
+}
+
+func (x *HardFork) GetTimeBased() bool {
+	if x != nil {
+		return x.TimeBased
+	}
+	return false
+}
+
+func (x *HardFork) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	state         protoimpl.MessageState
@@ -10406,7 +13279,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10420,7 +13293,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *State) GetKey() string {
@@ -10454,7 +13327,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10468,7 +13341,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -10523,7 +13396,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10537,7 +13410,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Log) GetAddress() string {
@@ -10636,7 +13509,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10650,7 +13523,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -10710,7 +13583,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10724,7 +13597,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -10775,7 +13648,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10789,7 +13662,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -10887,7 +13760,7 @@ type Preinstall struct {
 func (x *Preinstall) Reset() {
 	*x = Preinstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10901,7 +13774,7 @@ func (*Preinstall) ProtoMessage() {}
 
 // Deprecated: Use Preinstall.ProtoReflect.Descriptor instead.
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Preinstall) GetName() string {
@@ -10939,7 +13812,7 @@ type EvmCoinInfo struct {
 func (x *EvmCoinInfo) Reset() {
 	*x = EvmCoinInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10953,7 +13826,7 @@ func (*EvmCoinInfo) ProtoMessage() {}
 
 // Deprecated: Use EvmCoinInfo.ProtoReflect.Descriptor instead.
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EvmCoinInfo) GetDenom() string {
@@ -10992,7 +13865,7 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d,
@@ -11022,296 +13895,346 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74,
 	0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x52, 0x14, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
-	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x3d, 0x0a, 0x14,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x91, 0x01, 0x0a, 0x0d,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a,
-	0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22,
-	0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde,
-	0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0xa8, 0x10, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d,
-	0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68,
-	0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a,
-	0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
-	0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f,
-	0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f,
-	0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66,
-	0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44, 0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
-	0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22,
-	0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69,
-	0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70,
-	0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31,
-	0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x14, 0x6f, 0x70, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x47,
+	0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x12, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x3a, 0x1b,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
+	0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10,
+	0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08,
+	0x06, 0x10, 0x07, 0x22, 0x4e, 0x0a, 0x11, 0x4f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x61, 0x73,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
+	0x47, 0x61, 0x73, 0x22, 0x3d, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x22, 0xde, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x4b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x4b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x15, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x63, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x24, 0xe2, 0xde, 0x1f, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x63, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x33, 0xe2, 0xde, 0x1f, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x52, 0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa8, 0x10, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73,
+	0x74, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72,
+	0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x42, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0c, 0x44, 0x41, 0x4f, 0x46,
+	0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x0c, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x57, 0x0a, 0x10, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2d, 0xe2, 0xde, 0x1f, 0x0e, 0x44,
+	0x41, 0x4f, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f,
+	0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x6b, 0x5f,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0e, 0x64, 0x61, 0x6f, 0x46, 0x6f, 0x72,
+	0x6b, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31,
+	0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f,
 	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50,
-	0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
-	0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f,
-	0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
-	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74,
-	0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61,
-	0x6e, 0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70,
-	0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x34, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62,
-	0x75, 0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63,
-	0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61,
-	0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72,
-	0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x22, 0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53,
-	0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2,
-	0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61,
-	0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x37, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69,
-	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77,
-	0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12,
-	0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61,
-	0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x52, 0x10, 0x67, 0x72, 0x61, 0x79, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73,
-	0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x30, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x62, 0x0a, 0x0c,
+	0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f,
+	0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x13,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x35, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x62, 0x0a, 0x0c, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xe2, 0xde, 0x1f, 0x0b, 0x45, 0x49, 0x50, 0x31, 0x35, 0x38, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x65, 0x69, 0x70, 0x31, 0x35, 0x38, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75,
+	0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x0e, 0x62, 0x79, 0x7a, 0x61, 0x6e, 0x74, 0x69, 0x75, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x6b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x6f, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70,
-	0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67,
-	0x65, 0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x73,
-	0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x31, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
-	0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f,
+	0x70, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x6f, 0x70, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x5f, 0x0a, 0x10, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x0f, 0x70, 0x65, 0x74, 0x65, 0x72, 0x73, 0x62, 0x75, 0x72, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x59, 0x0a, 0x0e, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e,
-	0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x75,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70,
-	0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x70, 0x72, 0x61,
-	0x67, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x6b, 0x6c,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde,
+	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x62, 0x75, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0d, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x62, 0x75, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x6d,
+	0x75, 0x69, 0x72, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x75, 0x69, 0x72,
+	0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x10, 0x6d, 0x75, 0x69, 0x72, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x62, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x65, 0x72, 0x6c,
+	0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b, 0x62, 0x65, 0x72, 0x6c, 0x69,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xda, 0xde,
 	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x76,
-	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x6f, 0x73, 0x61,
-	0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xda,
-	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x6f,
-	0x73, 0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04,
-	0x08, 0x16, 0x10, 0x17, 0x4a, 0x04, 0x08, 0x17, 0x10, 0x18, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x87, 0x03,
-	0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a,
-	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f,
-	0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b,
-	0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72,
-	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73,
-	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73,
-	0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04,
-	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10,
-	0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b,
-	0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b,
-	0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75,
-	0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72,
-	0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72,
-	0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f,
-	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f,
-	0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a,
-	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x12,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0b,
+	0x6c, 0x6f, 0x6e, 0x64, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x67, 0x0a, 0x13, 0x61,
+	0x72, 0x72, 0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x72, 0x72,
+	0x6f, 0x77, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x52, 0x11, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x47, 0x6c, 0x61, 0x63, 0x69, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x64, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61,
+	0x63, 0x69, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x19, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x67, 0x72, 0x61, 0x79, 0x5f, 0x67, 0x6c, 0x61, 0x63, 0x69, 0x65,
+	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x10, 0x67, 0x72, 0x61, 0x79, 0x47, 0x6c,
+	0x61, 0x63, 0x69, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x14, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x52, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x4e, 0x65, 0x74, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0c, 0x73,
+	0x68, 0x61, 0x6e, 0x67, 0x68, 0x61, 0x69, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x63,
+	0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x50, 0x0a,
+	0x0b, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f,
+	0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x52, 0x0a, 0x70, 0x72, 0x61, 0x67, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x50, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2,
+	0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d, 0x65,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x16, 0x10, 0x17, 0x4a, 0x04, 0x08, 0x17,
+	0x10, 0x18, 0x22, 0x75, 0x0a, 0x08, 0x48, 0x61, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x2a, 0xc0,
-	0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a,
-	0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52,
-	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a,
-	0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52,
-	0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65,
-	0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a,
-	0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76,
-	0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x87, 0x03, 0x0a,
+	0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
+	0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08,
+	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2,
+	0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f,
+	0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a,
+	0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea,
+	0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a,
+	0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e,
+	0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x8b, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x6d, 0x43, 0x6f, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x2a, 0xc0, 0x01,
+	0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d,
+	0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a,
+	0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d,
+	0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11327,37 +14250,46 @@ func file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_evm_vm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_cosmos_evm_vm_v1_evm_proto_goTypes = []interface{}{
-	(AccessType)(0),              // 0: cosmos.evm.vm.v1.AccessType
-	(*Params)(nil),               // 1: cosmos.evm.vm.v1.Params
-	(*ExtendedDenomOptions)(nil), // 2: cosmos.evm.vm.v1.ExtendedDenomOptions
-	(*AccessControl)(nil),        // 3: cosmos.evm.vm.v1.AccessControl
-	(*AccessControlType)(nil),    // 4: cosmos.evm.vm.v1.AccessControlType
-	(*ChainConfig)(nil),          // 5: cosmos.evm.vm.v1.ChainConfig
-	(*State)(nil),                // 6: cosmos.evm.vm.v1.State
-	(*TransactionLogs)(nil),      // 7: cosmos.evm.vm.v1.TransactionLogs
-	(*Log)(nil),                  // 8: cosmos.evm.vm.v1.Log
-	(*TxResult)(nil),             // 9: cosmos.evm.vm.v1.TxResult
-	(*AccessTuple)(nil),          // 10: cosmos.evm.vm.v1.AccessTuple
-	(*TraceConfig)(nil),          // 11: cosmos.evm.vm.v1.TraceConfig
-	(*Preinstall)(nil),           // 12: cosmos.evm.vm.v1.Preinstall
-	(*EvmCoinInfo)(nil),          // 13: cosmos.evm.vm.v1.EvmCoinInfo
+	(AccessType)(0),               // 0: cosmos.evm.vm.v1.AccessType
+	(*Params)(nil),                // 1: cosmos.evm.vm.v1.Params
+	(*OpcodeGasOverride)(nil),     // 2: cosmos.evm.vm.v1.OpcodeGasOverride
+	(*ExtendedDenomOptions)(nil),  // 3: cosmos.evm.vm.v1.ExtendedDenomOptions
+	(*AccessControl)(nil),         // 4: cosmos.evm.vm.v1.AccessControl
+	(*ContractAccessControl)(nil), // 5: cosmos.evm.vm.v1.ContractAccessControl
+	(*FunctionAccessControl)(nil), // 6: cosmos.evm.vm.v1.FunctionAccessControl
+	(*AccessControlType)(nil),     // 7: cosmos.evm.vm.v1.AccessControlType
+	(*ChainConfig)(nil),           // 8: cosmos.evm.vm.v1.ChainConfig
+	(*HardFork)(nil),              // 9: cosmos.evm.vm.v1.HardFork
+	(*State)(nil),                 // 10: cosmos.evm.vm.v1.State
+	(*TransactionLogs)(nil),       // 11: cosmos.evm.vm.v1.TransactionLogs
+	(*Log)(nil),                   // 12: cosmos.evm.vm.v1.Log
+	(*TxResult)(nil),              // 13: cosmos.evm.vm.v1.TxResult
+	(*AccessTuple)(nil),           // 14: cosmos.evm.vm.v1.AccessTuple
+	(*TraceConfig)(nil),           // 15: cosmos.evm.vm.v1.TraceConfig
+	(*Preinstall)(nil),            // 16: cosmos.evm.vm.v1.Preinstall
+	(*EvmCoinInfo)(nil),           // 17: cosmos.evm.vm.v1.EvmCoinInfo
 }
 var file_cosmos_evm_vm_v1_evm_proto_depIdxs = []int32{
-	3, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
-	2, // 1: cosmos.evm.vm.v1.Params.extended_denom_options:type_name -> cosmos.evm.vm.v1.ExtendedDenomOptions
-	4, // 2: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
-	4, // 3: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
-	0, // 4: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
-	8, // 5: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
-	7, // 6: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
-	5, // 7: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
+	3,  // 1: cosmos.evm.vm.v1.Params.extended_denom_options:type_name -> cosmos.evm.vm.v1.ExtendedDenomOptions
+	2,  // 2: cosmos.evm.vm.v1.Params.opcode_gas_overrides:type_name -> cosmos.evm.vm.v1.OpcodeGasOverride
+	7,  // 3: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
+	7,  // 4: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
+	5,  // 5: cosmos.evm.vm.v1.AccessControl.contracts:type_name -> cosmos.evm.vm.v1.ContractAccessControl
+	7,  // 6: cosmos.evm.vm.v1.ContractAccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
+	6,  // 7: cosmos.evm.vm.v1.ContractAccessControl.functions:type_name -> cosmos.evm.vm.v1.FunctionAccessControl
+	7,  // 8: cosmos.evm.vm.v1.FunctionAccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
+	0,  // 9: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
+	12, // 10: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
+	11, // 11: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
+	8,  // 12: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_evm_proto_init() }
@@ -11379,7 +14311,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedDenomOptions); i {
+			switch v := v.(*OpcodeGasOverride); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11391,7 +14323,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*ExtendedDenomOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11403,7 +14335,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControlType); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11415,7 +14347,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*ContractAccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11427,7 +14359,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*FunctionAccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11439,7 +14371,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*AccessControlType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11451,7 +14383,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11463,7 +14395,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*HardFork); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11475,7 +14407,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11487,7 +14419,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceConfig); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11499,7 +14431,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Preinstall); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11511,6 +14443,54 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preinstall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EvmCoinInfo); i {
 			case 0:
 				return &v.state
@@ -11529,7 +14509,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,21 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Account_FullMethodName           = "/cosmos.evm.vm.v1.Query/Account"
-	Query_CosmosAccount_FullMethodName     = "/cosmos.evm.vm.v1.Query/CosmosAccount"
-	Query_ValidatorAccount_FullMethodName  = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
-	Query_Balance_FullMethodName           = "/cosmos.evm.vm.v1.Query/Balance"
-	Query_Storage_FullMethodName           = "/cosmos.evm.vm.v1.Query/Storage"
-	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
-	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
-	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
-	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
-	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
-	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
-	Query_TraceCall_FullMethodName         = "/cosmos.evm.vm.v1.Query/TraceCall"
-	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
-	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
-	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_Account_FullMethodName               = "/cosmos.evm.vm.v1.Query/Account"
+	Query_CosmosAccount_FullMethodName         = "/cosmos.evm.vm.v1.Query/CosmosAccount"
+	Query_ValidatorAccount_FullMethodName      = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
+	Query_Balance_FullMethodName               = "/cosmos.evm.vm.v1.Query/Balance"
+	Query_Storage_FullMethodName               = "/cosmos.evm.vm.v1.Query/Storage"
+	Query_Code_FullMethodName                  = "/cosmos.evm.vm.v1.Query/Code"
+	Query_StorageRange_FullMethodName          = "/cosmos.evm.vm.v1.Query/StorageRange"
+	Query_Accounts_FullMethodName              = "/cosmos.evm.vm.v1.Query/Accounts"
+	Query_Params_FullMethodName                = "/cosmos.evm.vm.v1.Query/Params"
+	Query_EthCall_FullMethodName               = "/cosmos.evm.vm.v1.Query/EthCall"
+	Query_EstimateGas_FullMethodName           = "/cosmos.evm.vm.v1.Query/EstimateGas"
+	Query_SimulateV1_FullMethodName            = "/cosmos.evm.vm.v1.Query/SimulateV1"
+	Query_CallMany_FullMethodName              = "/cosmos.evm.vm.v1.Query/CallMany"
+	Query_TraceTx_FullMethodName               = "/cosmos.evm.vm.v1.Query/TraceTx"
+	Query_TraceBlock_FullMethodName            = "/cosmos.evm.vm.v1.Query/TraceBlock"
+	Query_TraceCall_FullMethodName             = "/cosmos.evm.vm.v1.Query/TraceCall"
+	Query_BaseFee_FullMethodName               = "/cosmos.evm.vm.v1.Query/BaseFee"
+	Query_Config_FullMethodName                = "/cosmos.evm.vm.v1.Query/Config"
+	Query_HardForks_FullMethodName             = "/cosmos.evm.vm.v1.Query/HardForks"
+	Query_OpcodeGasSchedule_FullMethodName     = "/cosmos.evm.vm.v1.Query/OpcodeGasSchedule"
+	Query_ContractAccessControl_FullMethodName = "/cosmos.evm.vm.v1.Query/ContractAccessControl"
+	Query_GlobalMinGasPrice_FullMethodName     = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,12 +63,20 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// StorageRange queries the storage of a single account, ordered by key.
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// Accounts queries the Ethereum accounts, ordered by address.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
+	// CallMany implements the `eth_callMany` and `eth_callBundle` rpc apis
+	CallMany(ctx context.Context, in *CallManyRequest, opts ...grpc.CallOption) (*CallManyResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -75,6 +90,15 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// HardForks queries the EVM hard forks of the chain config, either active or
+	// scheduled, ordered by activation.
+	HardForks(ctx context.Context, in *QueryHardForksRequest, opts ...grpc.CallOption) (*QueryHardForksResponse, error)
+	// OpcodeGasSchedule queries the constant gas of the opcodes overridden by the
+	// params, along with their constant gas in the current hard fork.
+	OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error)
+	// ContractAccessControl queries the permission policies for calling a target
+	// contract and its functions.
+	ContractAccessControl(ctx context.Context, in *QueryContractAccessControlRequest, opts ...grpc.CallOption) (*QueryContractAccessControlResponse, error)
 	// GlobalMinGasPrice queries the MinGasPrice
 	// it's similar to feemarket module's method,
 	// but makes the conversion to 18 decimals
@@ -150,6 +174,26 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_Accounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -180,6 +224,26 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, Query_SimulateV1_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallMany(ctx context.Context, in *CallManyRequest, opts ...grpc.CallOption) (*CallManyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallManyResponse)
+	err := c.cc.Invoke(ctx, Query_CallMany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryTraceTxResponse)
@@ -230,6 +294,36 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) HardForks(ctx context.Context, in *QueryHardForksRequest, opts ...grpc.CallOption) (*QueryHardForksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHardForksResponse)
+	err := c.cc.Invoke(ctx, Query_HardForks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOpcodeGasScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_OpcodeGasSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractAccessControl(ctx context.Context, in *QueryContractAccessControlRequest, opts ...grpc.CallOption) (*QueryContractAccessControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractAccessControlResponse)
+	err := c.cc.Invoke(ctx, Query_ContractAccessControl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryGlobalMinGasPriceResponse)
@@ -260,12 +354,20 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// StorageRange queries the storage of a single account, ordered by key.
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// Accounts queries the Ethereum accounts, ordered by address.
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
+	// CallMany implements the `eth_callMany` and `eth_callBundle` rpc apis
+	CallMany(context.Context, *CallManyRequest) (*CallManyResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -279,6 +381,15 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// HardForks queries the EVM hard forks of the chain config, either active or
+	// scheduled, ordered by activation.
+	HardForks(context.Context, *QueryHardForksRequest) (*QueryHardForksResponse, error)
+	// OpcodeGasSchedule queries the constant gas of the opcodes overridden by the
+	// params, along with their constant gas in the current hard fork.
+	OpcodeGasSchedule(context.Context, *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error)
+	// ContractAccessControl queries the permission policies for calling a target
+	// contract and its functions.
+	ContractAccessControl(context.Context, *QueryContractAccessControlRequest) (*QueryContractAccessControlResponse, error)
 	// GlobalMinGasPrice queries the MinGasPrice
 	// it's similar to feemarket module's method,
 	// but makes the conversion to 18 decimals
@@ -312,6 +423,12 @@ func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (
 func (UnimplementedQueryServer) Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Code not implemented")
 }
+func (UnimplementedQueryServer) StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Accounts not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
@@ -321,6 +438,12 @@ func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgE
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedQueryServer) SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateV1 not implemented")
+}
+func (UnimplementedQueryServer) CallMany(context.Context, *CallManyRequest) (*CallManyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CallMany not implemented")
+}
 func (UnimplementedQueryServer) TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -336,6 +459,15 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Config not implemented")
 }
+func (UnimplementedQueryServer) HardForks(context.Context, *QueryHardForksRequest) (*QueryHardForksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HardForks not implemented")
+}
+func (UnimplementedQueryServer) OpcodeGasSchedule(context.Context, *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpcodeGasSchedule not implemented")
+}
+func (UnimplementedQueryServer) ContractAccessControl(context.Context, *QueryContractAccessControlRequest) (*QueryContractAccessControlResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ContractAccessControl not implemented")
+}
 func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
@@ -468,6 +600,42 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Accounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +690,42 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateV1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CallMany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallMany(ctx, req.(*CallManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -612,6 +816,60 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HardForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHardForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HardForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HardForks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HardForks(ctx, req.(*QueryHardForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpcodeGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpcodeGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OpcodeGasSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, req.(*QueryOpcodeGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractAccessControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractAccessControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAccessControl(ctx, req.(*QueryContractAccessControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGlobalMinGasPriceRequest)
 	if err := dec(in); err != nil {
@@ -661,6 +919,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -673,6 +939,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
+		{
+			MethodName: "CallMany",
+			Handler:    _Query_CallMany_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -693,6 +967,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "HardForks",
+			Handler:    _Query_HardForks_Handler,
+		},
+		{
+			MethodName: "OpcodeGasSchedule",
+			Handler:    _Query_OpcodeGasSchedule_Handler,
+		},
+		{
+			MethodName: "ContractAccessControl",
+			Handler:    _Query_ContractAccessControl_Handler,
+		},
 		{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
@@ -22,6 +22,8 @@ const (
 	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
 	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
 	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_ScheduleHardFork_FullMethodName    = "/cosmos.evm.vm.v1.Msg/ScheduleHardFork"
+	Msg_UpdateChainConfig_FullMethodName   = "/cosmos.evm.vm.v1.Msg/UpdateChainConfig"
 )
 
 // MsgClient is the client API for Msg service.
@@ -40,6 +42,14 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleHardFork defines a governance operation for scheduling the
+	// activation of an EVM hard fork in the chain config. The authority is the
+	// same as is used for Params updates.
+	ScheduleHardFork(ctx context.Context, in *MsgScheduleHardFork, opts ...grpc.CallOption) (*MsgScheduleHardForkResponse, error)
+	// UpdateChainConfig defines a governance operation for replacing the EVM
+	// chain config. Only the hard forks that are not active yet can be changed.
+	// The authority is the same as is used for Params updates.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +90,26 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) ScheduleHardFork(ctx context.Context, in *MsgScheduleHardFork, opts ...grpc.CallOption) (*MsgScheduleHardForkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleHardForkResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleHardFork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateChainConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -96,6 +126,14 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleHardFork defines a governance operation for scheduling the
+	// activation of an EVM hard fork in the chain config. The authority is the
+	// same as is used for Params updates.
+	ScheduleHardFork(context.Context, *MsgScheduleHardFork) (*MsgScheduleHardForkResponse, error)
+	// UpdateChainConfig defines a governance operation for replacing the EVM
+	// chain config. Only the hard forks that are not active yet can be changed.
+	// The authority is the same as is used for Params updates.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -115,6 +153,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) ScheduleHardFork(context.Context, *MsgScheduleHardFork) (*MsgScheduleHardForkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleHardFork not implemented")
+}
+func (UnimplementedMsgServer) UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -190,6 +234,42 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleHardFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleHardFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleHardFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleHardFork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleHardFork(ctx, req.(*MsgScheduleHardFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -209,6 +289,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "ScheduleHardFork",
+			Handler:    _Msg_ScheduleHardFork_Handler,
+		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _Msg_UpdateChainConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -9,9 +9,11 @@ import (
 
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/spf13/cast"
 
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/live"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -40,6 +42,7 @@ import (
 	transferv2 "github.com/cosmos/evm/x/ibc/transfer/v2"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	_ "github.com/cosmos/evm/x/vm/tracers"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
@@ -463,6 +466,16 @@ func NewExampleApp(
 		),
 	)
 
+	// Set up the live tracer streaming the execution of the blocks, if any
+	if liveTracer := cast.ToString(appOpts.Get(srvflags.EVMLiveTracer)); liveTracer != "" {
+		liveTracerConfig := json.RawMessage(cast.ToString(appOpts.Get(srvflags.EVMLiveTracerConfig)))
+		hooks, err := tracers.LiveDirectory.New(liveTracer, liveTracerConfig)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create live tracer %s: %s", liveTracer, err.Error()))
+		}
+		app.EVMKeeper.WithLiveTracer(hooks)
+	}
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
 		appCodec,
@@ -1056,6 +1069,8 @@ func (app *EVMD) Close() error {
 		err = m.Close()
 	}
 
+	app.EVMKeeper.CloseLiveTracer()
+
 	msg := "Application gracefully shutdown"
 	err = errors.Join(err, app.BaseApp.Close())
 	if err == nil {
@@ -1,9 +1,15 @@
 package indexer
 
 import (
+	"bytes"
+	"cmp"
+	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -15,6 +21,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log/v2"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,14 +30,30 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash       = 1
+	KeyPrefixTxIndex      = 2
+	KeyPrefixLogAddress   = 3
+	KeyPrefixLogTopic     = 4
+	KeyPrefixLogBlock     = 5
+	KeyPrefixAddressTx    = 6
+	KeyPrefixCreation     = 7
+	KeyPrefixAddressBlock = 8
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// LogBlockKeyLength is the length of log-block and address-block keys
+	LogBlockKeyLength = 1 + 8
+	// logPositionLength is the length of the log position suffix of the log keys
+	logPositionLength = 8 + 8
+	// addressTxKeyLength is the length of address-tx key
+	addressTxKeyLength = 1 + common.AddressLength + 8 + 8
 )
 
-var _ servertypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ servertypes.EVMTxIndexer      = &KVIndexer{}
+	_ servertypes.EVMLogIndexer     = &KVIndexer{}
+	_ servertypes.EVMAddressIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -49,6 +72,9 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Stores the tx hash by sender, recipient and created contract of every message
+// - Stores the positions of the logs of the Tx by address and topic
+// - Marks the block as indexed in the log and address indexes
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
@@ -57,6 +83,8 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// record index of the logs within the block, as the logs of a tx are indexed from 0
+	var logIndex uint64
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSucessOrExpectedFailure(result) {
@@ -115,7 +143,29 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if err := saveTxAddresses(batch, ethMsg, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+		logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
 		}
+		if err := saveLogPositions(batch, logs, logIndex); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		logIndex += uint64(len(logs))
+	}
+	if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set log-block key", height)
+	}
+	if err := batch.Set(AddressBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set address-block key", height)
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
@@ -161,6 +211,164 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*serv
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// FirstIndexedLogBlock returns the first block number covered by the log index,
+// returns -1 if the log index is empty
+func (kv *KVIndexer) FirstIndexedLogBlock() (int64, error) {
+	return loadMarkedBlock(kv.db, KeyPrefixLogBlock, false)
+}
+
+// LastIndexedLogBlock returns the latest block number covered by the log index,
+// returns -1 if the log index is empty
+func (kv *KVIndexer) LastIndexedLogBlock() (int64, error) {
+	return loadMarkedBlock(kv.db, KeyPrefixLogBlock, true)
+}
+
+// GetLogPositions finds the positions of the logs in the [from, to] block range
+// matching the given addresses and topics. Each non-empty address or topic list
+// is a clause matching any of its values, and the returned positions match all
+// the clauses.
+func (kv *KVIndexer) GetLogPositions(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]servertypes.LogPosition, error) {
+	var prefixes [][][]byte
+	if len(addresses) > 0 {
+		clause := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			clause[i] = logAddressPrefix(address)
+		}
+		prefixes = append(prefixes, clause)
+	}
+	for i, topicList := range topics {
+		if len(topicList) == 0 {
+			continue
+		}
+		clause := make([][]byte, len(topicList))
+		for j, topic := range topicList {
+			clause[j] = logTopicPrefix(i, topic)
+		}
+		prefixes = append(prefixes, clause)
+	}
+	if len(prefixes) == 0 {
+		return nil, errors.New("GetLogPositions: at least one address or topic is required")
+	}
+
+	var matches map[servertypes.LogPosition]struct{}
+	for _, clause := range prefixes {
+		positions := make(map[servertypes.LogPosition]struct{})
+		for _, prefix := range clause {
+			if err := kv.iterateLogPositions(prefix, from, to, func(pos servertypes.LogPosition) {
+				if _, ok := matches[pos]; matches == nil || ok {
+					positions[pos] = struct{}{}
+				}
+			}); err != nil {
+				return nil, errorsmod.Wrap(err, "GetLogPositions")
+			}
+		}
+		matches = positions
+		if len(matches) == 0 {
+			break
+		}
+	}
+
+	res := make([]servertypes.LogPosition, 0, len(matches))
+	for pos := range matches {
+		res = append(res, pos)
+	}
+	slices.SortFunc(res, func(a, b servertypes.LogPosition) int {
+		if a.Height != b.Height {
+			return cmp.Compare(a.Height, b.Height)
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return res, nil
+}
+
+// iterateLogPositions calls fn with the positions of the log entries under the
+// given prefix in the [from, to] block range.
+func (kv *KVIndexer) iterateLogPositions(prefix []byte, from, to int64, fn func(servertypes.LogPosition)) error {
+	start := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115 // block number won't exceed uint64
+	end := append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115 // block number won't exceed uint64
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		fn(parseLogPositionFromKey(it.Key()))
+	}
+	return it.Error()
+}
+
+// FirstIndexedAddressBlock returns the first block number covered by the
+// address index, returns -1 if the address index is empty
+func (kv *KVIndexer) FirstIndexedAddressBlock() (int64, error) {
+	return loadMarkedBlock(kv.db, KeyPrefixAddressBlock, false)
+}
+
+// GetAddressTxs finds the hashes of the eth txs sent or received by the address,
+// including the contract creations, in the blocks before the given height when
+// reverse is true and after it otherwise. A height lower than one isn't bounding.
+// The txs are ordered from the closest to the given height, and whole blocks are
+// returned until at least limit txs are found, so more than limit txs may be
+// returned. The boolean result reports whether other txs remain beyond them.
+func (kv *KVIndexer) GetAddressTxs(address common.Address, height int64, reverse bool, limit int) ([]common.Hash, bool, error) {
+	prefix := addressTxPrefix(address)
+	start, end := prefix, storetypes.PrefixEndBytes(prefix)
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		if height > 0 {
+			end = append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(height))...) //nolint:gosec // G115 // block number won't exceed uint64
+		}
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		if height > 0 {
+			start = append(bytes.Clone(prefix), sdk.Uint64ToBigEndian(uint64(height+1))...) //nolint:gosec // G115 // block number won't exceed uint64
+		}
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetAddressTxs")
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64
+	)
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != addressTxKeyLength {
+			return nil, false, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", addressTxKeyLength, len(key))
+		}
+		txHeight := int64(sdk.BigEndianToUint64(key[1+common.AddressLength:])) //#nosec G115 -- int overflow is not a concern here
+		if len(hashes) >= limit && txHeight != lastHeight {
+			return hashes, true, nil
+		}
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+		lastHeight = txHeight
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, errorsmod.Wrap(err, "GetAddressTxs")
+	}
+	return hashes, false, nil
+}
+
+// GetContractCreationTx finds the hash of the eth tx that created the contract,
+// returns nil if not found. Only the contracts created by the txs themselves are
+// indexed, not the ones created by other contracts.
+func (kv *KVIndexer) GetContractCreationTx(address common.Address) (*common.Hash, error) {
+	bz, err := kv.db.Get(CreationKey(address))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreationTx %s", address.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +381,59 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> tx hash`,
+// the log index being the index of the log within the block
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	return append(logAddressPrefix(address), logPosition(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> tx hash`,
+// the log index being the index of the log within the block
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	return append(logTopicPrefix(position, topic), logPosition(blockNumber, logIndex)...)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, marking the
+// block as covered by the log index
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// AddressBlockKey returns the key for db entry: `block number -> nil`, marking
+// the block as covered by the address index
+func AddressBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixAddressBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> tx hash`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(append(addressTxPrefix(address), bz1...), bz2...)
+}
+
+// CreationKey returns the key for db entry: `contract address -> tx hash`
+func CreationKey(address common.Address) []byte {
+	return append([]byte{KeyPrefixCreation}, address.Bytes()...)
+}
+
+func addressTxPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddressTx}, address.Bytes()...)
+}
+
+func logAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+func logTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //nolint:gosec // G115 // logs have at most 4 topics
+}
+
+func logPosition(blockNumber int64, logIndex uint64) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	return append(bz, sdk.Uint64ToBigEndian(logIndex)...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -199,6 +460,32 @@ func LoadFirstBlock(db dbm.DB) (int64, error) {
 	return parseBlockNumberFromKey(it.Key())
 }
 
+// loadMarkedBlock loads the first or the latest block marked by the keys of the
+// given prefix, i.e. covered by the log or the address index, returns -1 if the
+// index is empty
+func loadMarkedBlock(db dbm.DB, prefix byte, reverse bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = db.ReverseIterator([]byte{prefix}, []byte{prefix + 1})
+	} else {
+		it, err = db.Iterator([]byte{prefix}, []byte{prefix + 1})
+	}
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "loadMarkedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	if len(it.Key()) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong block marker key length, expect: %d, got: %d", LogBlockKeyLength, len(it.Key()))
+	}
+	return int64(sdk.BigEndianToUint64(it.Key()[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
+
 // isEthTx check if the tx is an eth tx
 func isEthTx(tx sdk.Tx) bool {
 	extTx, ok := tx.(authante.HasExtensionOptionsTx)
@@ -224,6 +511,58 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveTxAddresses index the tx hash by sender, recipient and created contract into the kv db batch.
+// Only the addresses of the tx itself are indexed, not the ones of its internal calls and
+// creations, which would require tracing the tx.
+func saveTxAddresses(batch dbm.Batch, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	txHash := msg.Hash()
+	from := msg.GetSender()
+	addresses := []common.Address{from}
+	tx := msg.AsTransaction()
+	if to := tx.To(); to != nil {
+		addresses = append(addresses, *to)
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(from, tx.Nonce())
+		addresses = append(addresses, contract)
+		if err := batch.Set(CreationKey(contract), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set creation key")
+		}
+	}
+	for _, address := range addresses {
+		if err := batch.Set(AddressTxKey(address, txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	return nil
+}
+
+// saveLogPositions index the positions of the logs of a tx by address and topics into the kv db
+// batch, the logs being positioned in the block from the given index
+func saveLogPositions(batch dbm.Batch, logs []*ethtypes.Log, firstIndex uint64) error {
+	for i, log := range logs {
+		height := int64(log.BlockNumber) //#nosec G115 -- int overflow is not a concern here
+		index := firstIndex + uint64(i)  //#nosec G115 -- int overflow is not a concern here
+		if err := batch.Set(LogAddressKey(log.Address, height, index), log.TxHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+		for j, topic := range log.Topics {
+			if err := batch.Set(LogTopicKey(j, topic, height, index), log.TxHash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	return nil
+}
+
+// parseLogPositionFromKey parses the log position from the suffix of a log-address or log-topic key
+func parseLogPositionFromKey(key []byte) servertypes.LogPosition {
+	suffix := key[len(key)-logPositionLength:]
+	return servertypes.LogPosition{
+		Height: int64(sdk.BigEndianToUint64(suffix[:8])), //#nosec G115 -- int overflow is not a concern here
+		Index:  sdk.BigEndianToUint64(suffix[8:]),
+	}
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
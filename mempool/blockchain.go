@@ -72,10 +72,25 @@ func NewBlockchain(ctx func(height int64, prove bool) (sdk.Context, error), logg
 	}
 }
 
-// Config returns the Ethereum chain configuration. It should only be called after the chain is initialized.
-// This provides the necessary parameters for EVM execution and transaction validation.
+// Config returns the Ethereum chain configuration stored in the latest state, or the configured one if
+// the context is not available. This provides the necessary parameters for EVM execution and transaction
+// validation.
 func (b *Blockchain) Config() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+	ctx, err := b.GetLatestContext()
+	if err != nil {
+		return evmtypes.GetEthChainConfig()
+	}
+	return b.vmKeeper.GetEthChainConfig(ctx)
+}
+
+// MaxInitCodeSize returns the maximum size of the init code of a creation transaction
+// set in the EVM params, or zero for the Ethereum limit if the context is not available.
+func (b *Blockchain) MaxInitCodeSize() uint64 {
+	ctx, err := b.GetLatestContext()
+	if err != nil {
+		return 0
+	}
+	return b.vmKeeper.GetParams(ctx).InitCodeSizeLimit()
 }
 
 // CurrentBlock returns the current block header for the app.
@@ -109,7 +124,7 @@ func (b *Blockchain) CurrentBlock() *types.Header {
 		Difficulty: big.NewInt(0), // 0 difficulty on PoS
 	}
 
-	chainConfig := evmtypes.GetEthChainConfig()
+	chainConfig := b.vmKeeper.GetEthChainConfig(ctx)
 	if chainConfig.IsLondon(header.Number) {
 		baseFee := b.vmKeeper.GetBaseFee(ctx)
 		if baseFee != nil {
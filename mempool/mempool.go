@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -502,16 +503,17 @@ func (m *ExperimentalEVMMempool) defaultBroadcastTxFn(txs []*ethtypes.Transactio
 	m.logger.Debug("broadcasting EVM transactions", "tx_count", len(txs))
 
 	// Apply the broadcast EVM transactions using the client context
-	return broadcastEVMTransactions(m.clientCtx, txs)
+	return broadcastEVMTransactions(m.clientCtx, m.blockchain.Config(), txs)
 }
 
 // broadcastEVMTransactions converts Ethereum transactions to Cosmos SDK format and broadcasts them.
 // This function wraps EVM transactions in MsgEthereumTx messages and submits them to the network
-// using the provided client context. It handles encoding and error reporting for each transaction.
-func broadcastEVMTransactions(clientCtx client.Context, ethTxs []*ethtypes.Transaction) error {
+// using the provided client context, recovering the senders with the latest signer of the given
+// chain config. It handles encoding and error reporting for each transaction.
+func broadcastEVMTransactions(clientCtx client.Context, chainConfig *params.ChainConfig, ethTxs []*ethtypes.Transaction) error {
+	ethSigner := ethtypes.LatestSigner(chainConfig)
 	for _, ethTx := range ethTxs {
 		msg := &evmtypes.MsgEthereumTx{}
-		ethSigner := ethtypes.LatestSigner(evmtypes.GetEthChainConfig())
 		if err := msg.FromSignedEthereumTx(ethTx, ethSigner); err != nil {
 			return fmt.Errorf("failed to convert ethereum transaction: %w", err)
 		}
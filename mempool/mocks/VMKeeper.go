@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	params "github.com/ethereum/go-ethereum/params"
+
 	statedb "github.com/cosmos/evm/x/vm/statedb"
 
 	storetypes "cosmossdk.io/store/types"
@@ -137,6 +139,26 @@ func (_m *VMKeeper) GetCodeHash(ctx types.Context, addr common.Address) common.H
 	return r0
 }
 
+// GetEthChainConfig provides a mock function with given fields: ctx
+func (_m *VMKeeper) GetEthChainConfig(ctx types.Context) *params.ChainConfig {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEthChainConfig")
+	}
+
+	var r0 *params.ChainConfig
+	if rf, ok := ret.Get(0).(func(types.Context) *params.ChainConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*params.ChainConfig)
+		}
+	}
+
+	return r0
+}
+
 // GetEvmCoinInfo provides a mock function with given fields: ctx
 func (_m *VMKeeper) GetEvmCoinInfo(ctx types.Context) vmtypes.EvmCoinInfo {
 	ret := _m.Called(ctx)
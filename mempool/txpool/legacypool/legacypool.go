@@ -135,6 +135,14 @@ type BlockChain interface {
 	StateAt(root common.Hash) (vm.StateDB, error)
 }
 
+// initCodeSizeLimitedChain is implemented by the chains limiting the size of the
+// init code of the creation transactions differently from Ethereum.
+type initCodeSizeLimitedChain interface {
+	// MaxInitCodeSize returns the maximum size of the init code of a creation
+	// transaction, or zero for the Ethereum limit.
+	MaxInitCodeSize() uint64
+}
+
 // Config are the configuration parameters of the transaction pool.
 type Config struct {
 	Locals    []common.Address // Addresses that should be treated by default as local
@@ -576,6 +584,9 @@ func (pool *LegacyPool) ValidateTxBasics(tx *types.Transaction) error {
 		MaxSize: txMaxSize,
 		MinTip:  pool.gasTip.Load().ToBig(),
 	}
+	if chain, ok := pool.chain.(initCodeSizeLimitedChain); ok {
+		opts.MaxInitCodeSize = chain.MaxInitCodeSize()
+	}
 	return txpool.ValidateTransaction(tx, pool.currentHead.Load(), pool.signer, opts)
 }
 
@@ -40,9 +40,10 @@ var blobTxMinBlobGasPrice = big.NewInt(params.BlobTxMinBlobGasprice)
 type ValidationOptions struct {
 	Config *params.ChainConfig // Chain configuration to selectively validate based on current fork rules
 
-	Accept  uint8    // Bitmap of transaction types that should be accepted for the calling pool
-	MaxSize uint64   // Maximum size of a transaction that the caller can meaningfully handle
-	MinTip  *big.Int // Minimum gas tip needed to allow a transaction into the caller pool
+	Accept          uint8    // Bitmap of transaction types that should be accepted for the calling pool
+	MaxSize         uint64   // Maximum size of a transaction that the caller can meaningfully handle
+	MaxInitCodeSize uint64   // Maximum size of the init code of a creation transaction, the Ethereum limit if zero
+	MinTip          *big.Int // Minimum gas tip needed to allow a transaction into the caller pool
 }
 
 // ValidationFunction is an method type which the pools use to perform the tx-validations which do not
@@ -81,8 +82,12 @@ func ValidateTransaction(tx *types.Transaction, head *types.Header, signer types
 		return fmt.Errorf("%w: type %d rejected, pool not yet in Prague", core.ErrTxTypeNotSupported, tx.Type())
 	}
 	// Check whether the init code size has been exceeded
-	if rules.IsShanghai && tx.To() == nil && len(tx.Data()) > params.MaxInitCodeSize {
-		return fmt.Errorf("%w: code size %v, limit %v", core.ErrMaxInitCodeSizeExceeded, len(tx.Data()), params.MaxInitCodeSize)
+	maxInitCodeSize := opts.MaxInitCodeSize
+	if maxInitCodeSize == 0 {
+		maxInitCodeSize = params.MaxInitCodeSize
+	}
+	if rules.IsShanghai && tx.To() == nil && uint64(len(tx.Data())) > maxInitCodeSize {
+		return fmt.Errorf("%w: code size %v, limit %v", core.ErrMaxInitCodeSizeExceeded, len(tx.Data()), maxInitCodeSize)
 	}
 	// Transactions can't be negative. This may never happen using RLP decoded
 	// transactions but may occur for transactions created using the RPC.
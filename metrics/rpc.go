@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+)
+
+// The JSON-RPC metrics are registered in the geth metrics registry, and are
+// served by the geth metrics server in the Prometheus format.
+const rpcMetricsPrefix = "evm/rpc"
+
+// UnknownRPCMethod is the method label of the requests calling methods that are
+// not served, which are counted together to bound the number of metrics.
+const UnknownRPCMethod = "unknown"
+
+var rpcBatchSize = gethmetrics.NewRegisteredHistogram(rpcMetricsPrefix+"/batch/size", nil, gethmetrics.NewExpDecaySample(1028, 0.015))
+
+// RecordRPCRequest records a JSON-RPC request to the method, with its serving
+// time and its error code, or 0 if it succeeded. The error codes are recorded
+// without their sign to yield valid Prometheus names.
+func RecordRPCRequest(method string, code int, elapsed time.Duration) {
+	gethmetrics.GetOrRegisterCounter(fmt.Sprintf("%s/requests/%s", rpcMetricsPrefix, method), nil).Inc(1)
+	gethmetrics.GetOrRegisterTimer(fmt.Sprintf("%s/duration/%s", rpcMetricsPrefix, method), nil).Update(elapsed)
+	if code != 0 {
+		gethmetrics.GetOrRegisterCounter(fmt.Sprintf("%s/errors/%s/%d", rpcMetricsPrefix, method, max(code, -code)), nil).Inc(1)
+	}
+}
+
+// RecordRPCBatch records the number of requests of a JSON-RPC batch.
+func RecordRPCBatch(size int) {
+	rpcBatchSize.Update(int64(size))
+}
+
+// RPCSubscriptions returns the gauge of the active subscriptions of the given
+// type, e.g. newHeads or logs.
+func RPCSubscriptions(typ string) *gethmetrics.Gauge {
+	return gethmetrics.GetOrRegisterGauge(fmt.Sprintf("%s/subscriptions/%s", rpcMetricsPrefix, typ), nil)
+}
+
+// RPCFilters returns the gauge of the installed filters of the given type,
+// e.g. blocks or logs.
+func RPCFilters(typ string) *gethmetrics.Gauge {
+	return gethmetrics.GetOrRegisterGauge(fmt.Sprintf("%s/filters/%s", rpcMetricsPrefix, typ), nil)
+}
@@ -2,20 +2,27 @@ package rpc
 
 import (
 	"fmt"
+	"reflect"
+	"unicode"
 
 	"github.com/ethereum/go-ethereum/rpc"
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/cosmos"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/server/config"
 	servertypes "github.com/cosmos/evm/server/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -37,6 +44,9 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
+	AdminNamespace    = "admin"
 
 	apiVersion = "1.0"
 )
@@ -68,7 +78,7 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   eth.NewPublicAPI(ctx.Logger, evmBackend),
+					Service:   eth.NewPublicAPI(ctx.Logger, evmBackend, stream),
 					Public:    true,
 				},
 				{
@@ -167,6 +177,75 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		AdminNamespace: func(ctx *server.Context, clientCtx client.Context, _ *stream.RPCStream, _ bool, _ servertypes.EVMTxIndexer, _ *evmmempool.ExperimentalEVMMempool) []rpc.API {
+			cfg, err := config.GetConfig(ctx.Viper)
+			if err != nil {
+				panic(err)
+			}
+			if !cfg.JSONRPC.EnableUnsafeAdmin {
+				ctx.Logger.Error("admin namespace requires json-rpc.enable-unsafe-admin to be set", "namespace", AdminNamespace)
+				return nil
+			}
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx),
+					Public:    false,
+				},
+			}
+		},
+		CosmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewAPI(ctx, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -192,6 +271,23 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// MethodNames returns the names of the JSON-RPC methods of the APIs, as named
+// by the geth server, including the subscription methods of their namespaces.
+func MethodNames(apis []rpc.API) []string {
+	var names []string
+	for _, api := range apis {
+		names = append(names, api.Namespace+"_subscribe", api.Namespace+"_unsubscribe")
+		service := reflect.TypeOf(api.Service)
+		for i := range service.NumMethod() {
+			// the server lowercases the first letter of the method names
+			name := []rune(service.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			names = append(names, api.Namespace+"_"+string(name))
+		}
+	}
+	return names
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
@@ -0,0 +1,21 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testAPI struct{}
+
+func (testAPI) BlockNumber() uint64 { return 0 }
+
+func (testAPI) GetBalance() uint64 { return 0 }
+
+func (testAPI) unexported() {} //nolint:unused // not served
+
+func TestMethodNames(t *testing.T) {
+	names := MethodNames([]rpc.API{{Namespace: "eth", Service: testAPI{}}})
+	require.ElementsMatch(t, []string{"eth_subscribe", "eth_unsubscribe", "eth_blockNumber", "eth_getBalance"}, names)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // BackendI implements the Cosmos and EVM backend.
@@ -53,10 +54,13 @@ type EVMBackend interface {
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
-	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
-	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
-	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCGasCap() uint64                  // global gas cap for eth_call over rpc: DoS protection
+	RPCEVMTimeout() time.Duration       // global timeout for eth_call over rpc: DoS protection
+	RPCTxSyncTimeout() time.Duration    // default time eth_sendRawTransactionSync waits for the receipt
+	RPCTxSyncMaxTimeout() time.Duration // max time eth_sendRawTransactionSync waits for the receipt
+	RPCTxFeeCap() float64               // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
+	RPCBlockRangeCap() int32 // max block range allowed for queries over a range of blocks
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -93,7 +97,7 @@ type EVMBackend interface {
 
 	// Chain Info
 	ChainID(ctx context.Context) (*hexutil.Big, error)
-	ChainConfig() *params.ChainConfig
+	ChainConfig(height int64) *params.ChainConfig
 	GlobalMinGasPrice(ctx context.Context) (*big.Int, error)
 	BaseFee(ctx context.Context, blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error)
 	CurrentHeader(ctx context.Context) (*ethtypes.Header, error)
@@ -112,6 +116,8 @@ type EVMBackend interface {
 	GetTransactionByBlockHashAndIndex(ctx context.Context, hash common.Hash, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNum types.BlockNumber, idx hexutil.Uint) (*types.RPCTransaction, error)
 	CreateAccessList(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, overrides *json.RawMessage) (*types.AccessListResult, error)
+	AddressTxs(ctx context.Context, address common.Address, height int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	ContractCreationTx(ctx context.Context, address common.Address) (*common.Hash, error)
 
 	// Send Transaction
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -119,11 +125,16 @@ type EVMBackend interface {
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(ctx context.Context, opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]map[string]interface{}, error)
+	CallMany(ctx context.Context, bundles []types.Bundle, stateCtx types.StateContext, overrides *json.RawMessage, timeoutMs *int64) ([][]map[string]interface{}, error)
+	CallBundle(ctx context.Context, args types.CallBundleArgs) (map[string]interface{}, error)
 	GasPrice(ctx context.Context) (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	PendingLogs(ctx context.Context) ([]*ethtypes.Log, error)
+	IndexedLogHeights(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -136,6 +147,17 @@ type EVMBackend interface {
 	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(ctx context.Context, height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+
+	// State inspection
+	StorageRangeAt(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (types.StorageRangeResult, error)
+	AccountRange(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool) (types.Dump, error)
+
+	// Cosmos Info
+	GetCosmosTxByEthHash(ctx context.Context, hash common.Hash) (*sdktx.GetTxResponse, error)
+	GetEthTxsByCosmosHash(ctx context.Context, hash string) ([]*types.RPCTransaction, error)
+	GetCosmosAccount(ctx context.Context, address sdk.AccAddress, blockNrOrHash types.BlockNumberOrHash) (*types.CosmosAccount, error)
+	GetDenomBalances(ctx context.Context, address sdk.AccAddress, blockNrOrHash types.BlockNumberOrHash) (sdk.Coins, error)
+	BroadcastCosmosTx(ctx context.Context, txBytes []byte) (string, error)
 }
 
 var (
@@ -179,6 +201,10 @@ type Backend struct {
 	Indexer             servertypes.EVMTxIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             *evmmempool.ExperimentalEVMMempool
+
+	pendingLogs *pendingLogsCache
+	caches      backendCaches
+	gasOracle   *gasPriceOracle
 }
 
 func (b *Backend) GetConfig() config.Config {
@@ -214,6 +240,9 @@ func NewBackend(
 		AllowUnprotectedTxs: allowUnprotectedTxs,
 		Indexer:             indexer,
 		Mempool:             mempool,
+		pendingLogs:         &pendingLogsCache{},
+		caches:              newBackendCaches(appConf.JSONRPC),
+		gasOracle:           newGasPriceOracle(appConf.JSONRPC.GasPriceOracle, appConf.EVM.MinTip),
 	}
 	b.ProcessBlocker = b.ProcessBlock
 	return b
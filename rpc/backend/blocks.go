@@ -67,7 +67,12 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	key := rpcBlockKey{height: resBlock.Block.Height, fullTx: fullTx}
+	if result, ok := b.caches.rpcBlocks.Get(key); ok {
+		return result, nil
+	}
+
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "height", blockNum, "error", err.Error())
 		return nil, nil
@@ -79,6 +84,7 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, err
 	}
 
+	b.caches.rpcBlocks.Add(key, result)
 	return result, nil
 }
 
@@ -98,7 +104,12 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, nil
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &resBlock.Block.Height)
+	key := rpcBlockKey{height: resBlock.Block.Height, fullTx: fullTx}
+	if result, ok := b.caches.rpcBlocks.Get(key); ok {
+		return result, nil
+	}
+
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		b.Logger.Debug("failed to fetch block result from CometBFT", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
@@ -110,6 +121,7 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, err
 	}
 
+	b.caches.rpcBlocks.Add(key, result)
 	return result, nil
 }
 
@@ -216,7 +228,11 @@ func (b *Backend) GetBlockReceipts(
 		return nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, blockNum.CmtHeight())
+	if result, ok := b.caches.blockReceipts.Get(resBlock.Block.Height); ok {
+		return result, nil
+	}
+
+	blockRes, err := b.blockResults(ctx, &resBlock.Block.Height)
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -247,5 +263,7 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
 	}
+
+	b.caches.blockReceipts.Add(resBlock.Block.Height, result)
 	return result, nil
 }
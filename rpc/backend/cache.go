@@ -0,0 +1,88 @@
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+// rpcBlockKey identifies a JSON-RPC block, which differs depending on whether
+// the full transactions are included.
+type rpcBlockKey struct {
+	height int64
+	fullTx bool
+}
+
+// backendCaches holds the in-memory caches of the finalized chain data served by
+// the backend. CometBFT has instant finality, so committed blocks and their
+// results never change and the entries don't need to be invalidated. The zero
+// value has all the caches disabled.
+type backendCaches struct {
+	blocks        *cache[int64, *cmtrpctypes.ResultBlock]
+	blockResults  *cache[int64, *cmtrpctypes.ResultBlockResults]
+	rpcBlocks     *cache[rpcBlockKey, map[string]interface{}]
+	blockReceipts *cache[int64, []map[string]interface{}]
+	receipts      *cache[common.Hash, map[string]interface{}]
+	chainConfigs  *cache[int64, *params.ChainConfig]
+}
+
+// newBackendCaches creates the caches with the sizes of the JSON-RPC config.
+func newBackendCaches(cfg config.JSONRPCConfig) backendCaches {
+	return backendCaches{
+		blocks:        newCache[int64, *cmtrpctypes.ResultBlock]("blocks", cfg.BlockCacheSize),
+		blockResults:  newCache[int64, *cmtrpctypes.ResultBlockResults]("block_results", cfg.BlockCacheSize),
+		rpcBlocks:     newCache[rpcBlockKey, map[string]interface{}]("rpc_blocks", cfg.RPCBlockCacheSize),
+		blockReceipts: newCache[int64, []map[string]interface{}]("block_receipts", cfg.RPCBlockCacheSize),
+		receipts:      newCache[common.Hash, map[string]interface{}]("receipts", cfg.ReceiptCacheSize),
+		chainConfigs:  newCache[int64, *params.ChainConfig]("chain_configs", cfg.BlockCacheSize),
+	}
+}
+
+// cache is a size-bounded LRU cache reporting its hits and misses to the geth
+// metrics registry, served by the JSON-RPC metrics server. A nil cache is
+// disabled and never holds any entry.
+type cache[K comparable, V any] struct {
+	lru    *lru.Cache[K, V]
+	hits   *metrics.Counter
+	misses *metrics.Counter
+}
+
+// newCache returns a new cache holding up to size entries, or nil if size is
+// not positive.
+func newCache[K comparable, V any](name string, size int) *cache[K, V] {
+	if size <= 0 {
+		return nil
+	}
+	return &cache[K, V]{
+		lru:    lru.NewCache[K, V](size),
+		hits:   metrics.GetOrRegisterCounter("rpc/backend/cache/"+name+"/hit", nil),
+		misses: metrics.GetOrRegisterCounter("rpc/backend/cache/"+name+"/miss", nil),
+	}
+}
+
+// Get returns the cached value of the key, if any.
+func (c *cache[K, V]) Get(key K) (value V, ok bool) {
+	if c == nil {
+		return value, false
+	}
+	value, ok = c.lru.Get(key)
+	if ok {
+		c.hits.Inc(1)
+	} else {
+		c.misses.Inc(1)
+	}
+	return value, ok
+}
+
+// Add caches the value of the key.
+func (c *cache[K, V]) Add(key K, value V) {
+	if c == nil {
+		return
+	}
+	c.lru.Add(key, value)
+}
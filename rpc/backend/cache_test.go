@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestCache(t *testing.T) {
+	var disabled *cache[int64, string]
+	disabled.Add(1, "a")
+	_, ok := disabled.Get(1)
+	require.False(t, ok)
+	require.Nil(t, newCache[int64, string]("test_disabled", 0))
+
+	c := newCache[int64, string]("test", 2)
+	hits, misses := c.hits.Snapshot().Count(), c.misses.Snapshot().Count()
+
+	_, ok = c.Get(1)
+	require.False(t, ok)
+	c.Add(1, "a")
+	c.Add(2, "b")
+	value, ok := c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "a", value)
+
+	// the least recently used entry is evicted
+	c.Add(3, "c")
+	_, ok = c.Get(2)
+	require.False(t, ok)
+
+	require.Equal(t, hits+1, c.hits.Snapshot().Count())
+	require.Equal(t, misses+2, c.misses.Snapshot().Count())
+}
+
+func TestCometBlockByNumberCache(t *testing.T) {
+	height := int64(5)
+	resBlock := &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}
+
+	testCases := []struct {
+		name      string
+		cfg       config.JSONRPCConfig
+		expCalls  int
+		expCached bool
+	}{
+		{"cache enabled", config.JSONRPCConfig{BlockCacheSize: 1}, 1, true},
+		{"cache disabled", config.JSONRPCConfig{}, 2, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			backend.caches = newBackendCaches(tc.cfg)
+			client := backend.ClientCtx.Client.(*mocks.Client)
+			client.On("Block", mock.Anything, &height).Return(resBlock, nil).Times(tc.expCalls)
+
+			for range 2 {
+				res, err := backend.CometBlockByNumber(context.Background(), rpctypes.BlockNumber(height))
+				require.NoError(t, err)
+				require.Equal(t, resBlock, res)
+			}
+			_, ok := backend.caches.blocks.Get(height)
+			require.Equal(t, tc.expCached, ok)
+		})
+	}
+}
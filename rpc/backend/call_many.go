@@ -0,0 +1,309 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallMany executes the bundles of calls one after another on top of the state
+// context, each call seeing the changes of the previous ones. The result of
+// every call holds either its return value or its error.
+func (b *Backend) CallMany(
+	ctx context.Context,
+	bundles []rpctypes.Bundle,
+	stateCtx rpctypes.StateContext,
+	overrides *json.RawMessage,
+	timeoutMs *int64,
+) (result [][]map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "CallMany", trace.WithAttributes(attribute.Int("bundleCount", len(bundles))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNr, err := b.BlockNumberFromComet(ctx, stateCtx.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.CometBlockByNumber(ctx, blockNr)
+	if err != nil || block == nil || block.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(bundles)
+	if err != nil {
+		return nil, err
+	}
+	req := &evmtypes.CallManyRequest{
+		Bundles:         bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+	}
+	if overrides != nil {
+		req.Overrides = *overrides
+	}
+
+	height := block.Block.Height
+	if stateCtx.TransactionIndex != nil && *stateCtx.TransactionIndex >= 0 {
+		blockRes, err := b.CometBlockResultByNumber(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+		msgs := b.EthMsgsFromCometBlock(ctx, block, blockRes)
+		if *stateCtx.TransactionIndex > len(msgs) {
+			return nil, fmt.Errorf("transaction index %d out of range for block %d", *stateCtx.TransactionIndex, height)
+		}
+		// the predecessors are applied from the beginning of the block
+		req.Predecessors = msgs[:*stateCtx.TransactionIndex]
+		req.BlockNumber = height
+		req.BlockTime = block.Block.Time
+		height = max(height-1, 1)
+	}
+
+	var timeout time.Duration
+	if timeoutMs != nil && *timeoutMs > 0 {
+		timeout = time.Duration(*timeoutMs) * time.Millisecond
+	}
+	results, err := b.callMany(ctx, req, height, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([][]map[string]interface{}, 0, len(results))
+	for _, bundleResults := range results {
+		bundleResult := make([]map[string]interface{}, 0, len(bundleResults))
+		for _, res := range bundleResults {
+			if res.VMError != "" {
+				bundleResult = append(bundleResult, map[string]interface{}{"error": bundleCallError(res).Error()})
+				continue
+			}
+			bundleResult = append(bundleResult, map[string]interface{}{"value": res.ReturnValue})
+		}
+		result = append(result, bundleResult)
+	}
+	return result, nil
+}
+
+// CallBundle executes the signed transactions of a bundle one after another on
+// top of the state block, as the transactions of the requested block. It
+// returns the result of every transaction along with the gas fees and the value
+// paid to the coinbase.
+func (b *Backend) CallBundle(ctx context.Context, args rpctypes.CallBundleArgs) (result map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "CallBundle", trace.WithAttributes(attribute.Int("txCount", len(args.Txs))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(args.Txs) == 0 {
+		return nil, errors.New("bundle missing txs")
+	}
+	if args.BlockNumber == 0 {
+		return nil, errors.New("bundle missing blockNumber")
+	}
+
+	stateBlockNr, err := b.BlockNumberFromComet(ctx, args.StateBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(ctx, stateBlockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	signer := ethtypes.LatestSigner(b.ChainConfig(header.Header.Height))
+	txHashes := make([]byte, 0, len(args.Txs)*common.HashLength)
+	calls := make([]evmtypes.TransactionArgs, len(args.Txs))
+	for i, raw := range args.Txs {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return nil, err
+		}
+		from, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+		calls[i] = transactionArgsFromTx(tx, from)
+		txHashes = append(txHashes, tx.Hash().Bytes()...)
+	}
+
+	blockNumber := hexutil.Uint64(args.BlockNumber.Int64())    //#nosec G115 -- checked above
+	timestamp := hexutil.Uint64(header.Header.Time.Unix() + 1) //#nosec G115 -- int overflow is not a concern here
+	if args.Timestamp != nil {
+		timestamp = hexutil.Uint64(*args.Timestamp)
+	}
+	blockOverride := &rpctypes.BundleBlockOverrides{
+		BlockNumber: &blockNumber,
+		Coinbase:    args.Coinbase,
+		Timestamp:   &timestamp,
+		GasLimit:    (*hexutil.Uint64)(args.GasLimit),
+		BaseFee:     (*hexutil.Big)(args.BaseFee),
+	}
+
+	bz, err := json.Marshal([]rpctypes.Bundle{{Transactions: calls, BlockOverride: blockOverride}})
+	if err != nil {
+		return nil, err
+	}
+	req := &evmtypes.CallManyRequest{
+		Bundles:         bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		Validate:        true,
+	}
+
+	var timeout time.Duration
+	if args.Timeout != nil && *args.Timeout > 0 {
+		timeout = time.Duration(*args.Timeout) * time.Second
+	}
+	results, err := b.callMany(ctx, req, header.Header.Height, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		totalGasUsed   uint64
+		gasFees        = new(big.Int)
+		sentToCoinbase = new(big.Int)
+		txResults      = make([]map[string]interface{}, 0, len(args.Txs))
+	)
+	for i, res := range results[0] {
+		txGasFees := new(big.Int).Mul(res.GasPrice.ToInt(), new(big.Int).SetUint64(uint64(res.GasUsed)))
+		txSent := res.EthSentToCoinbase.ToInt()
+		txCoinbaseDiff := new(big.Int).Add(txGasFees, txSent)
+		totalGasUsed += uint64(res.GasUsed)
+		gasFees.Add(gasFees, txGasFees)
+		sentToCoinbase.Add(sentToCoinbase, txSent)
+
+		to := ""
+		if res.To != nil {
+			to = res.To.Hex()
+		}
+		txResult := map[string]interface{}{
+			"txHash":            common.BytesToHash(txHashes[i*common.HashLength : (i+1)*common.HashLength]).Hex(),
+			"gasUsed":           uint64(res.GasUsed),
+			"fromAddress":       res.From.Hex(),
+			"toAddress":         to,
+			"coinbaseDiff":      txCoinbaseDiff.String(),
+			"gasFees":           txGasFees.String(),
+			"ethSentToCoinbase": txSent.String(),
+			"gasPrice":          res.GasPrice.ToInt().String(),
+		}
+		if res.VMError != "" {
+			txResult["error"] = res.VMError
+			if res.VMError == vm.ErrExecutionReverted.Error() && len(res.ReturnValue) > 0 {
+				txResult["revert"] = revertReason(res.ReturnValue)
+			}
+		} else {
+			txResult["value"] = res.ReturnValue
+		}
+		txResults = append(txResults, txResult)
+	}
+
+	coinbaseDiff := new(big.Int).Add(gasFees, sentToCoinbase)
+	bundleGasPrice := new(big.Int)
+	if totalGasUsed > 0 {
+		bundleGasPrice.Div(coinbaseDiff, new(big.Int).SetUint64(totalGasUsed))
+	}
+	return map[string]interface{}{
+		"results":           txResults,
+		"coinbaseDiff":      coinbaseDiff.String(),
+		"gasFees":           gasFees.String(),
+		"ethSentToCoinbase": sentToCoinbase.String(),
+		"bundleGasPrice":    bundleGasPrice.String(),
+		"totalGasUsed":      totalGasUsed,
+		"stateBlockNumber":  header.Header.Height,
+		"bundleHash":        crypto.Keccak256Hash(txHashes).Hex(),
+	}, nil
+}
+
+// callMany runs the CallMany query at the height, within the gas cap and the
+// EVM timeout of the JSON-RPC server. The timeout of the request only applies
+// if it's shorter than the EVM timeout.
+func (b *Backend) callMany(ctx context.Context, req *evmtypes.CallManyRequest, height int64, timeout time.Duration) ([][]*rpctypes.BundleCallResult, error) {
+	if evmTimeout := b.RPCEVMTimeout(); evmTimeout > 0 && (timeout <= 0 || evmTimeout < timeout) {
+		timeout = evmTimeout
+	}
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		req.Timeout = timeout.String()
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.CallMany(rpctypes.ContextWithHeight(ctx, height), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results [][]*rpctypes.BundleCallResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// transactionArgsFromTx converts a signed transaction to the arguments of the
+// call applying it.
+func transactionArgsFromTx(tx *ethtypes.Transaction, from common.Address) evmtypes.TransactionArgs {
+	var (
+		gas        = hexutil.Uint64(tx.Gas())
+		nonce      = hexutil.Uint64(tx.Nonce())
+		input      = hexutil.Bytes(tx.Data())
+		accessList = tx.AccessList()
+	)
+	args := evmtypes.TransactionArgs{
+		From:              &from,
+		To:                tx.To(),
+		Gas:               &gas,
+		Value:             (*hexutil.Big)(tx.Value()),
+		Nonce:             &nonce,
+		Input:             &input,
+		AccessList:        &accessList,
+		ChainID:           (*hexutil.Big)(tx.ChainId()),
+		AuthorizationList: tx.SetCodeAuthorizations(),
+	}
+	switch tx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	}
+	return args
+}
+
+// bundleCallError returns the error of a failed call, with the revert reason
+// of the reverted calls.
+func bundleCallError(res *rpctypes.BundleCallResult) error {
+	if res.VMError == vm.ErrExecutionReverted.Error() {
+		return evmtypes.NewExecErrorWithReason(res.ReturnValue)
+	}
+	return errors.New(res.VMError)
+}
+
+// revertReason returns the reason of a revert, or its data if it isn't an
+// Error(string) revert.
+func revertReason(data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	return hexutil.Encode(data)
+}
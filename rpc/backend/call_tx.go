@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -47,7 +48,7 @@ func (b *Backend) Resend(ctx context.Context, args evmtypes.TransactionArgs, gas
 
 	// The signer used should always be the 'latest' known one because we expect
 	// signers to be backwards-compatible with old transactions.
-	cfg := b.ChainConfig()
+	cfg := b.ChainConfig(0)
 	if cfg == nil {
 		cfg = evmtypes.DefaultChainConfig(b.EvmChainID.Uint64()).EthereumConfig(nil)
 	}
@@ -132,7 +133,7 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	}
 
 	ethereumTx := &evmtypes.MsgEthereumTx{}
-	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
+	ethSigner := ethtypes.LatestSigner(b.ChainConfig(0))
 	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
 		b.Logger.Error("transaction converting failed", "error", err.Error())
 		return common.Hash{}, fmt.Errorf("failed to convert ethereum transaction: %w", err)
@@ -466,6 +467,126 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 simulates a sequence of blocks with their calls on top of the
+// requested block and returns the simulated blocks in the RPC format.
+func (b *Backend) SimulateV1(
+	ctx context.Context,
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (result []map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "SimulateV1", trace.WithAttributes(attribute.Int("blockCount", len(opts.BlockStateCalls))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(opts.BlockStateCalls) > rpctypes.MaxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks: the limit is %d", rpctypes.MaxSimulateBlocks)
+	}
+
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		blockNr, err = b.BlockNumberFromComet(ctx, *blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(ctx, blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, header.Header.Height)
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		req.Timeout = timeout.String()
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &blocks); err != nil {
+		return nil, err
+	}
+
+	cfg := b.ChainConfig(header.Header.Height)
+	parentHash := common.BytesToHash(header.Header.Hash())
+	result = make([]map[string]interface{}, 0, len(blocks))
+	for _, block := range blocks {
+		txs := make(ethtypes.Transactions, len(block.Transactions))
+		var logs []*ethtypes.Log
+		for i, txBz := range block.Transactions {
+			txs[i] = new(ethtypes.Transaction)
+			if err := txs[i].UnmarshalBinary(txBz); err != nil {
+				return nil, err
+			}
+			logs = append(logs, block.Calls[i].Logs...)
+		}
+
+		ethHeader := &ethtypes.Header{
+			ParentHash: parentHash,
+			UncleHash:  ethtypes.EmptyUncleHash,
+			Coinbase:   block.Coinbase,
+			TxHash:     ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)),
+			Bloom:      ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
+			Difficulty: big.NewInt(0),
+			Number:     new(big.Int).SetUint64(uint64(block.Number)),
+			GasLimit:   uint64(block.GasLimit),
+			GasUsed:    uint64(block.GasUsed),
+			Time:       uint64(block.Time),
+			BaseFee:    block.BaseFee.ToInt(),
+		}
+		blockHash := ethHeader.Hash()
+		parentHash = blockHash
+
+		for _, call := range block.Calls {
+			for _, log := range call.Logs {
+				log.BlockHash = blockHash
+			}
+		}
+
+		transactions := make([]interface{}, len(txs))
+		for i, tx := range txs {
+			if !opts.ReturnFullTransactions {
+				transactions[i] = tx.Hash()
+				continue
+			}
+			rpcTx := rpctypes.NewRPCTransaction(tx, blockHash, ethHeader.Number.Uint64(), ethHeader.Time, uint64(i), ethHeader.BaseFee, cfg)
+			// simulated transactions are unsigned
+			rpcTx.From = block.Senders[i]
+			transactions[i] = rpcTx
+		}
+
+		fields := rpctypes.RPCMarshalHeader(ethHeader, blockHash.Bytes())
+		fields["size"] = hexutil.Uint64(ethHeader.Size())
+		fields["transactions"] = transactions
+		fields["uncles"] = []common.Hash{}
+		fields["calls"] = block.Calls
+		result = append(result, fields)
+	}
+
+	return result, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice(ctx context.Context) (result *hexutil.Big, err error) {
 	ctx, span := tracer.Start(ctx, "GasPrice")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	gomath "math"
 	"math/big"
+	"strconv"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -15,19 +16,21 @@ import (
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
-	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 // ChainID is the EIP-155 replay-protection chain id for the current ethereum chain config.
@@ -42,16 +45,39 @@ func (b *Backend) ChainID(ctx context.Context) (result *hexutil.Big, err error)
 		return (*hexutil.Big)(b.EvmChainID), nil
 	}
 
-	if config := b.ChainConfig(); config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) {
+	if config := b.ChainConfig(int64(bn)); config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) { //#nosec G115 -- int overflow is not a concern here
 		return (*hexutil.Big)(config.ChainID), nil
 	}
 
 	return nil, fmt.Errorf("chain not synced beyond EIP-155 replay-protection fork block")
 }
 
-// ChainConfig returns the latest ethereum chain configuration
-func (b *Backend) ChainConfig() *params.ChainConfig {
-	return evmtypes.GetEthChainConfig()
+// ChainConfig returns the ethereum chain configuration at the given block
+// height, or at the latest one if the height is not positive. The chain config
+// of a committed block never changes, so it is cached by height. The configured
+// chain config is returned if the query fails.
+func (b *Backend) ChainConfig(height int64) *params.ChainConfig {
+	if height > 0 {
+		if cfg, ok := b.caches.chainConfigs.Get(height); ok {
+			return cfg
+		}
+	}
+
+	var header metadata.MD
+	res, err := b.QueryClient.Config(rpctypes.NewContextWithHeight(height), &evmtypes.QueryConfigRequest{}, grpc.Header(&header))
+	if err != nil || res.Config == nil {
+		b.Logger.Error("failed to query the EVM chain config", "height", height, "error", err)
+		return evmtypes.GetEthChainConfig()
+	}
+	cfg := res.Config.EthereumConfig(nil)
+
+	// the latest config is cached at the height it was queried at
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) == 1 {
+		if queried, err := strconv.ParseInt(heights[0], 10, 64); err == nil {
+			b.caches.chainConfigs.Add(queried, cfg)
+		}
+	}
+	return cfg
 }
 
 // GlobalMinGasPrice returns MinGasPrice param from FeeMarket
@@ -343,36 +369,3 @@ func (b *Backend) FeeHistory(
 
 	return &feeHistory, nil
 }
-
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
-func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *big.Int, err error) {
-	ctx, span := tracer.Start(ctx, "SuggestGasTipCap")
-	defer func() { evmtrace.EndSpanErr(span, err) }()
-	if baseFee == nil {
-		// london hardfork not enabled or feemarket not enabled
-		return big.NewInt(0), nil
-	}
-
-	params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
-	if err != nil {
-		return nil, err
-	}
-	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
-	// ```
-	// GasTarget = GasLimit / ElasticityMultiplier
-	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
-	// ```
-	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
-	// ```
-	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
-	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```t
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
-	if maxDelta < 0 {
-		// impossible if the parameter validation passed.
-		maxDelta = 0
-	}
-	return big.NewInt(maxDelta), nil
-}
@@ -26,6 +26,9 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 	if err != nil {
 		return nil, err
 	}
+	if resBlock, ok := b.caches.blocks.Get(height); ok {
+		return resBlock, nil
+	}
 	resBlock, err := b.RPCClient.Block(ctx, &height)
 	if err != nil {
 		b.Logger.Debug("cometbft client failed to get block", "height", height, "error", err.Error())
@@ -37,6 +40,7 @@ func (b *Backend) CometBlockByNumber(ctx context.Context, blockNum rpctypes.Bloc
 		return nil, nil
 	}
 
+	b.caches.blocks.Add(height, resBlock)
 	return resBlock, nil
 }
 
@@ -66,7 +70,7 @@ func (b *Backend) CometBlockResultByNumber(ctx context.Context, height *int64) (
 	if height != nil && *height == 0 {
 		height = nil
 	}
-	res, err := b.RPCClient.BlockResults(ctx, height)
+	res, err := b.blockResults(ctx, height)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch block result from CometBFT %d: %w", *height, err)
 	}
@@ -74,6 +78,23 @@ func (b *Backend) CometBlockResultByNumber(ctx context.Context, height *int64) (
 	return res, nil
 }
 
+// blockResults returns the CometBFT block result at the given height, or at the
+// latest height if nil. The results of the finalized heights are cached.
+func (b *Backend) blockResults(ctx context.Context, height *int64) (*cmtrpctypes.ResultBlockResults, error) {
+	if height == nil {
+		return b.RPCClient.BlockResults(ctx, nil)
+	}
+	if res, ok := b.caches.blockResults.Get(*height); ok {
+		return res, nil
+	}
+	res, err := b.RPCClient.BlockResults(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	b.caches.blockResults.Add(*height, res)
+	return res, nil
+}
+
 // CometBlockByHash returns a CometBFT-formatted block by block number
 func (b *Backend) CometBlockByHash(ctx context.Context, blockHash common.Hash) (result *cmtrpctypes.ResultBlock, err error) {
 	ctx, span := tracer.Start(ctx, "CometBlockByHash", trace.WithAttributes(attribute.String("blockHash", blockHash.Hex())))
@@ -58,7 +58,7 @@ func (b *Backend) RPCBlockFromCometBlock(
 		return nil, fmt.Errorf("failed to get rpc block from comet block: %w", err)
 	}
 
-	return rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig())
+	return rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, b.ChainConfig(resBlock.Block.Height))
 }
 
 // BlockNumberFromComet returns the BlockNumber from BlockNumberOrHash
@@ -176,7 +176,7 @@ func (b *Backend) EthBlockFromCometBlock(
 	}
 
 	// 4. create blockHeader without transactions, receipts, withdrawals, ...
-	ethHeader := rpctypes.MakeHeader(cmtBlock.Header, gasLimit, miner, baseFee)
+	ethHeader := rpctypes.MakeHeader(cmtBlock.Header, gasLimit, miner, baseFee, b.ChainConfig(cmtBlock.Height))
 
 	// 5. get MsgEthereumTxs
 	msgs := b.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
@@ -0,0 +1,192 @@
+package backend
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetCosmosTxByEthHash returns the Cosmos transaction, with its result and
+// events, that includes the Ethereum transaction of the given hash. It returns
+// nil if the transaction is not found.
+func (b *Backend) GetCosmosTxByEthHash(ctx context.Context, hash common.Hash) (result *sdktx.GetTxResponse, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosTxByEthHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.GetTxByEthHash(ctx, hash)
+	if err != nil || res == nil {
+		b.Logger.Debug("tx not found", "hash", hash.Hex(), "error", err)
+		return nil, nil
+	}
+
+	block, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil || int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("tx %d not found in block %d", res.TxIndex, res.Height)
+	}
+
+	cosmosHash := hex.EncodeToString(block.Block.Txs[res.TxIndex].Hash())
+	return b.QueryClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: cosmosHash})
+}
+
+// GetEthTxsByCosmosHash returns the Ethereum transactions included in the
+// Cosmos transaction of the given hash.
+func (b *Backend) GetEthTxsByCosmosHash(ctx context.Context, hash string) (result []*rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "GetEthTxsByCosmosHash", trace.WithAttributes(attribute.String("hash", hash)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.QueryClient.GetTx(ctx, &sdktx.GetTxRequest{Hash: strings.TrimPrefix(hash, "0x")})
+	if err != nil {
+		return nil, err
+	}
+
+	result = []*rpctypes.RPCTransaction{}
+	if res.Tx == nil || res.Tx.Body == nil {
+		return result, nil
+	}
+	for _, anyMsg := range res.Tx.Body.Messages {
+		var msg sdk.Msg
+		if err := b.ClientCtx.InterfaceRegistry.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, err
+		}
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		tx, err := b.GetTransactionByHash(ctx, ethMsg.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			result = append(result, tx)
+		}
+	}
+	return result, nil
+}
+
+// GetCosmosAccount returns the Cosmos account of the address at the given
+// block, with its vesting schedule for vesting accounts. It returns nil if the
+// account doesn't exist.
+func (b *Backend) GetCosmosAccount(ctx context.Context, address sdk.AccAddress, blockNrOrHash rpctypes.BlockNumberOrHash) (result *rpctypes.CosmosAccount, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosAccount", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CometHeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+
+	res, err := b.QueryClient.Auth.Account(ctx, &authtypes.QueryAccountRequest{Address: address.String()})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var acc sdk.AccountI
+	if err := b.ClientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.CosmosAccount{
+		Address:       address.String(),
+		EthAddress:    common.BytesToAddress(address),
+		AccountNumber: hexutil.Uint64(acc.GetAccountNumber()),
+		Sequence:      hexutil.Uint64(acc.GetSequence()),
+	}
+	if pubKey := acc.GetPubKey(); pubKey != nil {
+		result.PubKey = pubKey.Bytes()
+	}
+	if vacc, ok := acc.(vestingexported.VestingAccount); ok {
+		blockTime := header.Header.Time
+		result.Vesting = &rpctypes.VestingAccount{
+			Type:             sdk.MsgTypeURL(acc),
+			StartTime:        vacc.GetStartTime(),
+			EndTime:          vacc.GetEndTime(),
+			OriginalVesting:  vacc.GetOriginalVesting(),
+			DelegatedFree:    vacc.GetDelegatedFree(),
+			DelegatedVesting: vacc.GetDelegatedVesting(),
+			VestedCoins:      vacc.GetVestedCoins(blockTime),
+			VestingCoins:     vacc.GetVestingCoins(blockTime),
+		}
+	}
+	return result, nil
+}
+
+// GetDenomBalances returns the balances of the address in every denomination
+// at the given block.
+func (b *Backend) GetDenomBalances(ctx context.Context, address sdk.AccAddress, blockNrOrHash rpctypes.BlockNumberOrHash) (result sdk.Coins, err error) {
+	ctx, span := tracer.Start(ctx, "GetDenomBalances", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+
+	result = sdk.NewCoins()
+	req := &banktypes.QueryAllBalancesRequest{Address: address.String(), Pagination: &query.PageRequest{}}
+	for {
+		res, err := b.QueryClient.Bank.AllBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		result = result.Add(res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return result, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// BroadcastCosmosTx broadcasts a signed protobuf-encoded Cosmos transaction and
+// returns its hash once it passed the CheckTx.
+func (b *Backend) BroadcastCosmosTx(ctx context.Context, txBytes []byte) (result string, err error) {
+	ctx, span := tracer.Start(ctx, "BroadcastCosmosTx")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.QueryClient.BroadcastTx(ctx, &sdktx.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    sdktx.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return "", err
+	}
+	if res.TxResponse == nil {
+		return "", fmt.Errorf("empty broadcast response")
+	}
+	if res.TxResponse.Code != 0 {
+		return "", errorsmod.ABCIError(res.TxResponse.Codespace, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+	span.SetAttributes(attribute.String("tx_hash", res.TxResponse.TxHash))
+	return res.TxResponse.TxHash, nil
+}
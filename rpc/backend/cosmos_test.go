@@ -0,0 +1,256 @@
+package backend
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// indexEthTx indexes a block of the given height holding a Cosmos tx wrapping a
+// signed Ethereum tx, and mocks the queries of the block.
+func indexEthTx(t *testing.T, backend *Backend, height int64) (tmtypes.Tx, *evmtypes.MsgEthereumTx) {
+	t.Helper()
+	// the EVM coin and the chain config are required to build and return the txs
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID]).Configure())
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(constants.ExampleChainID.EVMChainID)))
+	evmtypes.RegisterInterfaces(backend.ClientCtx.InterfaceRegistry)
+
+	from, priv := utiltx.NewAddrKey()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  new(big.Int).SetUint64(constants.ExampleChainID.EVMChainID),
+		To:       &common.Address{},
+		Amount:   big.NewInt(1),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	msg.From = from.Bytes()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(msg.AsTransaction().ChainId()), utiltx.NewSigner(priv)))
+	cosmosTx, err := msg.BuildTx(backend.ClientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := backend.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
+	require.NoError(t, err)
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	txResults := []*abcitypes.ExecTxResult{{
+		Code: 0,
+		Events: []abcitypes.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abcitypes.EventAttribute{
+				{Key: "ethereumTxHash", Value: msg.Hash().Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+			}},
+		},
+	}}
+	require.NoError(t, backend.Indexer.IndexBlock(block, txResults))
+
+	client := backend.ClientCtx.Client.(*mocks.Client)
+	resBlock := &tmrpctypes.ResultBlock{BlockID: tmtypes.BlockID{Hash: common.HexToHash("0xb1").Bytes()}, Block: block}
+	client.On("Block", mock.Anything, &height).Return(resBlock, nil).Maybe()
+	client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{Height: height, TxsResults: txResults}, nil).Maybe()
+	return txBz, msg
+}
+
+func TestGetCosmosTxByEthHash(t *testing.T) {
+	backend := setupMockBackend(t)
+	txService := mocks.NewTxServiceClient(t)
+	backend.QueryClient.ServiceClient = txService
+
+	txBz, msg := indexEthTx(t, backend, 5)
+	cosmosHash := hex.EncodeToString(txBz.Hash())
+	expRes := &sdktx.GetTxResponse{TxResponse: &sdk.TxResponse{Height: 5, TxHash: cosmosHash}}
+	txService.On("GetTx", mock.Anything, &sdktx.GetTxRequest{Hash: cosmosHash}).Return(expRes, nil)
+
+	res, err := backend.GetCosmosTxByEthHash(context.Background(), msg.Hash())
+	require.NoError(t, err)
+	require.Equal(t, expRes, res)
+
+	// the unknown txs are not found
+	res, err = backend.GetCosmosTxByEthHash(context.Background(), common.HexToHash("0x01"))
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestGetEthTxsByCosmosHash(t *testing.T) {
+	backend := setupMockBackend(t)
+	txService := mocks.NewTxServiceClient(t)
+	backend.QueryClient.ServiceClient = txService
+	queryClient := backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+	queryClient.On("BaseFee", mock.Anything, mock.Anything).Return(&evmtypes.QueryBaseFeeResponse{}, nil)
+	banktypes.RegisterInterfaces(backend.ClientCtx.InterfaceRegistry)
+
+	txBz, msg := indexEthTx(t, backend, 5)
+	ethMsg, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	bankMsg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+	require.NoError(t, err)
+	cosmosHash := hex.EncodeToString(txBz.Hash())
+	txService.On("GetTx", mock.Anything, &sdktx.GetTxRequest{Hash: cosmosHash}).Return(&sdktx.GetTxResponse{
+		Tx: &sdktx.Tx{Body: &sdktx.TxBody{Messages: []*codectypes.Any{bankMsg, ethMsg}}},
+	}, nil)
+
+	// the hash may be prefixed, and only the Ethereum txs are returned
+	txs, err := backend.GetEthTxsByCosmosHash(context.Background(), "0x"+cosmosHash)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, msg.Hash(), txs[0].Hash)
+	require.Equal(t, common.HexToHash("0xb1"), *txs[0].BlockHash)
+	require.Equal(t, uint64(5), txs[0].BlockNumber.ToInt().Uint64())
+}
+
+func TestGetCosmosAccount(t *testing.T) {
+	var (
+		height  = int64(5)
+		address = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+		coins   = sdk.NewCoins(sdk.NewInt64Coin(constants.ExampleAttoDenom, 100))
+	)
+	blockNum := rpctypes.BlockNumber(height)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+
+	baseAcc := authtypes.NewBaseAccount(address, nil, 7, 3)
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccount(address, nil, 7, 3), coins, 1000, 3000)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		account    sdk.AccountI
+		expVesting *rpctypes.VestingAccount
+	}{
+		{"not found", nil, nil},
+		{"base account", baseAcc, nil},
+		{
+			"vesting account", vestingAcc,
+			&rpctypes.VestingAccount{
+				Type:            sdk.MsgTypeURL(vestingAcc),
+				StartTime:       1000,
+				EndTime:         3000,
+				OriginalVesting: coins,
+				// half of the coins are vested at the time of the block
+				VestedCoins:  sdk.NewCoins(sdk.NewInt64Coin(constants.ExampleAttoDenom, 50)),
+				VestingCoins: sdk.NewCoins(sdk.NewInt64Coin(constants.ExampleAttoDenom, 50)),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			authClient := mocks.NewAuthQueryClient(t)
+			backend.QueryClient.Auth = authClient
+			authtypes.RegisterInterfaces(backend.ClientCtx.InterfaceRegistry)
+			vestingtypes.RegisterInterfaces(backend.ClientCtx.InterfaceRegistry)
+
+			client := backend.ClientCtx.Client.(*mocks.Client)
+			client.On("Header", mock.Anything, &height).Return(&tmrpctypes.ResultHeader{
+				Header: &tmtypes.Header{Height: height, Time: time.Unix(2000, 0)},
+			}, nil)
+			req := &authtypes.QueryAccountRequest{Address: address.String()}
+			if tc.account == nil {
+				authClient.On("Account", mock.Anything, req).Return(nil, status.Error(codes.NotFound, "account not found"))
+			} else {
+				acc, err := codectypes.NewAnyWithValue(tc.account)
+				require.NoError(t, err)
+				authClient.On("Account", mock.Anything, req).Return(&authtypes.QueryAccountResponse{Account: acc}, nil)
+			}
+
+			res, err := backend.GetCosmosAccount(context.Background(), address, blockNrOrHash)
+			require.NoError(t, err)
+			if tc.account == nil {
+				require.Nil(t, res)
+				return
+			}
+			require.Equal(t, address.String(), res.Address)
+			require.Equal(t, common.BytesToAddress(address), res.EthAddress)
+			require.Equal(t, uint64(7), uint64(res.AccountNumber))
+			require.Equal(t, uint64(3), uint64(res.Sequence))
+			require.Equal(t, tc.expVesting, res.Vesting)
+		})
+	}
+}
+
+func TestGetDenomBalances(t *testing.T) {
+	backend := setupMockBackend(t)
+	bankClient := mocks.NewBankQueryClient(t)
+	backend.QueryClient.Bank = bankClient
+
+	address := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	blockNum := rpctypes.BlockNumber(5)
+	firstPage := sdk.NewCoins(sdk.NewInt64Coin("adenom", 1), sdk.NewInt64Coin("bdenom", 2))
+	secondPage := sdk.NewCoins(sdk.NewInt64Coin("cdenom", 3))
+
+	// the balances are queried page by page
+	bankClient.On("AllBalances", mock.Anything, mock.MatchedBy(func(req *banktypes.QueryAllBalancesRequest) bool {
+		return req.Address == address.String() && len(req.Pagination.Key) == 0
+	})).Return(&banktypes.QueryAllBalancesResponse{
+		Balances:   firstPage,
+		Pagination: &query.PageResponse{NextKey: []byte("cdenom")},
+	}, nil).Once()
+	bankClient.On("AllBalances", mock.Anything, mock.MatchedBy(func(req *banktypes.QueryAllBalancesRequest) bool {
+		return string(req.Pagination.Key) == "cdenom"
+	})).Return(&banktypes.QueryAllBalancesResponse{
+		Balances:   secondPage,
+		Pagination: &query.PageResponse{},
+	}, nil).Once()
+
+	balances, err := backend.GetDenomBalances(context.Background(), address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	require.NoError(t, err)
+	require.Equal(t, firstPage.Add(secondPage...), balances)
+}
+
+func TestBroadcastCosmosTx(t *testing.T) {
+	txBytes := []byte("tx")
+	req := &sdktx.BroadcastTxRequest{TxBytes: txBytes, Mode: sdktx.BroadcastMode_BROADCAST_MODE_SYNC}
+
+	testCases := []struct {
+		name     string
+		response *sdk.TxResponse
+		expErr   string
+	}{
+		{"empty response", nil, "empty broadcast response"},
+		{"rejected by the CheckTx", &sdk.TxResponse{Codespace: "sdk", Code: 5, RawLog: "insufficient funds"}, "insufficient funds"},
+		{"pass", &sdk.TxResponse{TxHash: "ABCD"}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			txService := mocks.NewTxServiceClient(t)
+			backend.QueryClient.ServiceClient = txService
+			txService.On("BroadcastTx", mock.Anything, req).Return(&sdktx.BroadcastTxResponse{TxResponse: tc.response}, nil)
+
+			hash, err := backend.BroadcastCosmosTx(context.Background(), txBytes)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "ABCD", hash)
+		})
+	}
+}
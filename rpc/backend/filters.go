@@ -2,16 +2,35 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ErrPendingLogsDisabled is returned when the pending logs are requested but
+// the execution of the pending transactions is disabled in the JSON-RPC config.
+var ErrPendingLogsDisabled = errors.New("pending logs are disabled")
+
 // GetLogs returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogs(ctx context.Context, hash common.Hash) (result [][]*ethtypes.Log, err error) {
 	ctx, span := tracer.Start(ctx, "GetLogs", trace.WithAttributes(attribute.String("hash", hash.Hex())))
@@ -50,3 +69,321 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// IndexedLogHeights returns the heights of the blocks in the [from, to] range
+// containing logs that match the given addresses and topics, as found in the log
+// index of the EVM indexer. The boolean result is false when the log index can't
+// serve the query, i.e. the indexer is disabled, the criteria are empty or the
+// range is not fully covered by the index, in which case every block of the range
+// must be inspected.
+func (b *Backend) IndexedLogHeights(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) (heights []int64, ok bool, err error) {
+	_, span := tracer.Start(ctx, "IndexedLogHeights", trace.WithAttributes(attribute.Int64("from", from), attribute.Int64("to", to)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	logIndexer, isLogIndexer := b.Indexer.(servertypes.EVMLogIndexer)
+	if !isLogIndexer || !hasLogCriteria(addresses, topics) {
+		return nil, false, nil
+	}
+
+	first, err := logIndexer.FirstIndexedLogBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := logIndexer.LastIndexedLogBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first || to > last {
+		return nil, false, nil
+	}
+
+	positions, err := logIndexer.GetLogPositions(from, to, addresses, topics)
+	if err != nil {
+		return nil, false, err
+	}
+	heights = make([]int64, 0, len(positions))
+	for _, pos := range positions {
+		if len(heights) == 0 || heights[len(heights)-1] != pos.Height {
+			heights = append(heights, pos.Height)
+		}
+	}
+	return heights, true, nil
+}
+
+// hasLogCriteria returns true if any address or topic is set.
+func hasLogCriteria(addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		return true
+	}
+	for _, topicList := range topics {
+		if len(topicList) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingLogs returns the logs of the pending block. The pending transactions of
+// the mempool are applied on top of the latest state, ordered by price and nonce
+// as done by the block proposer, and the logs they emit are returned without a
+// block hash. The pending transactions failing to be applied are skipped. The
+// execution result is reused until either the latest block or the pending
+// transactions change.
+func (b *Backend) PendingLogs(ctx context.Context) (result []*ethtypes.Log, err error) {
+	ctx, span := tracer.Start(ctx, "PendingLogs")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	txsCap := b.Cfg.JSONRPC.PendingLogsTxsCap
+	if txsCap <= 0 {
+		return nil, ErrPendingLogsDisabled
+	}
+	if b.Mempool == nil {
+		return []*ethtypes.Log{}, nil
+	}
+
+	header, err := b.CometHeaderByNumber(ctx, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	height := header.Header.Height
+	ctx = rpctypes.ContextWithHeight(ctx, height)
+
+	var baseFee *big.Int
+	if res, err := b.QueryClient.BaseFee(ctx, &evmtypes.QueryBaseFeeRequest{}); err == nil && res.BaseFee != nil {
+		baseFee = res.BaseFee.BigInt()
+	}
+
+	txs, senders := b.pendingTxs(baseFee, txsCap)
+	if len(txs) == 0 {
+		return []*ethtypes.Log{}, nil
+	}
+
+	hashes := make([]byte, 0, len(txs)*common.HashLength)
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	key := crypto.Keccak256Hash(hashes)
+	if logs, ok := b.pendingLogs.get(height, key); ok {
+		return logs, nil
+	}
+
+	if timeout := b.Cfg.JSONRPC.PendingLogsTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	proposer := sdk.ConsAddress(header.Header.ProposerAddress)
+	txs, calls, err := b.executeValidPendingTxs(ctx, proposer, txs, senders)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*ethtypes.Log{}
+	for i, call := range calls {
+		for _, ethLog := range call.Logs {
+			// the logs refer to the actual pending transactions rather than to
+			// the unsigned transactions built by the simulation
+			ethLog.TxHash = txs[i].Hash()
+			ethLog.BlockHash = common.Hash{}
+			logs = append(logs, ethLog)
+		}
+	}
+
+	b.pendingLogs.set(height, key, logs)
+	return logs, nil
+}
+
+// executeValidPendingTxs executes the pending transactions, skipping the ones
+// failing to be applied, e.g. for an insufficient balance, which abort the whole
+// execution. The first failing transaction is found by executing the prefixes of
+// the remaining transactions, then logged and skipped. It returns the applied
+// transactions along with their results.
+func (b *Backend) executeValidPendingTxs(
+	ctx context.Context,
+	proposer sdk.ConsAddress,
+	txs []*ethtypes.Transaction,
+	senders []common.Address,
+) ([]*ethtypes.Transaction, []rpctypes.SimCallResult, error) {
+	var valid []int
+	rest := make([]int, len(txs))
+	for i := range rest {
+		rest[i] = i
+	}
+	execute := func(indexes ...[]int) ([]rpctypes.SimCallResult, error) {
+		return b.executePendingTxs(ctx, proposer, txs, senders, slices.Concat(indexes...))
+	}
+
+	for {
+		calls, err := execute(valid, rest)
+		if err == nil {
+			validTxs := make([]*ethtypes.Transaction, len(valid)+len(rest))
+			for i, index := range slices.Concat(valid, rest) {
+				validTxs[i] = txs[index]
+			}
+			return validTxs, calls, nil
+		}
+		if ctx.Err() != nil {
+			return nil, nil, fmt.Errorf("failed to execute the pending transactions: %w", err)
+		}
+
+		// the execution of a prefix fails if and only if it includes the first
+		// failing transaction
+		failing, failingErr := len(rest)-1, err
+		for low := 0; low < failing; {
+			mid := (low + failing) / 2
+			if _, err := execute(valid, rest[:mid+1]); err != nil {
+				if ctx.Err() != nil {
+					return nil, nil, fmt.Errorf("failed to execute the pending transactions: %w", err)
+				}
+				failing, failingErr = mid, err
+			} else {
+				low = mid + 1
+			}
+		}
+
+		b.Logger.Debug("skipping the failing pending transaction", "hash", txs[rest[failing]].Hash(), "error", failingErr)
+		valid = slices.Concat(valid, rest[:failing])
+		rest = rest[failing+1:]
+	}
+}
+
+// executePendingTxs executes the pending transactions of the given indexes in a
+// single simulated block on top of the latest state and returns their results.
+func (b *Backend) executePendingTxs(
+	ctx context.Context,
+	proposer sdk.ConsAddress,
+	txs []*ethtypes.Transaction,
+	senders []common.Address,
+	indexes []int,
+) ([]rpctypes.SimCallResult, error) {
+	if len(indexes) == 0 {
+		return []rpctypes.SimCallResult{}, nil
+	}
+
+	// the gas limit of the pending block is raised to fit all the pending
+	// transactions, which are bounded by the txs cap
+	var gasLimit uint64
+	calls := make([]evmtypes.TransactionArgs, len(indexes))
+	for i, index := range indexes {
+		calls[i] = pendingTxArgs(txs[index], senders[index])
+		gasLimit += txs[index].Gas()
+	}
+	opts := rpctypes.SimOpts{
+		BlockStateCalls: []rpctypes.SimBlock{{
+			BlockOverrides: &rpctypes.BlockOverrides{GasLimit: (*hexutil.Uint64)(&gasLimit)},
+			Calls:          calls,
+		}},
+	}
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.SimulateV1Request{
+		Opts:            bz,
+		ProposerAddress: proposer,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		req.Timeout = time.Until(deadline).String()
+	}
+	res, err := b.QueryClient.SimulateV1(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []rpctypes.SimBlockResult
+	if err := json.Unmarshal(res.Data, &blocks); err != nil {
+		return nil, err
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != len(indexes) {
+		return nil, errors.New("unexpected result of the pending transactions execution")
+	}
+	return blocks[0].Calls, nil
+}
+
+// pendingTxs returns up to txsCap pending transactions of the mempool along
+// with their senders, in the order they are selected for the next block.
+func (b *Backend) pendingTxs(baseFee *big.Int, txsCap int) ([]*ethtypes.Transaction, []common.Address) {
+	pending := b.Mempool.GetTxPool().Pending(txpool.PendingFilter{OnlyPlainTxs: true})
+
+	senders := make(map[common.Hash]common.Address)
+	for addr, accTxs := range pending {
+		for _, lazyTx := range accTxs {
+			senders[lazyTx.Hash] = addr
+		}
+	}
+
+	txs := make([]*ethtypes.Transaction, 0, min(len(senders), txsCap))
+	txSenders := make([]common.Address, 0, cap(txs))
+	ordered := miner.NewTransactionsByPriceAndNonce(nil, pending, baseFee)
+	for len(txs) < txsCap {
+		lazyTx, _ := ordered.Peek()
+		if lazyTx == nil {
+			break
+		}
+		tx := lazyTx.Resolve()
+		if tx == nil {
+			// the transaction was removed from the pool in the meantime
+			ordered.Pop()
+			continue
+		}
+		txs = append(txs, tx)
+		txSenders = append(txSenders, senders[lazyTx.Hash])
+		ordered.Shift()
+	}
+	return txs, txSenders
+}
+
+// pendingTxArgs converts a pending transaction into the arguments of a simulated
+// call. The fees are left empty since the pending state is not charged.
+func pendingTxArgs(tx *ethtypes.Transaction, from common.Address) evmtypes.TransactionArgs {
+	gas := tx.Gas()
+	nonce := tx.Nonce()
+	data := hexutil.Bytes(tx.Data())
+	args := evmtypes.TransactionArgs{
+		From:  &from,
+		To:    tx.To(),
+		Gas:   (*hexutil.Uint64)(&gas),
+		Value: (*hexutil.Big)(tx.Value()),
+		Nonce: (*hexutil.Uint64)(&nonce),
+		Input: &data,
+	}
+	if accessList := tx.AccessList(); len(accessList) > 0 {
+		args.AccessList = &accessList
+	}
+	if authList := tx.SetCodeAuthorizations(); len(authList) > 0 {
+		args.AuthorizationList = authList
+	}
+	return args
+}
+
+// pendingLogsCache holds the logs of the last execution of the pending
+// transactions, identified by the latest height and the pending tx hashes.
+type pendingLogsCache struct {
+	mu     sync.Mutex
+	height int64
+	key    common.Hash
+	logs   []*ethtypes.Log
+}
+
+func (c *pendingLogsCache) get(height int64, key common.Hash) ([]*ethtypes.Log, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.logs == nil || c.height != height || c.key != key {
+		return nil, false
+	}
+	return c.logs, true
+}
+
+func (c *pendingLogsCache) set(height int64, key common.Hash, logs []*ethtypes.Log) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.height, c.key, c.logs = height, key, logs
+}
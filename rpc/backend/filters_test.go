@@ -0,0 +1,140 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// mockPendingTxsExecution mocks the execution of the pending transactions,
+// failing the whole execution when it includes a transaction with one of the
+// failing nonces, and returning the nonce of each transaction.
+func mockPendingTxsExecution(queryClient *mocks.EVMQueryClient, failing map[uint64]bool) {
+	queryClient.On("SimulateV1", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *evmtypes.SimulateV1Request, _ ...grpc.CallOption) (*evmtypes.SimulateV1Response, error) {
+			var opts rpctypes.SimOpts
+			if err := json.Unmarshal(req.Opts, &opts); err != nil {
+				return nil, err
+			}
+			calls := []rpctypes.SimCallResult{}
+			for _, call := range opts.BlockStateCalls[0].Calls {
+				nonce := uint64(*call.Nonce)
+				if failing[nonce] {
+					return nil, errors.New("insufficient funds")
+				}
+				calls = append(calls, rpctypes.SimCallResult{
+					ReturnValue: []byte{byte(nonce)},
+					Status:      1,
+				})
+			}
+			bz, err := json.Marshal([]rpctypes.SimBlockResult{{Calls: calls}})
+			if err != nil {
+				return nil, err
+			}
+			return &evmtypes.SimulateV1Response{Data: bz}, nil
+		}, nil,
+	)
+}
+
+func TestExecuteValidPendingTxs(t *testing.T) {
+	testCases := []struct {
+		name      string
+		txsCount  int
+		failing   []uint64
+		cancelled bool
+		expNonces []uint64
+		expError  bool
+	}{
+		{
+			"pass - no failing transaction",
+			3,
+			nil,
+			false,
+			[]uint64{0, 1, 2},
+			false,
+		},
+		{
+			"pass - skip a failing transaction",
+			5,
+			[]uint64{2},
+			false,
+			[]uint64{0, 1, 3, 4},
+			false,
+		},
+		{
+			"pass - skip several failing transactions",
+			8,
+			[]uint64{0, 3, 4, 7},
+			false,
+			[]uint64{1, 2, 5, 6},
+			false,
+		},
+		{
+			"pass - skip all the failing transactions",
+			3,
+			[]uint64{0, 1, 2},
+			false,
+			[]uint64{},
+			false,
+		},
+		{
+			"fail - context cancelled",
+			3,
+			[]uint64{1},
+			true,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			failing := make(map[uint64]bool)
+			for _, nonce := range tc.failing {
+				failing[nonce] = true
+			}
+			mockPendingTxsExecution(backend.QueryClient.QueryClient.(*mocks.EVMQueryClient), failing)
+
+			to := utiltx.GenerateAddress()
+			txs := make([]*ethtypes.Transaction, tc.txsCount)
+			senders := make([]common.Address, tc.txsCount)
+			for i := range txs {
+				txs[i] = ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: uint64(i), To: &to, Gas: 21000})
+				senders[i] = utiltx.GenerateAddress()
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tc.cancelled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+
+			validTxs, calls, err := backend.executeValidPendingTxs(ctx, nil, txs, senders)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, validTxs, len(tc.expNonces))
+			require.Len(t, calls, len(tc.expNonces))
+			for i, nonce := range tc.expNonces {
+				require.Equal(t, nonce, validTxs[i].Nonce())
+				require.Equal(t, []byte{byte(nonce)}, []byte(calls[i].ReturnValue))
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	evmtrace "github.com/cosmos/evm/trace"
+)
+
+const (
+	// oracleSampleNumber is the number of the lowest tips sampled in every block
+	oracleSampleNumber = 3
+	// oracleBlockFetchers is the max number of blocks sampled concurrently
+	oracleBlockFetchers = 4
+)
+
+// gasPriceOracle suggests the gas tip caps from the effective tips paid by the
+// transactions of the latest blocks, as the go-ethereum gas price oracle. The
+// lowest tips of every block are sampled, and the configured percentile of the
+// samples is suggested, no lower than the min tip of the mempool. The
+// suggestion is cached until the next block.
+type gasPriceOracle struct {
+	blocks      int64
+	percentile  int
+	maxPrice    *big.Int
+	ignorePrice *big.Int
+	minTip      *big.Int
+
+	mu        sync.Mutex
+	lastHead  int64
+	lastPrice *big.Int
+}
+
+// newGasPriceOracle creates the gas price oracle of the given configuration
+// and mempool min tip.
+func newGasPriceOracle(cfg config.GasPriceOracleConfig, minTip uint64) *gasPriceOracle {
+	return &gasPriceOracle{
+		blocks:      int64(max(cfg.Blocks, 1)),
+		percentile:  min(max(cfg.Percentile, 0), 100),
+		maxPrice:    new(big.Int).SetUint64(cfg.MaxPrice),
+		ignorePrice: new(big.Int).SetUint64(cfg.IgnorePrice),
+		minTip:      new(big.Int).SetUint64(minTip),
+		lastHead:    -1,
+		lastPrice:   new(big.Int).SetUint64(minTip),
+	}
+}
+
+// SuggestGasTipCap returns the gas tip cap suggested by the gas price oracle
+// from the tips paid in the latest blocks.
+func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (_ *big.Int, err error) {
+	ctx, span := tracer.Start(ctx, "SuggestGasTipCap")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if baseFee == nil {
+		// london hardfork not enabled or feemarket not enabled
+		return big.NewInt(0), nil
+	}
+
+	head, err := b.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	oracle := b.gasOracle
+	oracle.mu.Lock()
+	defer oracle.mu.Unlock()
+	if oracle.lastHead == int64(head) {
+		return new(big.Int).Set(oracle.lastPrice), nil
+	}
+
+	price, err := b.sampleGasTipCap(ctx, int64(head))
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("tip", price.String()))
+	oracle.lastHead, oracle.lastPrice = int64(head), price
+	return new(big.Int).Set(price), nil
+}
+
+// sampleGasTipCap samples the tips of the blocks up to the head and returns
+// the suggested gas tip cap.
+func (b *Backend) sampleGasTipCap(ctx context.Context, head int64) (*big.Int, error) {
+	oracle := b.gasOracle
+	blocks := min(oracle.blocks, head)
+	results := make([][]*big.Int, blocks)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(oracleBlockFetchers)
+	for i := int64(0); i < blocks; i++ {
+		g.Go(func() error {
+			tips, err := b.blockTips(gctx, head-i)
+			results[i] = tips
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return oracle.suggest(results), nil
+}
+
+// suggest returns the percentile of the tips sampled in every block, capped by
+// the max price and raised to the min tip, as the mempool rejects the lower
+// tips. The blocks without any sampled tip count as the last suggestion.
+func (o *gasPriceOracle) suggest(results [][]*big.Int) *big.Int {
+	var samples []*big.Int
+	for _, tips := range results {
+		if len(tips) == 0 {
+			samples = append(samples, o.lastPrice)
+			continue
+		}
+		samples = append(samples, tips...)
+	}
+	price := o.lastPrice
+	if len(samples) > 0 {
+		slices.SortFunc(samples, func(a, b *big.Int) int { return a.Cmp(b) })
+		price = samples[(len(samples)-1)*o.percentile/100]
+	}
+	if o.maxPrice.Sign() > 0 && price.Cmp(o.maxPrice) > 0 {
+		price = o.maxPrice
+	}
+	if price.Cmp(o.minTip) < 0 {
+		price = o.minTip
+	}
+	return new(big.Int).Set(price)
+}
+
+// blockTips returns the lowest effective tips paid in the block, ignoring the
+// transactions sent by the block proposer and the tips below the ignore price.
+// The tips are the effective gas prices of the receipts above the base fee.
+func (b *Backend) blockTips(ctx context.Context, height int64) (_ []*big.Int, err error) {
+	ctx, span := tracer.Start(ctx, "blockTips", trace.WithAttributes(attribute.Int64("height", height)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(height))
+	if block == nil || block.Block == nil {
+		return nil, err
+	}
+	blockRes, err := b.blockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	baseFee, err := b.BaseFee(ctx, blockRes)
+	if err != nil {
+		return nil, err
+	}
+	miner, err := b.MinerFromCometBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	msgs := b.EthMsgsFromCometBlock(ctx, block, blockRes)
+	receipts, err := b.ReceiptsFromCometBlock(ctx, block, blockRes, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(b.EvmChainID)
+	var tips []*big.Int
+	for i, msg := range msgs {
+		sender, err := msg.GetSenderLegacy(signer)
+		if err != nil || sender == miner {
+			continue
+		}
+		tip := new(big.Int).Set(receipts[i].EffectiveGasPrice)
+		if baseFee != nil {
+			tip.Sub(tip, baseFee)
+		}
+		if tip.Cmp(b.gasOracle.ignorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+	slices.SortFunc(tips, func(a, b *big.Int) int { return a.Cmp(b) })
+	if len(tips) > oracleSampleNumber {
+		tips = tips[:oracleSampleNumber]
+	}
+	return tips, nil
+}
@@ -0,0 +1,85 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func tips(values ...int64) []*big.Int {
+	res := make([]*big.Int, len(values))
+	for i, v := range values {
+		res[i] = big.NewInt(v)
+	}
+	return res
+}
+
+func TestGasPriceOracleSuggest(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cfg       config.GasPriceOracleConfig
+		minTip    uint64
+		lastPrice int64
+		results   [][]*big.Int
+		exp       int64
+	}{
+		{
+			"no blocks keeps the last price",
+			config.DefaultGasPriceOracleConfig(),
+			0,
+			7,
+			nil,
+			7,
+		},
+		{
+			"empty blocks count as the last price",
+			config.DefaultGasPriceOracleConfig(),
+			0,
+			7,
+			[][]*big.Int{nil, nil, tips(1, 2, 3)},
+			3,
+		},
+		{
+			"percentile of the samples",
+			config.GasPriceOracleConfig{Blocks: 3, Percentile: 50},
+			0,
+			0,
+			[][]*big.Int{tips(10, 20, 30), tips(1, 2, 3), tips(100)},
+			10,
+		},
+		{
+			"highest sample",
+			config.GasPriceOracleConfig{Blocks: 3, Percentile: 100},
+			0,
+			0,
+			[][]*big.Int{tips(10, 20, 30), tips(1, 2, 3), tips(100)},
+			100,
+		},
+		{
+			"capped by the max price",
+			config.GasPriceOracleConfig{Blocks: 3, Percentile: 100, MaxPrice: 50},
+			0,
+			0,
+			[][]*big.Int{tips(10, 20, 30), tips(100)},
+			50,
+		},
+		{
+			"raised to the min tip",
+			config.GasPriceOracleConfig{Blocks: 3, Percentile: 50},
+			15,
+			0,
+			[][]*big.Int{tips(10, 20, 30), tips(1, 2, 3), tips(100)},
+			15,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			oracle := newGasPriceOracle(tc.cfg, tc.minTip)
+			oracle.lastPrice = big.NewInt(tc.lastPrice)
+			require.Equal(t, big.NewInt(tc.exp), oracle.suggest(tc.results))
+		})
+	}
+}
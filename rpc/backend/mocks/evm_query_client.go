@@ -99,6 +99,80 @@ func (_c *EVMQueryClient_Account_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// Accounts provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) Accounts(ctx context.Context, in *types.QueryAccountsRequest, opts ...grpc.CallOption) (*types.QueryAccountsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Accounts")
+	}
+
+	var r0 *types.QueryAccountsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountsRequest, ...grpc.CallOption) (*types.QueryAccountsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAccountsRequest, ...grpc.CallOption) *types.QueryAccountsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAccountsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_Accounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Accounts'
+type EVMQueryClient_Accounts_Call struct {
+	*mock.Call
+}
+
+// Accounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryCodeRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) Accounts(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_Accounts_Call {
+	return &EVMQueryClient_Accounts_Call{Call: _e.mock.On("Accounts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_Accounts_Call) Run(run func(ctx context.Context, in *types.QueryAccountsRequest, opts ...grpc.CallOption)) *EVMQueryClient_Accounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryAccountsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_Accounts_Call) Return(_a0 *types.QueryAccountsResponse, _a1 error) *EVMQueryClient_Accounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_Accounts_Call) RunAndReturn(run func(context.Context, *types.QueryAccountsRequest, ...grpc.CallOption) (*types.QueryAccountsResponse, error)) *EVMQueryClient_Accounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Balance provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Balance(ctx context.Context, in *types.QueryBalanceRequest, opts ...grpc.CallOption) (*types.QueryBalanceResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -247,6 +321,80 @@ func (_c *EVMQueryClient_BaseFee_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// CallMany provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CallMany(ctx context.Context, in *types.CallManyRequest, opts ...grpc.CallOption) (*types.CallManyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallMany")
+	}
+
+	var r0 *types.CallManyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.CallManyRequest, ...grpc.CallOption) (*types.CallManyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.CallManyRequest, ...grpc.CallOption) *types.CallManyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CallManyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.CallManyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_CallMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallMany'
+type EVMQueryClient_CallMany_Call struct {
+	*mock.Call
+}
+
+// CallMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.CallManyRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) CallMany(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_CallMany_Call {
+	return &EVMQueryClient_CallMany_Call{Call: _e.mock.On("CallMany",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_CallMany_Call) Run(run func(ctx context.Context, in *types.CallManyRequest, opts ...grpc.CallOption)) *EVMQueryClient_CallMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.CallManyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_CallMany_Call) Return(_a0 *types.CallManyResponse, _a1 error) *EVMQueryClient_CallMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_CallMany_Call) RunAndReturn(run func(context.Context, *types.CallManyRequest, ...grpc.CallOption) (*types.CallManyResponse, error)) *EVMQueryClient_CallMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Code provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest, opts ...grpc.CallOption) (*types.QueryCodeResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -395,6 +543,80 @@ func (_c *EVMQueryClient_Config_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// ContractAccessControl provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ContractAccessControl(ctx context.Context, in *types.QueryContractAccessControlRequest, opts ...grpc.CallOption) (*types.QueryContractAccessControlResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContractAccessControl")
+	}
+
+	var r0 *types.QueryContractAccessControlResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractAccessControlRequest, ...grpc.CallOption) (*types.QueryContractAccessControlResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractAccessControlRequest, ...grpc.CallOption) *types.QueryContractAccessControlResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryContractAccessControlResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryContractAccessControlRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_ContractAccessControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContractAccessControl'
+type EVMQueryClient_ContractAccessControl_Call struct {
+	*mock.Call
+}
+
+// ContractAccessControl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryContractAccessControlRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) ContractAccessControl(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_ContractAccessControl_Call {
+	return &EVMQueryClient_ContractAccessControl_Call{Call: _e.mock.On("ContractAccessControl",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_ContractAccessControl_Call) Run(run func(ctx context.Context, in *types.QueryContractAccessControlRequest, opts ...grpc.CallOption)) *EVMQueryClient_ContractAccessControl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryContractAccessControlRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_ContractAccessControl_Call) Return(_a0 *types.QueryContractAccessControlResponse, _a1 error) *EVMQueryClient_ContractAccessControl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_ContractAccessControl_Call) RunAndReturn(run func(context.Context, *types.QueryContractAccessControlRequest, ...grpc.CallOption) (*types.QueryContractAccessControlResponse, error)) *EVMQueryClient_ContractAccessControl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CosmosAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosmosAccountRequest, opts ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -691,6 +913,154 @@ func (_c *EVMQueryClient_GlobalMinGasPrice_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// HardForks provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) HardForks(ctx context.Context, in *types.QueryHardForksRequest, opts ...grpc.CallOption) (*types.QueryHardForksResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HardForks")
+	}
+
+	var r0 *types.QueryHardForksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryHardForksRequest, ...grpc.CallOption) (*types.QueryHardForksResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryHardForksRequest, ...grpc.CallOption) *types.QueryHardForksResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryHardForksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryHardForksRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_HardForks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HardForks'
+type EVMQueryClient_HardForks_Call struct {
+	*mock.Call
+}
+
+// HardForks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryHardForksRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) HardForks(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_HardForks_Call {
+	return &EVMQueryClient_HardForks_Call{Call: _e.mock.On("HardForks",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_HardForks_Call) Run(run func(ctx context.Context, in *types.QueryHardForksRequest, opts ...grpc.CallOption)) *EVMQueryClient_HardForks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryHardForksRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_HardForks_Call) Return(_a0 *types.QueryHardForksResponse, _a1 error) *EVMQueryClient_HardForks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_HardForks_Call) RunAndReturn(run func(context.Context, *types.QueryHardForksRequest, ...grpc.CallOption) (*types.QueryHardForksResponse, error)) *EVMQueryClient_HardForks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpcodeGasSchedule provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) OpcodeGasSchedule(ctx context.Context, in *types.QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*types.QueryOpcodeGasScheduleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpcodeGasSchedule")
+	}
+
+	var r0 *types.QueryOpcodeGasScheduleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryOpcodeGasScheduleRequest, ...grpc.CallOption) (*types.QueryOpcodeGasScheduleResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryOpcodeGasScheduleRequest, ...grpc.CallOption) *types.QueryOpcodeGasScheduleResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryOpcodeGasScheduleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryOpcodeGasScheduleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_OpcodeGasSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpcodeGasSchedule'
+type EVMQueryClient_OpcodeGasSchedule_Call struct {
+	*mock.Call
+}
+
+// OpcodeGasSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryOpcodeGasScheduleRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) OpcodeGasSchedule(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_OpcodeGasSchedule_Call {
+	return &EVMQueryClient_OpcodeGasSchedule_Call{Call: _e.mock.On("OpcodeGasSchedule",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_OpcodeGasSchedule_Call) Run(run func(ctx context.Context, in *types.QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption)) *EVMQueryClient_OpcodeGasSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryOpcodeGasScheduleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_OpcodeGasSchedule_Call) Return(_a0 *types.QueryOpcodeGasScheduleResponse, _a1 error) *EVMQueryClient_OpcodeGasSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_OpcodeGasSchedule_Call) RunAndReturn(run func(context.Context, *types.QueryOpcodeGasScheduleRequest, ...grpc.CallOption) (*types.QueryOpcodeGasScheduleResponse, error)) *EVMQueryClient_OpcodeGasSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -765,6 +1135,80 @@ func (_c *EVMQueryClient_Params_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption) (*types.SimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateV1")
+	}
+
+	var r0 *types.SimulateV1Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) *types.SimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SimulateV1Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_SimulateV1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateV1'
+type EVMQueryClient_SimulateV1_Call struct {
+	*mock.Call
+}
+
+// SimulateV1 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.EthCallRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) SimulateV1(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_SimulateV1_Call {
+	return &EVMQueryClient_SimulateV1_Call{Call: _e.mock.On("SimulateV1",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Run(run func(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.SimulateV1Request), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Return(_a0 *types.SimulateV1Response, _a1 error) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) RunAndReturn(run func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -839,6 +1283,80 @@ func (_c *EVMQueryClient_Storage_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// StorageRange provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageRange(ctx context.Context, in *types.QueryStorageRangeRequest, opts ...grpc.CallOption) (*types.QueryStorageRangeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StorageRange")
+	}
+
+	var r0 *types.QueryStorageRangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) (*types.QueryStorageRangeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) *types.QueryStorageRangeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageRangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_StorageRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StorageRange'
+type EVMQueryClient_StorageRange_Call struct {
+	*mock.Call
+}
+
+// StorageRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryCodeRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) StorageRange(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_StorageRange_Call {
+	return &EVMQueryClient_StorageRange_Call{Call: _e.mock.On("StorageRange",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) Run(run func(ctx context.Context, in *types.QueryStorageRangeRequest, opts ...grpc.CallOption)) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryStorageRangeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) Return(_a0 *types.QueryStorageRangeResponse, _a1 error) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) RunAndReturn(run func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) (*types.QueryStorageRangeResponse, error)) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
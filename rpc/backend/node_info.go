@@ -319,6 +319,16 @@ func (b *Backend) RPCEVMTimeout() time.Duration {
 	return b.Cfg.JSONRPC.EVMTimeout
 }
 
+// RPCTxSyncTimeout is the default time eth_sendRawTransactionSync waits for the receipt.
+func (b *Backend) RPCTxSyncTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TxSyncTimeout
+}
+
+// RPCTxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the receipt.
+func (b *Backend) RPCTxSyncMaxTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TxSyncMaxTimeout
+}
+
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
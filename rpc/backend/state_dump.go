@@ -0,0 +1,217 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// AccountRangeMaxResults is the maximum number of results to be returned per
+	// call of debug_accountRange.
+	AccountRangeMaxResults = 256
+	// DumpStorageMaxResults is the maximum number of storage slots returned per
+	// account by debug_accountRange and debug_dumpBlock.
+	DumpStorageMaxResults = 1024
+)
+
+// StorageRangeAt returns the storage of the given account before the execution
+// of the transaction at txIndex in the given block, starting at keyStart. The
+// state is only committed at the end of each block, so txIndex must either be
+// 0, for the state at the start of the block, or the number of Ethereum
+// transactions in the block, for the state at its end.
+//
+// The storage is stored by slot, so that, unlike go-ethereum which orders and
+// keys the entries by the hash of their slot, the entries are ordered and keyed
+// by storage slot, and keyStart and NextKey are storage slots.
+func (b *Backend) StorageRangeAt(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (result rpctypes.StorageRangeResult, err error) {
+	ctx, span := tracer.Start(ctx, "StorageRangeAt", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash)), attribute.Int("txIndex", txIndex)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if maxResult <= 0 {
+		return result, errors.New("maxResult must be positive")
+	}
+
+	height, err := b.stateHeightAtTx(ctx, blockNrOrHash, txIndex)
+	if err != nil {
+		return result, err
+	}
+
+	pagination := &query.PageRequest{Limit: uint64(maxResult)}
+	if len(keyStart) > 0 {
+		pagination.Key = common.BytesToHash(keyStart).Bytes()
+	}
+	res, err := b.QueryClient.StorageRange(rpctypes.ContextWithHeight(ctx, height), &evmtypes.QueryStorageRangeRequest{
+		Address:    address.String(),
+		Pagination: pagination,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Storage = make(rpctypes.StorageMap, len(res.Storage))
+	for _, entry := range res.Storage {
+		key := common.HexToHash(entry.Key)
+		result.Storage[key] = rpctypes.StorageEntry{Key: &key, Value: common.HexToHash(entry.Value)}
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// AccountRange returns a dump of the accounts at the end of the given block,
+// ordered and keyed by address and starting at the start address. The accounts
+// are stored by address, so that no preimage is ever missing and incompletes
+// has no effect. At most DumpStorageMaxResults storage slots are returned per
+// account, the truncated storages being flagged.
+func (b *Backend) AccountRange(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (result rpctypes.Dump, err error) {
+	ctx, span := tracer.Start(ctx, "AccountRange", trace.WithAttributes(attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash)), attribute.Int("maxResults", maxResults)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if maxResults <= 0 || maxResults > AccountRangeMaxResults {
+		maxResults = AccountRangeMaxResults
+	}
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return result, err
+	}
+	header, err := b.HeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return result, err
+	}
+	result.Root = fmt.Sprintf("%x", header.Root)
+	result.Accounts = make(map[string]rpctypes.DumpAccount)
+
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+	res, err := b.QueryClient.Accounts(ctx, &evmtypes.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: start, Limit: uint64(maxResults)},
+		SkipCode:   nocode,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		codeHash := common.HexToHash(acc.CodeHash)
+		account := rpctypes.DumpAccount{DumpAccount: state.DumpAccount{
+			Balance:     acc.Balance,
+			Nonce:       acc.Nonce,
+			Root:        common.Hash{}.Bytes(),
+			CodeHash:    codeHash.Bytes(),
+			Code:        acc.Code,
+			Address:     &address,
+			AddressHash: crypto.Keccak256(address.Bytes()),
+		}}
+		if !nostorage && codeHash != ethtypes.EmptyCodeHash && codeHash != (common.Hash{}) {
+			if err := b.dumpStorage(ctx, address, &account); err != nil {
+				return result, err
+			}
+		}
+		result.Accounts[address.String()] = account
+	}
+
+	if res.Pagination != nil {
+		result.Next = res.Pagination.NextKey
+	}
+	return result, nil
+}
+
+// dumpStorage sets the storage of the given account in the state dump format,
+// keyed by storage slot. At most DumpStorageMaxResults slots are set, the
+// storage being flagged as truncated if there are more.
+func (b *Backend) dumpStorage(ctx context.Context, address common.Address, account *rpctypes.DumpAccount) error {
+	res, err := b.QueryClient.StorageRange(ctx, &evmtypes.QueryStorageRangeRequest{
+		Address:    address.String(),
+		Pagination: &query.PageRequest{Limit: DumpStorageMaxResults},
+	})
+	if err != nil {
+		return err
+	}
+
+	account.Storage = make(map[common.Hash]string, len(res.Storage))
+	for _, entry := range res.Storage {
+		account.Storage[common.HexToHash(entry.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(common.HexToHash(entry.Value).Bytes()))
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		account.StorageTruncated = true
+		account.NextStorageKey = &nextKey
+	}
+	return nil
+}
+
+// stateHeightAtTx returns the height of the committed state before the
+// execution of the transaction at txIndex in the given block. The state within
+// a block is not committed, so the indexes other than 0 and the number of
+// transactions of the block are unsupported.
+func (b *Backend) stateHeightAtTx(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int) (int64, error) {
+	if txIndex < 0 {
+		return 0, fmt.Errorf("invalid transaction index %d", txIndex)
+	}
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return 0, fmt.Errorf("block %s not found", unwrapBlockNOrHash(blockNrOrHash))
+	}
+	height := resBlock.Block.Height
+
+	if txIndex == 0 {
+		if height <= 1 {
+			return 0, errors.New("state before the first block is not available")
+		}
+		return height - 1, nil
+	}
+
+	blockRes, err := b.CometBlockResultByNumber(ctx, &height)
+	if err != nil {
+		return 0, err
+	}
+	txCount := len(b.EthMsgsFromCometBlock(ctx, resBlock, blockRes))
+	switch {
+	case txIndex > txCount:
+		return 0, fmt.Errorf("transaction index %d out of range, block %d has %d transactions", txIndex, height, txCount)
+	case txIndex < txCount:
+		return 0, fmt.Errorf(
+			"unsupported transaction index %d: the state within block %d is not committed, only the indexes 0 and %d are available",
+			txIndex, height, txCount,
+		)
+	}
+	return height, nil
+}
@@ -0,0 +1,156 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestStorageRangeAt(t *testing.T) {
+	var (
+		height  = int64(5)
+		address = common.HexToAddress("0x1000")
+		slot    = common.HexToHash("0x01")
+		value   = common.HexToHash("0x02")
+		next    = common.HexToHash("0x03")
+	)
+	blockNum := rpctypes.BlockNumber(height)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+	resBlock := &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}
+
+	testCases := []struct {
+		name      string
+		txIndex   int
+		maxResult int
+		keyStart  []byte
+		expPass   bool
+	}{
+		{"non positive max result", 0, 0, nil, false},
+		{"negative transaction index", -1, 1, nil, false},
+		{"transaction index out of range", 1, 1, nil, false},
+		{"pass", 0, 1, nil, true},
+		{"pass from the start slot", 0, 1, slot.Bytes(), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			client := backend.ClientCtx.Client.(*mocks.Client)
+			queryClient := backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			if tc.maxResult > 0 && tc.txIndex >= 0 {
+				client.On("Block", mock.Anything, &height).Return(resBlock, nil)
+			}
+			if tc.txIndex > 0 {
+				client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{Height: height}, nil)
+			}
+			if tc.expPass {
+				queryClient.On("StorageRange", mock.Anything, &evmtypes.QueryStorageRangeRequest{
+					Address:    address.String(),
+					Pagination: &query.PageRequest{Key: tc.keyStart, Limit: uint64(tc.maxResult)},
+				}).Return(&evmtypes.QueryStorageRangeResponse{
+					Storage:    []evmtypes.State{evmtypes.NewState(slot, value)},
+					Pagination: &query.PageResponse{NextKey: next.Bytes()},
+				}, nil)
+			}
+
+			res, err := backend.StorageRangeAt(context.Background(), blockNrOrHash, tc.txIndex, address, tc.keyStart, tc.maxResult)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the entries are keyed and continued by storage slot
+			entry, ok := res.Storage[slot]
+			require.True(t, ok)
+			require.Equal(t, &slot, entry.Key)
+			require.Equal(t, value, entry.Value)
+			require.Equal(t, &next, res.NextKey)
+		})
+	}
+}
+
+func TestAccountRange(t *testing.T) {
+	var (
+		height   = int64(5)
+		eoa      = common.HexToAddress("0x1000")
+		contract = common.HexToAddress("0x2000")
+		codeHash = crypto.Keccak256Hash([]byte{0x01})
+		slot     = common.HexToHash("0x01")
+		value    = common.HexToHash("0x02")
+	)
+	blockNum := rpctypes.BlockNumber(height)
+
+	// the chain config is required to build the header of the block
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithEVMCoinInfo(constants.ExampleChainCoinInfo[constants.ExampleChainID]).Configure())
+	require.NoError(t, evmtypes.SetChainConfig(evmtypes.DefaultChainConfig(constants.ExampleChainID.EVMChainID)))
+
+	// the preimage recording is disabled by default
+	backend := setupMockBackend(t)
+	require.False(t, backend.Cfg.EVM.EnablePreimageRecording)
+	client := backend.ClientCtx.Client.(*mocks.Client)
+	queryClient := backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+	resBlock := &tmrpctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}
+	client.On("Block", mock.Anything, &height).Return(resBlock, nil)
+	client.On("BlockResults", mock.Anything, &height).Return(&tmrpctypes.ResultBlockResults{Height: height}, nil)
+	client.On("ConsensusParams", mock.Anything, &height).Return(nil, errors.New("unavailable"))
+	queryClient.On("BaseFee", mock.Anything, mock.Anything).Return(&evmtypes.QueryBaseFeeResponse{}, nil)
+	queryClient.On("ValidatorAccount", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+	queryClient.On("Accounts", mock.Anything, &evmtypes.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Limit: AccountRangeMaxResults},
+	}).Return(&evmtypes.QueryAccountsResponse{
+		Accounts: []evmtypes.EthAccount{
+			{Address: eoa.Hex(), Balance: "1", Nonce: 1, CodeHash: ethtypes.EmptyCodeHash.Hex()},
+			{Address: contract.Hex(), Balance: "0", Nonce: 1, CodeHash: codeHash.Hex(), Code: []byte{0x01}},
+		},
+		Pagination: &query.PageResponse{},
+	}, nil)
+	// the storage is capped, the remaining slots starting at nextSlot
+	nextSlot := common.HexToHash("0x03")
+	queryClient.On("StorageRange", mock.Anything, &evmtypes.QueryStorageRangeRequest{
+		Address:    contract.String(),
+		Pagination: &query.PageRequest{Limit: DumpStorageMaxResults},
+	}).Return(&evmtypes.QueryStorageRangeResponse{
+		Storage:    []evmtypes.State{evmtypes.NewState(slot, value)},
+		Pagination: &query.PageResponse{NextKey: nextSlot.Bytes()},
+	}, nil)
+
+	// debug_dumpBlock doesn't include the incomplete accounts
+	res, err := backend.AccountRange(context.Background(), rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, nil, AccountRangeMaxResults, false, false, false)
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 2)
+
+	account, ok := res.Accounts[eoa.String()]
+	require.True(t, ok)
+	require.Equal(t, &eoa, account.Address)
+	require.Equal(t, "1", account.Balance)
+	require.Empty(t, account.Storage)
+	require.False(t, account.StorageTruncated)
+
+	account, ok = res.Accounts[contract.String()]
+	require.True(t, ok)
+	require.Equal(t, &contract, account.Address)
+	require.Equal(t, []byte{0x01}, []byte(account.Code))
+	require.Equal(t, map[common.Hash]string{slot: "02"}, account.Storage)
+	require.True(t, account.StorageTruncated)
+	require.Equal(t, &nextSlot, account.NextStorageKey)
+	require.Nil(t, res.Next)
+}
@@ -99,7 +99,7 @@ func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash)
 		blockTime,
 		index,
 		baseFee,
-		b.ChainConfig(),
+		b.ChainConfig(res.Height),
 	), nil
 }
 
@@ -132,7 +132,7 @@ func (b *Backend) GetTransactionByHashPending(ctx context.Context, txHash common
 				uint64(0),
 				uint64(0),
 				nil,
-				b.ChainConfig(),
+				b.ChainConfig(0),
 			), nil
 		}
 	}
@@ -154,6 +154,10 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 	hexTx := hash.Hex()
 	b.Logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if result, ok := b.caches.receipts.Get(hash); ok {
+		return result, nil
+	}
+
 	// Retry logic for transaction lookup with exponential backoff
 	maxRetries := 10
 	baseDelay := 50 * time.Millisecond
@@ -198,7 +202,7 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
 
-	blockRes, err := b.RPCClient.BlockResults(ctx, &res.Height)
+	blockRes, err := b.blockResults(ctx, &res.Height)
 	if err != nil {
 		b.Logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, fmt.Errorf("block result not found at height %d: %w", res.Height, err)
@@ -222,7 +226,12 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	result, err = rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	if err != nil {
+		return nil, err
+	}
+	b.caches.receipts.Add(hash, result)
+	return result, nil
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -445,7 +454,7 @@ func (b *Backend) GetTransactionByBlockAndIndex(ctx context.Context, block *cmtr
 		blockTime,
 		index,
 		baseFee,
-		b.ChainConfig(),
+		b.ChainConfig(block.Block.Height),
 	), nil
 }
 
@@ -557,8 +566,9 @@ func (b *Backend) getAccessListExcludes(ctx context.Context, args evmtypes.Trans
 		addressesToExclude[*args.To] = struct{}{}
 	}
 
-	isMerge := b.ChainConfig().MergeNetsplitBlock != nil
-	precompiles := vm.ActivePrecompiles(b.ChainConfig().Rules(header.Number, isMerge, header.Time))
+	chainConfig := b.ChainConfig(header.Number.Int64())
+	isMerge := chainConfig.MergeNetsplitBlock != nil
+	precompiles := vm.ActivePrecompiles(chainConfig.Rules(header.Number, isMerge, header.Time))
 	for _, addr := range precompiles {
 		addressesToExclude[addr] = struct{}{}
 	}
@@ -573,7 +583,7 @@ func (b *Backend) getAccessListExcludes(ctx context.Context, args evmtypes.Trans
 	for _, auth := range args.AuthorizationList {
 		// validate authorization (duplicating stateTransition.validateAuthorization() logic from geth: https://github.com/ethereum/go-ethereum/blob/bf8f63dcd27e178bd373bfe41ea718efee2851dd/core/state_transition.go#L575)
 		nonceOverflow := auth.Nonce+1 < auth.Nonce
-		invalidChainID := !auth.ChainID.IsZero() && auth.ChainID.CmpBig(b.ChainConfig().ChainID) != 0
+		invalidChainID := !auth.ChainID.IsZero() && auth.ChainID.CmpBig(chainConfig.ChainID) != 0
 		if nonceOverflow || invalidChainID {
 			b.Logger.Error("invalid authorization", "auth", auth)
 			continue
@@ -609,7 +619,7 @@ func (b *Backend) initAccessListTracer(ctx context.Context, args evmtypes.Transa
 		nonce64 := hexutil.Uint64(nonce)
 		args.Nonce = &nonce64
 	}
-	if err = args.CallDefaults(b.RPCGasCap(), header.BaseFee, b.ChainConfig().ChainID); err != nil {
+	if err = args.CallDefaults(b.RPCGasCap(), header.BaseFee, b.ChainConfig(header.Number.Int64()).ChainID); err != nil {
 		b.Logger.Error("failed to set default call args", "error", err)
 		return nil, nil, err
 	}
@@ -622,3 +632,62 @@ func (b *Backend) initAccessListTracer(ctx context.Context, args evmtypes.Transa
 	b.Logger.Debug("access list tracer initialized", "tracer", tracer)
 	return tracer, &args, nil
 }
+
+// ErrAddressIndexUnavailable is returned when the transactions are searched by
+// address but the EVM indexer is disabled.
+var ErrAddressIndexUnavailable = errors.New("the address index requires the EVM indexer to be enabled")
+
+// AddressTxs returns the hashes of the transactions sent or received by the
+// address in the blocks before (reverse) or after the given height, as found in
+// the address index of the EVM indexer. Whole blocks are returned until at least
+// limit transactions are found, and the boolean result reports whether other
+// transactions remain. The searches reaching the blocks before the first one
+// covered by the address index fail, as their transactions would be missing.
+func (b *Backend) AddressTxs(ctx context.Context, address common.Address, height int64, reverse bool, limit int) (hashes []common.Hash, more bool, err error) {
+	_, span := tracer.Start(ctx, "AddressTxs", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.Int64("height", height),
+		attribute.Bool("reverse", reverse),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	addressIndexer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok {
+		return nil, false, ErrAddressIndexUnavailable
+	}
+	first, err := addressIndexer.FirstIndexedAddressBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 {
+		return nil, false, errors.New("the address index is empty")
+	}
+	// the search must not reach the blocks before the first indexed one, whose
+	// transactions would be missing
+	if !reverse && height+1 < first {
+		return nil, false, fmt.Errorf("the address index starts at block %d, the search after block %d is incomplete", first, height)
+	}
+
+	hashes, more, err = addressIndexer.GetAddressTxs(address, height, reverse, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	if reverse && !more && first > 1 {
+		return nil, false, fmt.Errorf("the address index starts at block %d, the search before it is incomplete", first)
+	}
+	return hashes, more, nil
+}
+
+// ContractCreationTx returns the hash of the transaction that created the
+// contract, as found in the address index of the EVM indexer, or nil if the
+// contract wasn't created by a transaction.
+func (b *Backend) ContractCreationTx(ctx context.Context, address common.Address) (hash *common.Hash, err error) {
+	_, span := tracer.Start(ctx, "ContractCreationTx", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	addressIndexer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok {
+		return nil, ErrAddressIndexUnavailable
+	}
+	return addressIndexer.GetContractCreationTx(address)
+}
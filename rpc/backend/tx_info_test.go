@@ -13,6 +13,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -63,7 +64,8 @@ func setupMockBackend(t *testing.T) *Backend {
 		WithKeyring(keyRing).
 		WithAccountRetriever(client.TestAccountRetriever{Accounts: accounts}).
 		WithClient(mocks.NewClient(t)).
-		WithCodec(encodingConfig.Codec)
+		WithCodec(encodingConfig.Codec).
+		WithInterfaceRegistry(encodingConfig.InterfaceRegistry)
 
 	allowUnprotectedTxs := false
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
@@ -77,6 +79,11 @@ func setupMockBackend(t *testing.T) *Backend {
 	mockFeeMarketQueryClient := mocks.NewFeeMarketQueryClient(t)
 	backend.QueryClient.QueryClient = mockEVMQueryClient
 	backend.QueryClient.FeeMarket = mockFeeMarketQueryClient
+	mockEVMQueryClient.EXPECT().Config(mock.Anything, &evmtypes.QueryConfigRequest{}, mock.Anything).
+		RunAndReturn(func(context.Context, *evmtypes.QueryConfigRequest, ...grpc.CallOption) (*evmtypes.QueryConfigResponse, error) {
+			return &evmtypes.QueryConfigResponse{Config: evmtypes.GetChainConfig()}, nil
+		}).
+		Maybe()
 
 	mockClient := backend.ClientCtx.Client.(*mocks.Client)
 	mockClient.On("Status", context.Background()).Return(&tmrpctypes.ResultStatus{
@@ -502,3 +509,43 @@ func TestReceiptsFromCometBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressTxs(t *testing.T) {
+	backend := setupMockBackend(t)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), backend.Logger, backend.ClientCtx)
+	backend.Indexer = idxer
+	address := common.HexToAddress("0x1000")
+
+	_, _, err := backend.AddressTxs(context.Background(), address, 0, true, 10)
+	require.ErrorContains(t, err, "address index is empty")
+
+	// the address index starts at block 3
+	for height := int64(3); height <= 4; height++ {
+		require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: height}}, nil))
+	}
+
+	testCases := []struct {
+		name    string
+		height  int64
+		reverse bool
+		expPass bool
+	}{
+		{"after genesis", 0, false, false},
+		{"after block before the index", 1, false, false},
+		{"after block preceding the index", 2, false, true},
+		{"before latest block", 0, true, false},
+		{"before first indexed block", 3, true, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hashes, more, err := backend.AddressTxs(context.Background(), address, tc.height, tc.reverse, 10)
+			if !tc.expPass {
+				require.ErrorContains(t, err, "the address index starts at block 3")
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, hashes)
+			require.False(t, more)
+		})
+	}
+}
@@ -43,6 +43,7 @@ func (b *Backend) Content(ctx context.Context) (result map[string]map[string]map
 	if evmMempool == nil {
 		return content, nil
 	}
+	chainConfig := b.ChainConfig(curHeader.Number.Int64())
 
 	// Get pending (runnable) and queued (blocked) transactions from the mempool
 	pending, queued := evmMempool.GetTxPool().Content()
@@ -55,7 +56,7 @@ func (b *Backend) Content(ctx context.Context) (result map[string]map[string]map
 		}
 
 		for _, tx := range txList {
-			rpcTx := types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig())
+			rpcTx := types.NewRPCPendingTransaction(tx, curHeader, chainConfig)
 			content[StatusPending][addrStr][strconv.FormatUint(tx.Nonce(), 10)] = rpcTx
 		}
 	}
@@ -68,7 +69,7 @@ func (b *Backend) Content(ctx context.Context) (result map[string]map[string]map
 		}
 
 		for _, tx := range txList {
-			rpcTx := types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig())
+			rpcTx := types.NewRPCPendingTransaction(tx, curHeader, chainConfig)
 			content[StatusQueued][addrStr][strconv.FormatUint(tx.Nonce(), 10)] = rpcTx
 		}
 	}
@@ -94,6 +95,7 @@ func (b *Backend) ContentFrom(ctx context.Context, addr common.Address) (result
 	if evmMempool == nil {
 		return content, nil
 	}
+	chainConfig := b.ChainConfig(curHeader.Number.Int64())
 
 	// Get transactions for the specific address
 	pending, queue := evmMempool.GetTxPool().ContentFrom(addr)
@@ -101,7 +103,7 @@ func (b *Backend) ContentFrom(ctx context.Context, addr common.Address) (result
 	// Build the pending transactions
 	dump := make(map[string]*types.RPCTransaction, len(pending)) // variable name comes from go-ethereum: https://github.com/ethereum/go-ethereum/blob/0dacfef8ac42e7be5db26c2956f2b238ba7c75e8/internal/ethapi/api.go#L221
 	for _, tx := range pending {
-		rpcTx := types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig())
+		rpcTx := types.NewRPCPendingTransaction(tx, curHeader, chainConfig)
 		dump[fmt.Sprintf("%d", tx.Nonce())] = rpcTx
 	}
 	content[StatusPending] = dump
@@ -109,7 +111,7 @@ func (b *Backend) ContentFrom(ctx context.Context, addr common.Address) (result
 	// Build the queued transactions
 	dump = make(map[string]*types.RPCTransaction, len(queue)) // variable name comes from go-ethereum: https://github.com/ethereum/go-ethereum/blob/0dacfef8ac42e7be5db26c2956f2b238ba7c75e8/internal/ethapi/api.go#L221
 	for _, tx := range queue {
-		rpcTx := types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig())
+		rpcTx := types.NewRPCPendingTransaction(tx, curHeader, chainConfig)
 		dump[fmt.Sprintf("%d", tx.Nonce())] = rpcTx
 	}
 	content[StatusQueued] = dump
@@ -0,0 +1,1544 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package graphql provides the EIP-1767 GraphQL interface to the node data.
+//
+// The resolvers refer to the go-ethereum v1.16 graphql package, but they are
+// built on the JSON-RPC backend, which resolves the Ethereum blocks from the
+// CometBFT blocks and uses the CometBFT block hashes.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethfilters "github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+	errBlockAndHash      = errors.New("only one of number or hash must be specified")
+)
+
+// Backend defines the methods of the JSON-RPC backend used by the resolvers.
+type Backend interface {
+	filters.Backend
+
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	CometBlockByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (*coretypes.ResultBlock, error)
+	EthBlockFromCometBlock(ctx context.Context, resBlock *coretypes.ResultBlock, blockRes *coretypes.ResultBlockResults) (*ethtypes.Block, error)
+	EthMsgsFromCometBlock(ctx context.Context, resBlock *coretypes.ResultBlock, blockRes *coretypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	ReceiptsFromCometBlock(ctx context.Context, resBlock *coretypes.ResultBlock, blockRes *coretypes.ResultBlockResults, msgs []*evmtypes.MsgEthereumTx) ([]*ethtypes.Receipt, error)
+	GetTxByEthHash(ctx context.Context, txHash common.Hash) (*servertypes.TxResult, error)
+	PendingTransactions(ctx context.Context) ([]*sdk.Tx, error)
+	FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
+
+	GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
+	GetTransactionCount(ctx context.Context, address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetStorageAt(ctx context.Context, address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+
+	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
+	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+
+	ChainID(ctx context.Context) (*hexutil.Big, error)
+	GasPrice(ctx context.Context) (*hexutil.Big, error)
+	SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (*big.Int, error)
+	CurrentHeader(ctx context.Context) (*ethtypes.Header, error)
+	Syncing(ctx context.Context) (interface{}, error)
+	ChainConfig(height int64) *params.ChainConfig
+}
+
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	var err error
+	switch input := input.(type) {
+	case string:
+		// apply leniency and support hex representations of longs.
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) //#nosec G115 -- int overflow is not a concern here
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		err = fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return err
+}
+
+// Account represents an Ethereum account at a particular block.
+type Account struct {
+	r       *Resolver
+	address common.Address
+	blockNr rpctypes.BlockNumber
+}
+
+func (a *Account) blockNrOrHash() rpctypes.BlockNumberOrHash {
+	return rpctypes.BlockNumberOrHash{BlockNumber: &a.blockNr}
+}
+
+func (a *Account) Address(_ context.Context) (common.Address, error) {
+	return a.address, nil
+}
+
+func (a *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(ctx, a.address, a.blockNrOrHash())
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+// TransactionCount returns the nonce of the account, which includes the
+// transactions of the mempool for the pending block.
+func (a *Account) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	nonce, err := a.r.backend.GetTransactionCount(ctx, a.address, a.blockNr)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(ctx, a.address, a.blockNrOrHash())
+}
+
+func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log represents an individual log message. All arguments are mandatory.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:       l.r,
+		address: l.log.Address,
+		blockNr: args.NumberOrLatest(),
+	}
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple represents EIP-2930
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(_ context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(_ context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal represents a withdrawal of value from the beacon chain
+// by a validator. For details see EIP-4895.
+type Withdrawal struct {
+	index     uint64
+	validator uint64
+	address   common.Address
+	amount    uint64
+}
+
+func (w *Withdrawal) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.index)
+}
+
+func (w *Withdrawal) Validator(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.validator)
+}
+
+func (w *Withdrawal) Address(_ context.Context) common.Address {
+	return w.address
+}
+
+func (w *Withdrawal) Amount(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.amount)
+}
+
+// Transaction represents an Ethereum transaction.
+// backend and hash are mandatory; all others will be fetched when required.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash // Must be present after initialization
+	mu   sync.Mutex
+	// mu protects following resources
+	msg   *evmtypes.MsgEthereumTx
+	block *Block
+	index uint64
+}
+
+// resolve returns the internal transaction message, fetching it if needed.
+// It also returns the block the tx belongs to, unless it is a pending tx.
+func (t *Transaction) resolve(ctx context.Context) (*evmtypes.MsgEthereumTx, *Block) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.msg != nil {
+		return t.msg, t.block
+	}
+	// Try to return an already finalized transaction
+	if res, err := t.r.backend.GetTxByEthHash(ctx, t.hash); err == nil {
+		block := newBlock(t.r, rpctypes.BlockNumber(res.Height))
+		if _, err := block.resolve(ctx); err == nil {
+			for i, msg := range block.msgs {
+				if msg.Hash() == t.hash {
+					t.msg, t.block, t.index = msg, block, uint64(i)
+					return t.msg, t.block
+				}
+			}
+		}
+	}
+	// No finalized transaction, try to retrieve it from the mempool
+	msgs, err := t.r.pendingMsgs(ctx)
+	if err != nil {
+		return nil, nil
+	}
+	for _, msg := range msgs {
+		if msg.Hash() == t.hash {
+			t.msg = msg
+			break
+		}
+	}
+	return t.msg, nil
+}
+
+// resolveTx returns the internal transaction object, fetching it if needed.
+func (t *Transaction) resolveTx(ctx context.Context) (*ethtypes.Transaction, *Block) {
+	msg, block := t.resolve(ctx)
+	if msg == nil {
+		return nil, nil
+	}
+	return msg.AsTransaction(), block
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData(ctx context.Context) hexutil.Bytes {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Bytes{}
+	}
+	return tx.Data()
+}
+
+func (t *Transaction) Gas(ctx context.Context) hexutil.Uint64 {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return 0
+	}
+	return hexutil.Uint64(tx.Gas())
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) hexutil.Big {
+	tx, block := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType:
+		if block != nil {
+			if baseFee, _ := block.BaseFeePerGas(ctx); baseFee != nil {
+				// price = min(gasTipCap + baseFee, gasFeeCap)
+				return hexutil.Big(*rpctypes.EffectiveGasPrice(tx, baseFee.ToInt()))
+			}
+		}
+		return hexutil.Big(*tx.GasPrice())
+	default:
+		return hexutil.Big(*tx.GasPrice())
+	}
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.EffectiveGasPrice), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return nil
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasFeeCap())
+	default:
+		return nil
+	}
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return nil
+	}
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType, ethtypes.BlobTxType, ethtypes.SetCodeTxType:
+		return (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil
+	}
+}
+
+func (t *Transaction) MaxFeePerBlobGas(ctx context.Context) *hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return nil
+	}
+	return (*hexutil.Big)(tx.BlobGasFeeCap())
+}
+
+func (t *Transaction) BlobVersionedHashes(ctx context.Context) *[]common.Hash {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil
+	}
+	blobHashes := tx.BlobHashes()
+	return &blobHashes
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block := t.resolveTx(ctx)
+	// Pending tx
+	if tx == nil || block == nil {
+		return nil, nil
+	}
+	baseFee, err := block.BaseFeePerGas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if baseFee == nil {
+		return (*hexutil.Big)(tx.GasPrice()), nil
+	}
+	tip, err := tx.EffectiveGasTip(baseFee.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Big{}, nil
+	}
+	if tx.Value() == nil {
+		return hexutil.Big{}, fmt.Errorf("invalid transaction value %x", t.hash)
+	}
+	return hexutil.Big(*tx.Value()), nil
+}
+
+func (t *Transaction) Nonce(ctx context.Context) hexutil.Uint64 {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return 0
+	}
+	return hexutil.Uint64(tx.Nonce())
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) *Account {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil || tx.To() == nil {
+		return nil
+	}
+	return &Account{
+		r:       t.r,
+		address: *tx.To(),
+		blockNr: args.NumberOrLatest(),
+	}
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) *Account {
+	msg, _ := t.resolve(ctx)
+	if msg == nil {
+		return nil
+	}
+	return &Account{
+		r:       t.r,
+		address: msg.GetSender(),
+		blockNr: args.NumberOrLatest(),
+	}
+}
+
+func (t *Transaction) Block(ctx context.Context) *Block {
+	_, block := t.resolve(ctx)
+	return block
+}
+
+func (t *Transaction) Index(ctx context.Context) *hexutil.Uint64 {
+	_, block := t.resolve(ctx)
+	// Pending tx
+	if block == nil {
+		return nil
+	}
+	index := hexutil.Uint64(t.index)
+	return &index
+}
+
+// getReceipt returns the receipt associated with this transaction, if any.
+func (t *Transaction) getReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	_, block := t.resolve(ctx)
+	// Pending tx
+	if block == nil {
+		return nil, nil
+	}
+	receipts, err := block.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return receipts[t.index], nil
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	if len(receipt.PostState) != 0 {
+		return nil, nil
+	}
+	ret := hexutil.Uint64(receipt.Status)
+	return &ret, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.GasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, nil
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.BlobGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil || tx.Type() != ethtypes.BlobTxType {
+		return nil, nil
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.BlobGasPrice), nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{
+		r:       t.r,
+		address: receipt.ContractAddress,
+		blockNr: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	// Pending tx
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		ret = append(ret, &Log{
+			r:           t.r,
+			transaction: t,
+			log:         log,
+		})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) *hexutil.Uint64 {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return nil
+	}
+	txType := hexutil.Uint64(tx.Type())
+	return &txType
+}
+
+func (t *Transaction) AccessList(ctx context.Context) *[]*AccessTuple {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return nil
+	}
+	accessList := tx.AccessList()
+	ret := make([]*AccessTuple, 0, len(accessList))
+	for _, al := range accessList {
+		ret = append(ret, &AccessTuple{
+			address:     al.Address,
+			storageKeys: al.StorageKeys,
+		})
+	}
+	return &ret
+}
+
+func (t *Transaction) R(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	_, r, _ := tx.RawSignatureValues()
+	return hexutil.Big(*r)
+}
+
+func (t *Transaction) S(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	_, _, s := tx.RawSignatureValues()
+	return hexutil.Big(*s)
+}
+
+func (t *Transaction) V(ctx context.Context) hexutil.Big {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Big{}
+	}
+	v, _, _ := tx.RawSignatureValues()
+	return hexutil.Big(*v)
+}
+
+func (t *Transaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil || tx.Type() == ethtypes.LegacyTxType {
+		return nil, nil
+	}
+	v, _, _ := tx.RawSignatureValues()
+	ret := hexutil.Big(*v)
+	return &ret, nil
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _ := t.resolveTx(ctx)
+	if tx == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return tx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// Block represents an Ethereum block.
+// backend, and number or hash are mandatory. All other fields are lazily
+// fetched when required.
+type Block struct {
+	r      *Resolver
+	number *rpctypes.BlockNumber
+	hash   *common.Hash
+	mu     sync.Mutex
+	// mu protects following resources
+	resBlock *coretypes.ResultBlock
+	blockRes *coretypes.ResultBlockResults
+	block    *ethtypes.Block
+	msgs     []*evmtypes.MsgEthereumTx
+	receipts []*ethtypes.Receipt
+}
+
+func newBlock(r *Resolver, number rpctypes.BlockNumber) *Block {
+	return &Block{r: r, number: &number}
+}
+
+// resolve returns the internal Block object representing this block, fetching
+// it if necessary. The block is nil if it doesn't exist.
+func (b *Block) resolve(ctx context.Context) (*ethtypes.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.block != nil {
+		return b.block, nil
+	}
+	if err := chargeBlocks(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	var (
+		resBlock *coretypes.ResultBlock
+		err      error
+	)
+	if b.hash != nil {
+		resBlock, err = b.r.backend.CometBlockByHash(ctx, *b.hash)
+	} else {
+		resBlock, err = b.r.backend.CometBlockByNumber(ctx, *b.number)
+	}
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, err
+	}
+	blockRes, err := b.r.backend.CometBlockResultByNumber(ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.r.backend.EthBlockFromCometBlock(ctx, resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	b.resBlock, b.blockRes, b.block = resBlock, blockRes, block
+	b.msgs = b.r.backend.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
+	return b.block, nil
+}
+
+// resolveHeader returns the internal Header object for this block, fetching
+// it if necessary.
+func (b *Block) resolveHeader(ctx context.Context) (*ethtypes.Header, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, errors.New("block not found")
+	}
+	return block.Header(), nil
+}
+
+// resolveReceipts returns the list of receipts for this block, fetching them
+// if necessary.
+func (b *Block) resolveReceipts(ctx context.Context) ([]*ethtypes.Receipt, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+	receipts, err := b.r.backend.ReceiptsFromCometBlock(ctx, b.resBlock, b.blockRes, b.msgs)
+	if err != nil {
+		return nil, err
+	}
+	b.receipts = receipts
+	return receipts, nil
+}
+
+// blockNumber returns the number of the block, which the state of the block is
+// queried at.
+func (b *Block) blockNumber(ctx context.Context) (rpctypes.BlockNumber, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rpctypes.BlockNumber(header.Number.Int64()), nil
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Number.Uint64()), nil
+}
+
+// Hash returns the hash of the CometBFT block.
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(b.resBlock.BlockID.Hash), nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasLimit), nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasUsed), nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil {
+		return nil, nil
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, as computed by the
+// fee market for the fee history.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !b.r.backend.ChainConfig(header.Number.Int64()).IsLondon(new(big.Int).Add(header.Number, common.Big1)) {
+		return nil, nil
+	}
+	feeHistory, err := b.r.backend.FeeHistory(ctx, 1, rpc.BlockNumber(header.Number.Int64()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(feeHistory.BaseFee) < 2 {
+		return nil, nil
+	}
+	return feeHistory.BaseFee[1], nil
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Uint64() < 1 {
+		return nil, nil
+	}
+	return newBlock(b.r, rpctypes.BlockNumber(header.Number.Int64()-1)), nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*header.Difficulty), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Time), nil
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Nonce[:], nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.MixDigest, nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.TxHash, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Root, nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ReceiptHash, nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.UncleHash, nil
+}
+
+// OmmerCount always returns zero, as the blocks have no ommers.
+func (b *Block) OmmerCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(0)
+	return &count, nil
+}
+
+// Ommers always returns an empty list, as the blocks have no ommers.
+func (b *Block) Ommers(ctx context.Context) (*[]*Block, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	return &[]*Block{}, nil
+}
+
+// OmmerAt always returns nil, as the blocks have no ommers.
+func (b *Block) OmmerAt(_ context.Context, _ struct{ Index Long }) (*Block, error) {
+	return nil, nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Extra, nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Bloom.Bytes(), nil
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(header)
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(b.block)
+}
+
+// BlockNumberArgs encapsulates arguments to accessors that specify a block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOrLatest returns the provided block number argument, or the "latest" block number if none
+// was provided.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumber {
+	if a.Block != nil {
+		return rpctypes.BlockNumber(*a.Block)
+	}
+	return rpctypes.EthLatestBlockNumber
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:       b.r,
+		address: header.Coinbase,
+		blockNr: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(b.msgs))
+	return &count, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(b.msgs))
+	for i, msg := range b.msgs {
+		ret = append(ret, &Transaction{
+			r:     b.r,
+			hash:  msg.Hash(),
+			msg:   msg,
+			block: b,
+			index: uint64(i),
+		})
+	}
+	return &ret, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	if _, err := b.resolveHeader(ctx); err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(b.msgs) {
+		return nil, nil
+	}
+	msg := b.msgs[args.Index]
+	return &Transaction{
+		r:     b.r,
+		hash:  msg.Hash(),
+		msg:   msg,
+		block: b,
+		index: uint64(args.Index),
+	}, nil
+}
+
+func (b *Block) WithdrawalsRoot(ctx context.Context) (*common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.WithdrawalsHash, nil
+}
+
+func (b *Block) Withdrawals(ctx context.Context) (*[]*Withdrawal, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.WithdrawalsHash == nil {
+		return nil, err
+	}
+	ret := make([]*Withdrawal, 0, len(b.block.Withdrawals()))
+	for _, w := range b.block.Withdrawals() {
+		ret = append(ret, &Withdrawal{
+			index:     w.Index,
+			validator: w.Validator,
+			address:   w.Address,
+			amount:    w.Amount,
+		})
+	}
+	return &ret, nil
+}
+
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.BlobGasUsed == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.BlobGasUsed)
+	return &ret, nil
+}
+
+func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.ExcessBlobGas == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.ExcessBlobGas)
+	return &ret, nil
+}
+
+// BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside
+// a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+// runFilter accepts a filter and executes it within the logs and block range
+// caps of the JSON-RPC server, returning all its results as `Log` objects.
+func runFilter(ctx context.Context, r *Resolver, filter *filters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil || logs == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		})
+	}
+	return ret, nil
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	criteria := ethfilters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		criteria.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		criteria.Topics = *args.Filter.Topics
+	}
+	filter := filters.NewBlockFilter(b.r.logger, b.r.backend, criteria)
+	return runFilter(ctx, b.r, filter)
+}
+
+func (b *Block) Account(ctx context.Context, args struct {
+	Address common.Address
+},
+) (*Account, error) {
+	blockNr, err := b.blockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:       b.r,
+		address: args.Address,
+		blockNr: blockNr,
+	}, nil
+}
+
+// CallData encapsulates arguments to `call` or `estimateGas`.
+// All arguments are optional.
+type CallData struct {
+	From                 *common.Address // The Ethereum address the call is from.
+	To                   *common.Address // The Ethereum address the call is to.
+	Gas                  *Long           // The amount of gas provided for the call.
+	GasPrice             *hexutil.Big    // The price of each unit of gas, in wei.
+	MaxFeePerGas         *hexutil.Big    // The max price of each unit of gas, in wei (1559).
+	MaxPriorityFeePerGas *hexutil.Big    // The max tip of each unit of gas, in wei (1559).
+	Value                *hexutil.Big    // The value sent along with the call.
+	Data                 *hexutil.Bytes  // Any data sent with the call.
+}
+
+// toTransactionArgs converts the call data to the arguments of the calls of
+// the backend.
+func (c CallData) toTransactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Input:                c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas) //#nosec G115 -- int overflow is not a concern here
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult encapsulates the result of an invocation of the `call` accessor.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// doCall executes the call at the block number, within the gas cap and the EVM
+// timeout of the JSON-RPC server. A reverted call results in a failed status
+// with the revert data.
+func doCall(ctx context.Context, r *Resolver, data CallData, blockNr rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(ctx, data.toTransactionArgs(), blockNr, nil)
+	if err != nil {
+		var revertErr *evmtypes.RevertError
+		if !errors.As(err, &revertErr) {
+			return nil, err
+		}
+		reason, _ := revertErr.ErrorData().(string)
+		ret, err := hexutil.Decode(reason)
+		if err != nil {
+			return nil, err
+		}
+		return &CallResult{data: ret}, nil
+	}
+
+	status := hexutil.Uint64(1)
+	if res.Failed() {
+		status = 0
+	}
+	return &CallResult{
+		data:    res.Ret,
+		gasUsed: hexutil.Uint64(res.GasUsed),
+		status:  status,
+	}, nil
+}
+
+func (b *Block) Call(ctx context.Context, args struct {
+	Data CallData
+},
+) (*CallResult, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	blockNr, err := b.blockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return doCall(ctx, b.r, args.Data, blockNr)
+}
+
+func (b *Block) EstimateGas(ctx context.Context, args struct {
+	Data CallData
+},
+) (hexutil.Uint64, error) {
+	if err := chargeField(ctx); err != nil {
+		return 0, err
+	}
+	blockNr, err := b.blockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), &rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, nil)
+}
+
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	msgs, err := p.r.pendingMsgs(ctx)
+	return hexutil.Uint64(len(msgs)), err
+}
+
+func (p *Pending) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	msgs, err := p.r.pendingMsgs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(msgs))
+	for i, msg := range msgs {
+		ret = append(ret, &Transaction{
+			r:     p.r,
+			hash:  msg.Hash(),
+			msg:   msg,
+			index: uint64(i),
+		})
+	}
+	return &ret, nil
+}
+
+func (p *Pending) Account(_ context.Context, args struct {
+	Address common.Address
+},
+) *Account {
+	return &Account{
+		r:       p.r,
+		address: args.Address,
+		blockNr: rpctypes.EthPendingBlockNumber,
+	}
+}
+
+func (p *Pending) Call(ctx context.Context, args struct {
+	Data CallData
+},
+) (*CallResult, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	return doCall(ctx, p.r, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(ctx context.Context, args struct {
+	Data CallData
+},
+) (hexutil.Uint64, error) {
+	if err := chargeField(ctx); err != nil {
+		return 0, err
+	}
+	return p.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), nil, nil)
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	backend Backend
+	logger  log.Logger
+}
+
+// pendingMsgs returns the Ethereum transactions of the mempool.
+func (r *Resolver) pendingMsgs(ctx context.Context) ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				msgs = append(msgs, ethMsg)
+			}
+		}
+	}
+	return msgs, nil
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+},
+) (*Block, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	if args.Number != nil && args.Hash != nil {
+		return nil, errBlockAndHash
+	}
+	var block *Block
+	switch {
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		// the blocks above the latest one don't exist yet
+		latest, err := r.backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(*args.Number) > uint64(latest) {
+			return nil, nil
+		}
+		block = newBlock(r, rpctypes.BlockNumber(*args.Number))
+	case args.Hash != nil:
+		block = &Block{r: r, hash: args.Hash}
+	default:
+		block = newBlock(r, rpctypes.EthLatestBlockNumber)
+	}
+	// Resolve the block, return nil if it doesn't exist.
+	b, err := block.resolve(ctx)
+	if err != nil {
+		return nil, err
+	} else if b == nil {
+		return nil, nil
+	}
+	return block, nil
+}
+
+// Blocks returns the blocks of the range, which is limited by the block range
+// cap of the JSON-RPC server.
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+},
+) ([]*Block, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	latest, err := r.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := int64(*args.From)
+	to := int64(latest) //#nosec G115 -- int overflow is not a concern here
+	if args.To != nil && int64(*args.To) < to {
+		to = int64(*args.To)
+	}
+	if args.To != nil && *args.To < *args.From {
+		return nil, errInvalidBlockRange
+	}
+	if limit := int64(r.backend.RPCBlockRangeCap()); limit > 0 && to-from > limit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", limit)
+	}
+
+	var ret []*Block
+	for i := from; i <= to; i++ {
+		block := newBlock(r, rpctypes.BlockNumber(i))
+		b, err := block.resolve(ctx)
+		if err != nil {
+			return nil, err
+		} else if b == nil {
+			// Blocks after must be non-existent too, break.
+			break
+		}
+		ret = append(ret, block)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *Resolver) Pending(ctx context.Context) (*Pending, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	return &Pending{r}, nil
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	tx := &Transaction{
+		r:    r,
+		hash: args.Hash,
+	}
+	// Resolve the transaction; if it doesn't exist, return nil.
+	if msg, _ := tx.resolve(ctx); msg == nil {
+		return nil, nil
+	}
+	return tx, nil
+}
+
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	if err := chargeField(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	return r.backend.SendRawTransaction(ctx, args.Data)
+}
+
+// FilterCriteria encapsulates the arguments to `logs` on the root resolver object.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means latest block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	// Convert the RPC block numbers into internal representations
+	begin := rpc.LatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpc.LatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	if err := r.chargeRange(ctx, begin, end); err != nil {
+		return nil, err
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	// Construct the range filter
+	filter := filters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics)
+	return runFilter(ctx, r, filter)
+}
+
+// chargeRange charges the block range filtered by the logs to the budget of the
+// query, the latest block being used for the negative block numbers.
+func (r *Resolver) chargeRange(ctx context.Context, begin, end int64) error {
+	if begin < 0 || end < 0 {
+		latest, err := r.backend.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		if begin < 0 {
+			begin = int64(latest) //#nosec G115 -- int overflow is not a concern here
+		}
+		if end < 0 {
+			end = int64(latest) //#nosec G115 -- int overflow is not a concern here
+		}
+	}
+	if begin > end {
+		return nil
+	}
+	return chargeBlocks(ctx, end-begin+1)
+}
+
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	if err := chargeField(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	price, err := r.backend.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	if err := chargeField(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	head, err := r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tipcap, err := r.backend.SuggestGasTipCap(ctx, head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*tipcap), nil
+}
+
+func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	if err := chargeField(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	chainID, err := r.backend.ChainID(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState represents the synchronisation status returned from the `syncing` accessor.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock returns the current block, as the highest block known by the
+// peers of CometBFT isn't available.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// Syncing returns nil in case the node is currently not syncing with the network,
+// or the synchronisation state of CometBFT otherwise.
+func (r *Resolver) Syncing(ctx context.Context) (*SyncState, error) {
+	if err := chargeField(ctx); err != nil {
+		return nil, err
+	}
+	res, err := r.backend.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	state := &SyncState{}
+	state.startingBlock, _ = progress["startingBlock"].(hexutil.Uint64)
+	state.currentBlock, _ = progress["currentBlock"].(hexutil.Uint64)
+	return state, nil
+}
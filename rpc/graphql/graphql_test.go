@@ -0,0 +1,249 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+var (
+	blockHash = common.HexToHash("0xb10c")
+	sender    = common.HexToAddress("0x5e4d")
+	recipient = common.HexToAddress("0x4ec1")
+)
+
+// backend serves a chain of two blocks, the latter including a single
+// transaction.
+type backend struct {
+	Backend
+
+	msg     *evmtypes.MsgEthereumTx
+	callErr error
+}
+
+func newBackend() *backend {
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    3,
+		GasPrice: big.NewInt(10),
+		Gas:      21000,
+		To:       &recipient,
+		Value:    big.NewInt(100),
+	})
+	msg := &evmtypes.MsgEthereumTx{}
+	msg.FromEthereumTx(tx)
+	msg.From = sender.Bytes()
+	return &backend{msg: msg}
+}
+
+func (b *backend) BlockNumber(context.Context) (hexutil.Uint64, error) { return 2, nil }
+
+func (b *backend) RPCBlockRangeCap() int32 { return 10 }
+
+func (b *backend) CometBlockByNumber(_ context.Context, blockNum rpctypes.BlockNumber) (*coretypes.ResultBlock, error) {
+	height := blockNum.Int64()
+	if blockNum == rpctypes.EthLatestBlockNumber {
+		height = 2
+	}
+	if height > 2 {
+		return nil, nil
+	}
+	return &coretypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: blockHash.Bytes()},
+		Block:   &cmttypes.Block{Header: cmttypes.Header{Height: height}},
+	}, nil
+}
+
+func (b *backend) CometBlockResultByNumber(context.Context, *int64) (*coretypes.ResultBlockResults, error) {
+	return &coretypes.ResultBlockResults{}, nil
+}
+
+func (b *backend) EthBlockFromCometBlock(_ context.Context, resBlock *coretypes.ResultBlock, _ *coretypes.ResultBlockResults) (*ethtypes.Block, error) {
+	return ethtypes.NewBlockWithHeader(&ethtypes.Header{
+		Number:     big.NewInt(resBlock.Block.Height),
+		Difficulty: common.Big0,
+		GasLimit:   10_000_000,
+	}), nil
+}
+
+func (b *backend) EthMsgsFromCometBlock(_ context.Context, resBlock *coretypes.ResultBlock, _ *coretypes.ResultBlockResults) []*evmtypes.MsgEthereumTx {
+	if resBlock.Block.Height != 2 {
+		return nil
+	}
+	return []*evmtypes.MsgEthereumTx{b.msg}
+}
+
+func (b *backend) ReceiptsFromCometBlock(context.Context, *coretypes.ResultBlock, *coretypes.ResultBlockResults, []*evmtypes.MsgEthereumTx) ([]*ethtypes.Receipt, error) {
+	return []*ethtypes.Receipt{{
+		Status:  ethtypes.ReceiptStatusSuccessful,
+		GasUsed: 21000,
+		Logs:    []*ethtypes.Log{{Address: recipient, Data: []byte{1}}},
+	}}, nil
+}
+
+func (b *backend) DoCall(context.Context, evmtypes.TransactionArgs, rpctypes.BlockNumber, *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error) {
+	if b.callErr != nil {
+		return nil, b.callErr
+	}
+	return &evmtypes.MsgEthereumTxResponse{Ret: []byte{0x2a}, GasUsed: 30000}, nil
+}
+
+func query(t *testing.T, b Backend, q string) (int, map[string]interface{}) {
+	t.Helper()
+	return queryWithTimeout(t, b, q, 0)
+}
+
+func queryWithTimeout(t *testing.T, b Backend, q string, timeout time.Duration) (int, map[string]interface{}) {
+	t.Helper()
+	h, err := NewHandler(b, log.NewNopLogger(), timeout)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(map[string]string{"query": q})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+
+	var res map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return rec.Code, res
+}
+
+func TestLongUnmarshal(t *testing.T) {
+	testCases := []struct {
+		input   interface{}
+		expLong Long
+		expErr  bool
+	}{
+		{"0x10", 16, false},
+		{"16", 16, false},
+		{int32(16), 16, false},
+		{float64(16), 16, false},
+		{"0xzz", 0, true},
+		{true, 0, true},
+	}
+	for _, tc := range testCases {
+		var l Long
+		err := l.UnmarshalGraphQL(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expLong, l)
+	}
+}
+
+func TestBlockQuery(t *testing.T) {
+	code, res := query(t, newBackend(), `{
+		block {
+			number hash gasLimit transactionCount
+			transactions { hash nonce value from { address } to { address } status gasUsed logs { data } }
+		}
+	}`)
+	require.Equal(t, http.StatusOK, code, res)
+
+	block := res["data"].(map[string]interface{})["block"].(map[string]interface{})
+	require.Equal(t, "0x2", block["number"])
+	require.Equal(t, blockHash.Hex(), block["hash"])
+	require.Equal(t, "0x1", block["transactionCount"])
+
+	tx := block["transactions"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0x3", tx["nonce"])
+	require.Equal(t, "0x64", tx["value"])
+	require.Equal(t, strings.ToLower(sender.Hex()), tx["from"].(map[string]interface{})["address"])
+	require.Equal(t, strings.ToLower(recipient.Hex()), tx["to"].(map[string]interface{})["address"])
+	require.Equal(t, "0x1", tx["status"])
+	require.Equal(t, "0x5208", tx["gasUsed"])
+	require.Equal(t, []interface{}{map[string]interface{}{"data": "0x01"}}, tx["logs"])
+}
+
+func TestBlockQueryFutureBlock(t *testing.T) {
+	code, res := query(t, newBackend(), `{ block(number: 3) { number } }`)
+	require.Equal(t, http.StatusOK, code, res)
+	require.Nil(t, res["data"].(map[string]interface{})["block"])
+}
+
+func TestBlocksQueryRangeCap(t *testing.T) {
+	code, res := query(t, newBackend(), `{ blocks(from: 1) { number } }`)
+	require.Equal(t, http.StatusOK, code, res)
+	require.Len(t, res["data"].(map[string]interface{})["blocks"], 2)
+
+	b := &cappedBackend{newBackend()}
+	code, res = query(t, b, `{ blocks(from: 0, to: 2) { number } }`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res["errors"].([]interface{})[0].(map[string]interface{})["message"], "maximum [from, to] blocks distance: 1")
+}
+
+type cappedBackend struct {
+	*backend
+}
+
+func (cappedBackend) RPCBlockRangeCap() int32 { return 1 }
+
+func TestQueryBudget(t *testing.T) {
+	// the aliases share the block range cap
+	b := &cappedBackend{newBackend()}
+	code, res := query(t, b, `{ a: blocks(from: 1, to: 2) { number } b: blocks(from: 1, to: 2) { number } }`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res["errors"].([]interface{})[0].(map[string]interface{})["message"], "query exceeds the maximum of 2 blocks")
+
+	// the root fields and calls are limited, the aliased ones included
+	calls := make([]string, maxQueryFields)
+	for i := range calls {
+		calls[i] = fmt.Sprintf(`c%d: call(data: { to: "0x0000000000000000000000000000000000004ec1" }) { status }`, i)
+	}
+	code, res = query(t, newBackend(), fmt.Sprintf(`{ block(number: 1) { %s } }`, strings.Join(calls, " ")))
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res["errors"].([]interface{})[0].(map[string]interface{})["message"], "query exceeds the maximum of 32 root fields and calls")
+
+	code, res = query(t, newBackend(), fmt.Sprintf(`{ block(number: 1) { %s } }`, strings.Join(calls[1:], " ")))
+	require.Equal(t, http.StatusOK, code, res)
+}
+
+type slowBackend struct {
+	*backend
+}
+
+func (slowBackend) DoCall(ctx context.Context, _ evmtypes.TransactionArgs, _ rpctypes.BlockNumber, _ *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestQueryTimeout(t *testing.T) {
+	code, res := queryWithTimeout(t, &slowBackend{newBackend()}, `{ pending { call(data: { to: "0x0000000000000000000000000000000000004ec1" }) { status } } }`, 10*time.Millisecond)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, res["errors"].([]interface{})[0].(map[string]interface{})["message"], context.DeadlineExceeded.Error())
+}
+
+func TestCallQuery(t *testing.T) {
+	b := newBackend()
+	code, res := query(t, b, `{ block(number: 1) { call(data: { to: "0x0000000000000000000000000000000000004ec1" }) { data gasUsed status } } }`)
+	require.Equal(t, http.StatusOK, code, res)
+	call := res["data"].(map[string]interface{})["block"].(map[string]interface{})["call"]
+	require.Equal(t, map[string]interface{}{"data": "0x2a", "gasUsed": "0x7530", "status": "0x1"}, call)
+
+	b.callErr = evmtypes.NewExecErrorWithReason([]byte{0xde, 0xad})
+	code, res = query(t, b, `{ pending { call(data: { to: "0x0000000000000000000000000000000000004ec1" }) { data status } } }`)
+	require.Equal(t, http.StatusOK, code, res)
+	call = res["data"].(map[string]interface{})["pending"].(map[string]interface{})["call"]
+	require.Equal(t, map[string]interface{}{"data": "0xdead", "status": "0x0"}, call)
+}
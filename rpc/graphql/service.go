@@ -0,0 +1,145 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"cosmossdk.io/log/v2"
+)
+
+const (
+	// maxQueryDepth is the maximum depth of the selections of a query.
+	maxQueryDepth = 16
+	// maxQueryFields is the maximum number of root fields and EVM calls resolved
+	// per query, the aliased ones included.
+	maxQueryFields = 32
+)
+
+// queryBudget bounds the work of a query, which is charged as a single method
+// by the access control while aliasing its fields repeats them.
+type queryBudget struct {
+	mu        sync.Mutex
+	fields    int
+	blocks    int64 // unlimited if negative
+	maxBlocks int64
+}
+
+type queryBudgetKey struct{}
+
+// withQueryBudget returns a context carrying the budget of a query, allowed to
+// fetch one more block than the block range cap.
+func withQueryBudget(ctx context.Context, blockRangeCap int64) context.Context {
+	budget := &queryBudget{blocks: -1}
+	if blockRangeCap > 0 {
+		budget.blocks = blockRangeCap + 1
+		budget.maxBlocks = budget.blocks
+	}
+	return context.WithValue(ctx, queryBudgetKey{}, budget)
+}
+
+// chargeField charges a root field or an EVM call to the budget of the query.
+func chargeField(ctx context.Context) error {
+	budget, ok := ctx.Value(queryBudgetKey{}).(*queryBudget)
+	if !ok {
+		return nil
+	}
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	if budget.fields >= maxQueryFields {
+		return fmt.Errorf("query exceeds the maximum of %d root fields and calls", maxQueryFields)
+	}
+	budget.fields++
+	return nil
+}
+
+// chargeBlocks charges the blocks fetched or filtered to the budget of the
+// query, which is shared by all the fields within the block range cap.
+func chargeBlocks(ctx context.Context, n int64) error {
+	budget, ok := ctx.Value(queryBudgetKey{}).(*queryBudget)
+	if !ok || budget.blocks < 0 {
+		return nil
+	}
+	budget.mu.Lock()
+	defer budget.mu.Unlock()
+	if n > budget.blocks {
+		return fmt.Errorf("query exceeds the maximum of %d blocks", budget.maxBlocks)
+	}
+	budget.blocks -= n
+	return nil
+}
+
+type handler struct {
+	Schema        *graphql.Schema
+	Timeout       time.Duration
+	BlockRangeCap int64
+}
+
+func (h handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if h.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.Timeout)
+		defer cancel()
+	}
+	ctx = withQueryBudget(ctx, h.BlockRangeCap)
+
+	response := h.Schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
+
+// NewHandler returns a new `http.Handler` that will answer GraphQL queries
+// with the data of the backend, each within the timeout if positive.
+func NewHandler(backend Backend, logger log.Logger, timeout time.Duration) (http.Handler, error) {
+	q := Resolver{backend: backend, logger: logger}
+
+	s, err := graphql.ParseSchema(schema, &q, graphql.MaxDepth(maxQueryDepth))
+	if err != nil {
+		return nil, err
+	}
+	return handler{
+		Schema:        s,
+		Timeout:       timeout,
+		BlockRangeCap: int64(backend.RPCBlockRangeCap()),
+	}, nil
+}
@@ -0,0 +1,213 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+const (
+	// HealthPath is the path of the liveness endpoint.
+	HealthPath = "/health"
+	// ReadyPath is the path of the readiness endpoint.
+	ReadyPath = "/ready"
+
+	// checkTimeout bounds the queries of the checks to the node.
+	checkTimeout = 5 * time.Second
+)
+
+// The names of the checks.
+const (
+	CheckNode        = "node"
+	CheckSync        = "sync"
+	CheckIndexer     = "indexer"
+	CheckEventStream = "event-stream"
+	CheckMempool     = "mempool"
+)
+
+// StreamStatus returns the liveness of the event subscriptions of the streams.
+type StreamStatus interface {
+	Status() stream.Status
+}
+
+// Check is the result of a check of the node.
+type Check struct {
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+	// Critical is set on the failed checks failing the liveness, while the
+	// other ones only fail the readiness.
+	Critical bool   `json:"critical,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Report is the JSON body of the responses of the endpoints.
+type Report struct {
+	// Healthy is false if a critical check failed
+	Healthy bool `json:"healthy"`
+	// Ready is false if any check failed
+	Ready  bool    `json:"ready"`
+	Checks []Check `json:"checks"`
+}
+
+// Checker checks the health and the readiness of the node served by the
+// JSON-RPC server. A node is healthy while it reaches CometBFT and its event
+// stream is subscribed, and ready when it is also synced, its EVM indexer and
+// event stream are up to date and its mempool is available.
+type Checker struct {
+	client  rpcclient.StatusClient
+	mempool rpcclient.MempoolClient   // nil if unavailable
+	indexer servertypes.EVMLogIndexer // nil if disabled
+	stream  StreamStatus
+	cfg     config.HealthConfig
+}
+
+// NewChecker creates the checker of the node of the client. The mempool is
+// checked through the client if it implements the mempool client, and the
+// indexer is checked if it implements the log indexer, which marks every block
+// it processed, with or without EVM txs.
+func NewChecker(
+	client rpcclient.StatusClient,
+	indexer servertypes.EVMTxIndexer,
+	stream StreamStatus,
+	cfg config.HealthConfig,
+) *Checker {
+	mempool, _ := client.(rpcclient.MempoolClient)
+	logIndexer, _ := indexer.(servertypes.EVMLogIndexer)
+	return &Checker{
+		client:  client,
+		mempool: mempool,
+		indexer: logIndexer,
+		stream:  stream,
+		cfg:     cfg,
+	}
+}
+
+// Check runs the checks of the node.
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var checks []Check
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		checks = append(checks,
+			Check{Name: CheckNode, Critical: true, Message: err.Error()},
+			Check{Name: CheckSync, Message: "node unavailable"},
+		)
+	} else {
+		checks = append(checks,
+			Check{Name: CheckNode, OK: true, Message: fmt.Sprintf("latest height %d", status.SyncInfo.LatestBlockHeight)},
+			checkSync(status.SyncInfo.CatchingUp),
+		)
+	}
+
+	if c.indexer != nil {
+		if err != nil {
+			checks = append(checks, Check{Name: CheckIndexer, Message: "node unavailable"})
+		} else {
+			checks = append(checks, c.checkIndexer(status.SyncInfo.LatestBlockHeight))
+		}
+	}
+
+	checks = append(checks, c.checkEventStream(time.Now()), c.checkMempool(ctx))
+
+	report := Report{Healthy: true, Ready: true, Checks: checks}
+	for _, check := range checks {
+		if !check.OK {
+			report.Ready = false
+			report.Healthy = report.Healthy && !check.Critical
+		}
+	}
+	return report
+}
+
+func checkSync(catchingUp bool) Check {
+	if catchingUp {
+		return Check{Name: CheckSync, Message: "catching up"}
+	}
+	return Check{Name: CheckSync, OK: true, Message: "synced"}
+}
+
+// checkIndexer checks the lag of the EVM indexer behind the latest height.
+func (c *Checker) checkIndexer(latest int64) Check {
+	last, err := c.indexer.LastIndexedLogBlock()
+	if err != nil {
+		return Check{Name: CheckIndexer, Message: err.Error()}
+	}
+	if last < 0 {
+		return Check{Name: CheckIndexer, Message: "no block indexed"}
+	}
+
+	lag := max(latest-last, 0)
+	msg := fmt.Sprintf("last indexed height %d, lag %d", last, lag)
+	return Check{Name: CheckIndexer, OK: lag <= c.cfg.MaxIndexerLag, Message: msg}
+}
+
+// checkEventStream checks that the event subscriptions are alive, and that
+// they received a block recently.
+func (c *Checker) checkEventStream(now time.Time) Check {
+	status := c.stream.Status()
+	switch {
+	case status.Closed:
+		return Check{Name: CheckEventStream, Critical: true, Message: "subscription closed"}
+	case !status.Subscribed:
+		return Check{Name: CheckEventStream, OK: true, Message: "not subscribed"}
+	}
+
+	last := status.LastBlockAt
+	if last.Before(status.SubscribedAt) {
+		last = status.SubscribedAt
+	}
+	age := now.Sub(last).Truncate(time.Millisecond)
+	if c.cfg.MaxBlockAge > 0 && age > c.cfg.MaxBlockAge {
+		return Check{Name: CheckEventStream, Message: fmt.Sprintf("no block received for %s", age)}
+	}
+	if status.LastBlockAt.IsZero() {
+		return Check{Name: CheckEventStream, OK: true, Message: "no block received"}
+	}
+	return Check{Name: CheckEventStream, OK: true, Message: fmt.Sprintf("last block received %s ago", age)}
+}
+
+// checkMempool checks that the mempool of the node can be queried.
+func (c *Checker) checkMempool(ctx context.Context) Check {
+	if c.mempool == nil {
+		return Check{Name: CheckMempool, Message: "unavailable"}
+	}
+	res, err := c.mempool.NumUnconfirmedTxs(ctx)
+	if err != nil {
+		return Check{Name: CheckMempool, Message: err.Error()}
+	}
+	return Check{Name: CheckMempool, OK: true, Message: fmt.Sprintf("%d pending txs", res.Total)}
+}
+
+// Handler serves the GET requests to the health and readiness endpoints,
+// responding with the report of the checks and a 503 status when the node
+// isn't healthy or ready, and passes the other requests to the next handler.
+func (c *Checker) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || (r.URL.Path != HealthPath && r.URL.Path != ReadyPath) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		report := c.Check(r.Context())
+		ok := report.Ready
+		if r.URL.Path == HealthPath {
+			ok = report.Healthy
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
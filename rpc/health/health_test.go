@@ -0,0 +1,204 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+type statusClient struct {
+	status *coretypes.ResultStatus
+	err    error
+}
+
+func (c statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return c.status, c.err
+}
+
+type mempoolClient struct {
+	statusClient
+	err error
+}
+
+func (c mempoolClient) UnconfirmedTxs(context.Context, *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	return &coretypes.ResultUnconfirmedTxs{}, c.err
+}
+
+func (c mempoolClient) NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	return &coretypes.ResultUnconfirmedTxs{Total: 3}, c.err
+}
+
+func (c mempoolClient) CheckTx(context.Context, cmttypes.Tx) (*coretypes.ResultCheckTx, error) {
+	return &coretypes.ResultCheckTx{}, c.err
+}
+
+type indexer struct {
+	last int64
+}
+
+func (indexer) LastIndexedBlock() (int64, error) { return -1, nil }
+
+func (indexer) IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error { return nil }
+
+func (indexer) GetByTxHash(common.Hash) (*servertypes.TxResult, error) { return nil, nil }
+
+func (indexer) GetByBlockAndIndex(int64, int32) (*servertypes.TxResult, error) { return nil, nil }
+
+func (indexer) FirstIndexedLogBlock() (int64, error) { return 0, nil }
+
+func (i indexer) LastIndexedLogBlock() (int64, error) { return i.last, nil }
+
+func (indexer) GetLogPositions(int64, int64, []common.Address, [][]common.Hash) ([]servertypes.LogPosition, error) {
+	return nil, nil
+}
+
+type streamStatus stream.Status
+
+func (s streamStatus) Status() stream.Status { return stream.Status(s) }
+
+func checks(report Report) map[string]Check {
+	byName := make(map[string]Check, len(report.Checks))
+	for _, check := range report.Checks {
+		byName[check.Name] = check
+	}
+	return byName
+}
+
+func TestCheck(t *testing.T) {
+	now := time.Now()
+	synced := statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 100}}}
+	liveStream := streamStatus{Subscribed: true, SubscribedAt: now.Add(-time.Hour), LastBlockAt: now}
+	cfg := config.DefaultHealthConfig()
+
+	testCases := []struct {
+		name    string
+		client  statusClient
+		mempool bool
+		indexer servertypes.EVMTxIndexer
+		stream  streamStatus
+		healthy bool
+		ready   bool
+		failed  []string
+	}{
+		{
+			name:    "ready",
+			client:  synced,
+			mempool: true,
+			indexer: indexer{last: 95},
+			stream:  liveStream,
+			healthy: true,
+			ready:   true,
+		},
+		{
+			name:    "not subscribed and indexer disabled",
+			client:  synced,
+			mempool: true,
+			healthy: true,
+			ready:   true,
+		},
+		{
+			name:    "node unavailable",
+			client:  statusClient{err: errors.New("connection refused")},
+			mempool: true,
+			indexer: indexer{last: 95},
+			stream:  liveStream,
+			failed:  []string{CheckNode, CheckSync, CheckIndexer},
+		},
+		{
+			name:    "catching up with a lagging indexer",
+			client:  statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 100, CatchingUp: true}}},
+			mempool: true,
+			indexer: indexer{last: 50},
+			stream:  liveStream,
+			healthy: true,
+			failed:  []string{CheckSync, CheckIndexer},
+		},
+		{
+			name:    "stale event stream and mempool unavailable",
+			client:  synced,
+			stream:  streamStatus{Subscribed: true, SubscribedAt: now.Add(-time.Hour), LastBlockAt: now.Add(-2 * cfg.MaxBlockAge)},
+			healthy: true,
+			failed:  []string{CheckEventStream, CheckMempool},
+		},
+		{
+			name:    "closed event stream",
+			client:  synced,
+			mempool: true,
+			stream:  streamStatus{Subscribed: true, Closed: true},
+			failed:  []string{CheckEventStream},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var checker *Checker
+			if tc.mempool {
+				checker = NewChecker(mempoolClient{statusClient: tc.client}, tc.indexer, tc.stream, cfg)
+			} else {
+				checker = NewChecker(tc.client, tc.indexer, tc.stream, cfg)
+			}
+
+			report := checker.Check(context.Background())
+			require.Equal(t, tc.healthy, report.Healthy)
+			require.Equal(t, tc.ready, report.Ready)
+
+			var failed []string
+			for _, check := range report.Checks {
+				if !check.OK {
+					failed = append(failed, check.Name)
+				}
+			}
+			require.ElementsMatch(t, tc.failed, failed)
+			_, indexed := checks(report)[CheckIndexer]
+			require.Equal(t, tc.indexer != nil, indexed)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	client := mempoolClient{statusClient: statusClient{status: &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 100, CatchingUp: true},
+	}}}
+	checker := NewChecker(client, nil, streamStatus{}, config.DefaultHealthConfig())
+	handler := checker.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+
+	// a node catching up is healthy but not ready
+	rec := serve(http.MethodGet, HealthPath)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.True(t, report.Healthy)
+	require.False(t, report.Ready)
+	require.False(t, checks(report)[CheckSync].OK)
+
+	rec = serve(http.MethodGet, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	// the other requests are passed to the JSON-RPC server
+	require.Equal(t, http.StatusTeapot, serve(http.MethodPost, "/").Code)
+	require.Equal(t, http.StatusTeapot, serve(http.MethodPost, HealthPath).Code)
+}
@@ -0,0 +1,332 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// ForwardedHeader marks the requests forwarded by the WebSocket server to
+	// the HTTP server, which were already checked by the access control.
+	ForwardedHeader = "X-Cosmos-Evm-Forwarded"
+	// APIKeyRoute is the route of the API key set as the URL path.
+	APIKeyRoute = "/{" + apiKeyVar + "}"
+	// GraphQLPath is the URL path of the GraphQL API, which is not an API key.
+	GraphQLPath = "/graphql"
+	// GraphQLMethod is the method charged for the GraphQL queries, whose cost
+	// is set by the cost classes. Its namespace is graphql.
+	GraphQLMethod = "graphql"
+
+	// maxRequestSize is the max number of bytes of a request read to find its
+	// methods, which matches the max request size of the go-ethereum server.
+	maxRequestSize = 5 * 1024 * 1024
+	// ipLimitersSize is the max number of client IPs whose buckets are kept.
+	ipLimitersSize = 16384
+
+	apiKeyVar = "apikey"
+)
+
+// AccessControl authenticates the clients of the JSON-RPC server by API key,
+// checks the namespaces allowed for their key and enforces the token bucket
+// rate limits. Every request consumes the cost of its methods from the bucket
+// of its API key or, without key, from the bucket of its client IP.
+type AccessControl struct {
+	requireKey bool
+	header     string
+	keys       map[string]*apiKey
+	costs      methodCosts
+	ipRate     float64
+	ipBurst    int
+	// forwardToken authenticates the requests forwarded by the WebSocket server
+	forwardToken string
+
+	mu         sync.Mutex
+	ipLimiters *lru.BasicLRU[string, *rate.Limiter]
+}
+
+// apiKey is an API key accepted by the server.
+type apiKey struct {
+	limiter    *rate.Limiter       // nil if unlimited
+	namespaces map[string]struct{} // nil if all the namespaces are allowed
+}
+
+// Client is an authenticated client of the JSON-RPC server, holding either an
+// API key or a client IP.
+type Client struct {
+	key *apiKey
+	ip  string
+}
+
+// NewAccessControl creates the access control of the given configuration.
+func NewAccessControl(cfg config.AccessControlConfig) (*AccessControl, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*apiKey, len(cfg.APIKeys))
+	for _, key := range cfg.APIKeys {
+		k := &apiKey{limiter: newLimiter(key.Rate, key.Burst)}
+		if len(key.Namespaces) > 0 {
+			k.namespaces = make(map[string]struct{}, len(key.Namespaces))
+			for _, ns := range key.Namespaces {
+				k.namespaces[ns] = struct{}{}
+			}
+		}
+		keys[key.Key] = k
+	}
+	ipLimiters := lru.NewBasicLRU[string, *rate.Limiter](ipLimitersSize)
+	return &AccessControl{
+		requireKey:   cfg.RequireAPIKey,
+		header:       cfg.APIKeyHeader,
+		keys:         keys,
+		costs:        newMethodCosts(cfg.CostClasses),
+		ipRate:       cfg.IPRate,
+		ipBurst:      cfg.IPBurst,
+		forwardToken: hex.EncodeToString(token),
+		ipLimiters:   &ipLimiters,
+	}, nil
+}
+
+// newLimiter returns a token bucket limiter, or nil if the rate is unlimited.
+func newLimiter(r float64, burst int) *rate.Limiter {
+	if r <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(r), burst)
+}
+
+// Authenticate returns the client of the request. The API key is read from the
+// configured header, or else from the URL path of the request routed to the
+// API key route.
+func (ac *AccessControl) Authenticate(r *http.Request) (*Client, error) {
+	key := r.Header.Get(ac.header)
+	if key == "" {
+		key = mux.Vars(r)[apiKeyVar]
+	}
+	if key != "" {
+		k, ok := ac.keys[key]
+		if !ok {
+			return nil, ErrUnauthorized
+		}
+		return &Client{key: k}, nil
+	}
+	if ac.requireKey {
+		return nil, ErrUnauthorized
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return &Client{ip: ip}, nil
+}
+
+// Allow checks that the namespaces of the methods are allowed for the client
+// and consumes their cost from its bucket.
+func (ac *AccessControl) Allow(client *Client, methods []string) error {
+	cost := 0
+	for _, method := range methods {
+		if client.key != nil && client.key.namespaces != nil {
+			ns, _, _ := strings.Cut(method, "_")
+			if _, ok := client.key.namespaces[ns]; !ok {
+				return NewMethodNotAllowedError(method)
+			}
+		}
+		cost += ac.costs.cost(method)
+	}
+	return ac.consume(client, cost)
+}
+
+// consume consumes the given request units from the bucket of the client. A
+// cost above the burst of the bucket can never be consumed, and is rejected
+// as such rather than as a rate limited request.
+func (ac *AccessControl) consume(client *Client, cost int) error {
+	limiter := ac.limiter(client)
+	if limiter == nil {
+		return nil
+	}
+	if cost > limiter.Burst() {
+		return NewCostExceedsBurstError(cost, limiter.Burst())
+	}
+	if !limiter.AllowN(time.Now(), cost) {
+		return ErrLimitExceeded
+	}
+	return nil
+}
+
+// limiter returns the bucket of the client, or nil if it is unlimited.
+func (ac *AccessControl) limiter(client *Client) *rate.Limiter {
+	if client.key != nil {
+		return client.key.limiter
+	}
+	if ac.ipRate <= 0 {
+		return nil
+	}
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	limiter, ok := ac.ipLimiters.Get(client.ip)
+	if !ok {
+		limiter = newLimiter(ac.ipRate, ac.ipBurst)
+		ac.ipLimiters.Add(client.ip, limiter)
+	}
+	return limiter
+}
+
+// AllowMessage checks the requests of a JSON-RPC message, which may be a batch.
+// The malformed messages cost one request unit, and are left to the server to
+// be rejected.
+func (ac *AccessControl) AllowMessage(client *Client, msg []byte) error {
+	reqs, _, err := parseRequests(msg)
+	if err != nil {
+		return ac.consume(client, 1)
+	}
+	methods := make([]string, len(reqs))
+	for i, req := range reqs {
+		methods[i] = req.Method
+	}
+	return ac.Allow(client, methods)
+}
+
+// SetForwarded marks a request forwarded by the WebSocket server, whose
+// messages are checked when received.
+func (ac *AccessControl) SetForwarded(r *http.Request) {
+	r.Header.Set(ForwardedHeader, ac.forwardToken)
+}
+
+// isForwarded returns true if the request was forwarded by the WebSocket server.
+func (ac *AccessControl) isForwarded(r *http.Request) bool {
+	token := r.Header.Get(ForwardedHeader)
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(ac.forwardToken)) == 1
+}
+
+// Handler wraps the routes of the JSON-RPC HTTP server, rejecting the requests
+// of unauthorized clients and the ones exceeding their rate limit with a
+// JSON-RPC error for each request of the message. It's meant to be used as a
+// middleware of the router, for the API key route to be matched.
+func (ac *AccessControl) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ac.isForwarded(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client, err := ac.Authenticate(r)
+		if err != nil {
+			WriteError(w, nil, err)
+			return
+		}
+
+		// the GraphQL queries are charged as a single method, their aliases and
+		// block ranges being bounded per query by the GraphQL handler
+		if r.URL.Path == GraphQLPath {
+			if err := ac.Allow(client, []string{GraphQLMethod}); err != nil {
+				WriteError(w, nil, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// the body is read up to the max request size, and then restored in full
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = readCloser{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+		if err := ac.AllowMessage(client, body); err != nil {
+			WriteError(w, body, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// readCloser reads from a reader and closes the original body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// methodCosts holds the costs of the methods, by exact name and by prefix.
+type methodCosts struct {
+	exact    map[string]int
+	prefixes []methodPrefix
+}
+
+type methodPrefix struct {
+	prefix string
+	cost   int
+}
+
+func newMethodCosts(classes []config.CostClassConfig) methodCosts {
+	costs := methodCosts{exact: make(map[string]int)}
+	for _, class := range classes {
+		for _, method := range class.Methods {
+			if prefix, ok := strings.CutSuffix(method, "*"); ok {
+				costs.prefixes = append(costs.prefixes, methodPrefix{prefix: prefix, cost: class.Cost})
+			} else {
+				costs.exact[method] = class.Cost
+			}
+		}
+	}
+	return costs
+}
+
+// cost returns the cost of the method. The exact names take precedence over
+// the prefixes, which are matched in order, and the other methods cost one.
+func (c methodCosts) cost(method string) int {
+	if cost, ok := c.exact[method]; ok {
+		return cost
+	}
+	for _, p := range c.prefixes {
+		if strings.HasPrefix(method, p.prefix) {
+			return p.cost
+		}
+	}
+	return 1
+}
+
+// request holds the fields of a JSON-RPC request read by the middlewares.
+type request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// parseRequests parses the requests of a JSON-RPC message, which may be a batch.
+func parseRequests(msg []byte) ([]request, bool, error) {
+	msg = bytes.TrimLeft(msg, " \t\r\n")
+	if len(msg) > 0 && msg[0] == '[' {
+		var reqs []request
+		if err := json.Unmarshal(msg, &reqs); err != nil {
+			return nil, true, err
+		}
+		return reqs, true, nil
+	}
+	var req request
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return nil, false, err
+	}
+	return []request{req}, false, nil
+}
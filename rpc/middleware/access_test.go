@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestAccessControl(t *testing.T, requireKey bool) *AccessControl {
+	t.Helper()
+	cfg := config.DefaultAccessControlConfig()
+	cfg.Enable = true
+	cfg.RequireAPIKey = requireKey
+	cfg.IPRate = 0.001
+	cfg.IPBurst = 20
+	cfg.APIKeys = []config.APIKeyConfig{
+		{Key: "unlimited"},
+		{Key: "eth-only", Namespaces: []string{"eth"}},
+		{Key: "graphql-only", Namespaces: []string{"graphql"}},
+	}
+	ac, err := NewAccessControl(cfg)
+	require.NoError(t, err)
+	return ac
+}
+
+// serve sends a request to the routes of the server, the access control being
+// a middleware of the router, and returns the status and the body of the response.
+func serve(ac *AccessControl, path, key, body string, setup func(*http.Request)) (int, string) {
+	ok := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	handler := mux.NewRouter()
+	handler.HandleFunc("/", ok)
+	handler.HandleFunc(GraphQLPath, ok)
+	handler.HandleFunc(APIKeyRoute, ok)
+	handler.Use(ac.Handler)
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.RemoteAddr = "10.0.0.1:1234"
+	if key != "" {
+		req.Header.Set(config.DefaultAPIKeyHeader, key)
+	}
+	if setup != nil {
+		setup(req)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func TestAccessControl(t *testing.T) {
+	call := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+	debugCall := `{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}`
+	query := `{"query":"{ block { number } }"}`
+
+	testCases := []struct {
+		name       string
+		requireKey bool
+		path       string
+		key        string
+		body       string
+		expStatus  int
+		expCode    int
+	}{
+		{"no key", false, "/", "", call, http.StatusOK, 0},
+		{"required key", true, "/", "", call, http.StatusUnauthorized, UnauthorizedErrorCode},
+		{"invalid key", false, "/", "invalid", call, http.StatusUnauthorized, UnauthorizedErrorCode},
+		{"key in header", true, "/", "unlimited", call, http.StatusOK, 0},
+		{"key in path", true, "/unlimited", "", call, http.StatusOK, 0},
+		{"key in unrouted path", true, "/unlimited/x", "", call, http.StatusNotFound, 0},
+		{"allowed namespace", true, "/", "eth-only", call, http.StatusOK, 0},
+		{"namespace not allowed", true, "/", "eth-only", debugCall, http.StatusForbidden, MethodNotAllowedErrorCode},
+		{"graphql without key", false, GraphQLPath, "", query, http.StatusOK, 0},
+		{"graphql with required key", true, GraphQLPath, "", query, http.StatusUnauthorized, UnauthorizedErrorCode},
+		{"graphql key in header", true, GraphQLPath, "graphql-only", query, http.StatusOK, 0},
+		{"graphql namespace not allowed", true, GraphQLPath, "eth-only", query, http.StatusForbidden, MethodNotAllowedErrorCode},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ac := newTestAccessControl(t, tc.requireKey)
+			status, body := serve(ac, tc.path, tc.key, tc.body, nil)
+			require.Equal(t, tc.expStatus, status)
+			if tc.expCode == 0 {
+				return
+			}
+			var res errorResponse
+			require.NoError(t, json.Unmarshal([]byte(body), &res))
+			require.Equal(t, tc.expCode, res.Error.Code)
+		})
+	}
+}
+
+func TestAccessControlRateLimit(t *testing.T) {
+	ac := newTestAccessControl(t, false)
+	debugCall := `{"jsonrpc":"2.0","id":7,"method":"debug_traceTransaction"}`
+	batch := `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"a","method":"eth_chainId"}]`
+
+	// the IP bucket holds a single tracing call
+	status, _ := serve(ac, "/", "", debugCall, nil)
+	require.Equal(t, http.StatusOK, status)
+	status, body := serve(ac, "/", "", debugCall, nil)
+	require.Equal(t, http.StatusTooManyRequests, status)
+	var res errorResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Equal(t, LimitExceededErrorCode, res.Error.Code)
+	require.Equal(t, "7", string(res.ID))
+
+	// every request of a rejected batch gets an error
+	status, body = serve(ac, "/", "", batch, nil)
+	require.Equal(t, http.StatusTooManyRequests, status)
+	var batchRes []errorResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &batchRes))
+	require.Len(t, batchRes, 2)
+	require.Equal(t, `"a"`, string(batchRes[1].ID))
+
+	// other IPs and API keys have their own bucket
+	status, _ = serve(ac, "/", "", debugCall, func(r *http.Request) { r.RemoteAddr = "10.0.0.2:1234" })
+	require.Equal(t, http.StatusOK, status)
+	status, _ = serve(ac, "/", "unlimited", debugCall, nil)
+	require.Equal(t, http.StatusOK, status)
+
+	// the requests forwarded by the WebSocket server were already checked
+	status, _ = serve(ac, "/", "", debugCall, ac.SetForwarded)
+	require.Equal(t, http.StatusOK, status)
+	status, _ = serve(ac, "/", "", debugCall, func(r *http.Request) { r.Header.Set(ForwardedHeader, "forged") })
+	require.Equal(t, http.StatusTooManyRequests, status)
+}
+
+func TestAccessControlGraphQLRateLimit(t *testing.T) {
+	ac := newTestAccessControl(t, false)
+	query := `{"query":"{ block { number } }"}`
+
+	// the GraphQL queries are charged by their cost class, the IP bucket
+	// holding four queries
+	for range 4 {
+		status, _ := serve(ac, GraphQLPath, "", query, nil)
+		require.Equal(t, http.StatusOK, status)
+	}
+	status, body := serve(ac, GraphQLPath, "", query, nil)
+	require.Equal(t, http.StatusTooManyRequests, status)
+	var res errorResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Equal(t, LimitExceededErrorCode, res.Error.Code)
+}
+
+func TestAccessControlAuthenticate(t *testing.T) {
+	ac := newTestAccessControl(t, true)
+
+	// the URL path is only read as the API key of the API key route
+	_, err := ac.Authenticate(httptest.NewRequest(http.MethodPost, "/unlimited", nil))
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/unlimited", nil), map[string]string{apiKeyVar: "unlimited"})
+	client, err := ac.Authenticate(req)
+	require.NoError(t, err)
+	require.NotNil(t, client.key)
+}
+
+func TestAccessControlCostAboveBurst(t *testing.T) {
+	ac := newTestAccessControl(t, false)
+	batch := `[{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`
+
+	// the batch costs twice the burst of the IP bucket, and could never pass
+	status, body := serve(ac, "/", "", batch, nil)
+	require.Equal(t, http.StatusRequestEntityTooLarge, status)
+	var res []errorResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &res))
+	require.Len(t, res, 2)
+	require.Equal(t, LimitExceededErrorCode, res[0].Error.Code)
+	require.Equal(t, "request cost 40 exceeds the rate limit burst 20, split the batch into smaller ones", res[0].Error.Message)
+
+	// the bucket is left untouched
+	status, _ = serve(ac, "/", "", `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`, nil)
+	require.Equal(t, http.StatusOK, status)
+}
+
+func TestMethodCosts(t *testing.T) {
+	costs := newMethodCosts([]config.CostClassConfig{
+		{Name: "tracing", Cost: 20, Methods: []string{"debug_trace*"}},
+		{Name: "cheap", Cost: 2, Methods: []string{"debug_traceCall"}},
+	})
+	require.Equal(t, 20, costs.cost("debug_traceBlockByNumber"))
+	require.Equal(t, 2, costs.cost("debug_traceCall"))
+	require.Equal(t, 1, costs.cost("eth_blockNumber"))
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// JSON-RPC error codes of the rejected requests, as defined by EIP-1474.
+const (
+	// UnauthorizedErrorCode is returned for a missing or invalid API key.
+	UnauthorizedErrorCode = -32002
+	// MethodNotAllowedErrorCode is returned for a method whose namespace isn't
+	// allowed for the API key.
+	MethodNotAllowedErrorCode = -32004
+	// LimitExceededErrorCode is returned when the rate limit is exceeded.
+	LimitExceededErrorCode = -32005
+)
+
+var (
+	// ErrUnauthorized is returned for a missing or invalid API key.
+	ErrUnauthorized = &Error{code: UnauthorizedErrorCode, status: http.StatusUnauthorized, message: "unauthorized: missing or invalid API key"}
+	// ErrLimitExceeded is returned when the rate limit is exceeded.
+	ErrLimitExceeded = &Error{code: LimitExceededErrorCode, status: http.StatusTooManyRequests, message: "rate limit exceeded"}
+)
+
+// Error is a rejection of a request by the access control.
+type Error struct {
+	code    int
+	status  int
+	message string
+}
+
+// NewMethodNotAllowedError returns the error of a method whose namespace isn't
+// allowed for the API key.
+func NewMethodNotAllowedError(method string) *Error {
+	return &Error{
+		code:    MethodNotAllowedErrorCode,
+		status:  http.StatusForbidden,
+		message: fmt.Sprintf("method %s is not allowed for the API key", method),
+	}
+}
+
+// NewCostExceedsBurstError returns the error of a request whose cost exceeds
+// the burst of the rate limit, which can never be allowed.
+func NewCostExceedsBurstError(cost, burst int) *Error {
+	return &Error{
+		code:    LimitExceededErrorCode,
+		status:  http.StatusRequestEntityTooLarge,
+		message: fmt.Sprintf("request cost %d exceeds the rate limit burst %d, split the batch into smaller ones", cost, burst),
+	}
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *Error) ErrorCode() int {
+	return e.code
+}
+
+// errorResponse is a JSON-RPC error response.
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorObject     `json:"error"`
+}
+
+type errorObject struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse returns the JSON-RPC response rejecting every request of the
+// message with the given error. A message that can't be parsed is rejected with
+// a single error response.
+func ErrorResponse(msg []byte, err *Error) []byte {
+	newResponse := func(id json.RawMessage) errorResponse {
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return errorResponse{
+			JSONRPC: "2.0",
+			ID:      id,
+			Error:   errorObject{Code: err.code, Message: err.message},
+		}
+	}
+
+	var res interface{} = newResponse(nil)
+	if reqs, batch, parseErr := parseRequests(msg); parseErr == nil {
+		if batch {
+			responses := make([]errorResponse, len(reqs))
+			for i, req := range reqs {
+				responses[i] = newResponse(req.ID)
+			}
+			res = responses
+		} else {
+			res = newResponse(reqs[0].ID)
+		}
+	}
+	bz, _ := json.Marshal(res) //nolint:errchkjson // the response only holds marshallable fields
+	return bz
+}
+
+// WriteError writes the HTTP response rejecting the message with the given
+// error, which must be an *Error.
+func WriteError(w http.ResponseWriter, msg []byte, err error) {
+	var rejection *Error
+	if !errors.As(err, &rejection) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(rejection.status)
+	_, _ = w.Write(ErrorResponse(msg, rejection))
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// NewIPCOnlyError returns the error of a method only served to the clients
+// connected over IPC.
+func NewIPCOnlyError(method string) *Error {
+	return &Error{
+		code:    MethodNotAllowedErrorCode,
+		status:  http.StatusForbidden,
+		message: fmt.Sprintf("method %s is only served over IPC", method),
+	}
+}
@@ -0,0 +1,206 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/evm/metrics"
+
+	"cosmossdk.io/log/v2"
+)
+
+const (
+	// methodNotFoundErrorCode is returned by the server for the methods it
+	// doesn't serve.
+	methodNotFoundErrorCode = -32601
+	// parseErrorCode is recorded for the messages that can't be parsed.
+	parseErrorCode = -32700
+
+	// maxLoggedParamsSize is the max number of bytes of the params logged for
+	// the slow requests.
+	maxLoggedParamsSize = 512
+	// redactedParams replaces the params of the methods handling secrets.
+	redactedParams = "[redacted]"
+	// maxRecordedResponseSize is the max number of bytes of a response kept to
+	// find the error codes of its requests.
+	maxRecordedResponseSize = 5 * 1024 * 1024
+)
+
+// redactedNamespaces are the namespaces whose methods take secrets, such as
+// account passwords or private keys, as params.
+var redactedNamespaces = map[string]struct{}{
+	"personal": {},
+}
+
+// RequestMetrics records the metrics of the JSON-RPC requests served by the
+// HTTP server, by method and error code, and logs the slow requests. The
+// requests of a batch are timed as the whole batch.
+type RequestMetrics struct {
+	logger        log.Logger
+	enabled       bool
+	slowThreshold time.Duration
+	methods       map[string]struct{}
+}
+
+// NewRequestMetrics creates the request metrics of the methods registered by
+// the server. The metrics are recorded if enabled, and the requests slower
+// than the threshold are logged unless it is zero.
+func NewRequestMetrics(logger log.Logger, enabled bool, slowThreshold time.Duration, methods []string) *RequestMetrics {
+	registered := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		registered[method] = struct{}{}
+	}
+	return &RequestMetrics{
+		logger:        logger,
+		enabled:       enabled,
+		slowThreshold: slowThreshold,
+		methods:       registered,
+	}
+}
+
+// Handler wraps the handler of the JSON-RPC HTTP server, recording the metrics
+// of the requests of every message from the responses of the server.
+func (m *RequestMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the body is read up to the max request size, and then restored in full
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = readCloser{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		m.Record(body, rec.body.Bytes(), time.Since(start))
+	})
+}
+
+// Record records the metrics of the requests of a JSON-RPC message, which may
+// be a batch, given the response of the server and its serving time. The
+// requests of a response truncated by the recorder are recorded as successful.
+func (m *RequestMetrics) Record(msg, response []byte, elapsed time.Duration) {
+	reqs, batch, err := parseRequests(msg)
+	if err != nil {
+		if m.enabled {
+			metrics.RecordRPCRequest(metrics.UnknownRPCMethod, parseErrorCode, elapsed)
+		}
+		return
+	}
+	if batch && m.enabled {
+		metrics.RecordRPCBatch(len(reqs))
+	}
+
+	codes := responseCodes(response)
+	for _, req := range reqs {
+		m.RecordRequest(req.Method, req.Params, codes[string(req.ID)], elapsed, batch)
+	}
+}
+
+// RecordRequest records the metrics of a JSON-RPC request to the method, given
+// its error code, or 0 if it succeeded, and its serving time. The methods that
+// aren't registered or weren't served are recorded as unknown, so that clients
+// can't create metrics with arbitrary names.
+func (m *RequestMetrics) RecordRequest(method string, params json.RawMessage, code int, elapsed time.Duration, batch bool) {
+	if m.enabled {
+		label := method
+		if _, registered := m.methods[method]; !registered || !servedMethod(code) {
+			label = metrics.UnknownRPCMethod
+		}
+		metrics.RecordRPCRequest(label, code, elapsed)
+	}
+	if m.slowThreshold > 0 && elapsed >= m.slowThreshold {
+		m.logger.Info(
+			"slow JSON-RPC request",
+			"method", method,
+			"params", RedactParams(method, params),
+			"duration", elapsed,
+			"batch", batch,
+			"error_code", code,
+		)
+	}
+}
+
+// Active returns true if the requests are recorded or the slow ones logged.
+func (m *RequestMetrics) Active() bool {
+	return m.enabled || m.slowThreshold > 0
+}
+
+// servedMethod returns false if the method of a request with the error code
+// wasn't served, either not found by the server or rejected by the access
+// control.
+func servedMethod(code int) bool {
+	switch code {
+	case methodNotFoundErrorCode, UnauthorizedErrorCode, MethodNotAllowedErrorCode, LimitExceededErrorCode:
+		return false
+	default:
+		return true
+	}
+}
+
+// RedactParams returns the params of a request to be logged, replacing the
+// params of the methods handling secrets and truncating the long ones.
+func RedactParams(method string, params json.RawMessage) string {
+	ns, _, _ := strings.Cut(method, "_")
+	if _, ok := redactedNamespaces[ns]; ok && len(params) > 0 {
+		return redactedParams
+	}
+	if len(params) > maxLoggedParamsSize {
+		return string(params[:maxLoggedParamsSize]) + "..."
+	}
+	return string(params)
+}
+
+// response holds the fields of a JSON-RPC response read by the request metrics.
+type response struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// responseCodes returns the error codes of the responses of a JSON-RPC
+// message, which may be a batch, by request ID. The successful responses have
+// a zero code.
+func responseCodes(msg []byte) map[string]int {
+	msg = bytes.TrimLeft(msg, " \t\r\n")
+	var resps []response
+	if len(msg) > 0 && msg[0] == '[' {
+		if err := json.Unmarshal(msg, &resps); err != nil {
+			return nil
+		}
+	} else {
+		var resp response
+		if err := json.Unmarshal(msg, &resp); err != nil {
+			return nil
+		}
+		resps = []response{resp}
+	}
+
+	codes := make(map[string]int, len(resps))
+	for _, resp := range resps {
+		if resp.Error != nil {
+			codes[string(resp.ID)] = resp.Error.Code
+		}
+	}
+	return codes
+}
+
+// responseRecorder writes the response and keeps a copy of its body, up to
+// the max recorded response size.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if remaining := maxRecordedResponseSize - r.body.Len(); remaining > 0 {
+		r.body.Write(b[:min(len(b), remaining)])
+	}
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+)
+
+func counter(t *testing.T, name string) int64 {
+	t.Helper()
+	c, ok := gethmetrics.DefaultRegistry.Get(name).(*gethmetrics.Counter)
+	if !ok {
+		return 0
+	}
+	return c.Snapshot().Count()
+}
+
+func TestRequestMetrics(t *testing.T) {
+	var logs bytes.Buffer
+	m := NewRequestMetrics(log.NewLogger(&logs), true, time.Nanosecond, []string{"eth_blockNumber", "eth_call", "eth_bar"})
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"jsonrpc":"2.0","id":1,"result":"0x1"},
+			{"jsonrpc":"2.0","id":2,"error":{"code":-32000,"message":"execution reverted"}},
+			{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"the method eth_foo does not exist"}},
+			{"jsonrpc":"2.0","id":4,"error":{"code":-32005,"message":"rate limit exceeded"}},
+			{"jsonrpc":"2.0","id":5,"result":"0x1"}
+		]`))
+	}))
+
+	requests := counter(t, "evm/rpc/requests/eth_blockNumber")
+	errs := counter(t, "evm/rpc/errors/eth_call/32000")
+	unknown := counter(t, "evm/rpc/requests/unknown")
+
+	body := `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},
+		{"jsonrpc":"2.0","id":2,"method":"eth_call","params":[{"to":"0x01"},"latest"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_foo"},
+		{"jsonrpc":"2.0","id":4,"method":"eth_bar"},
+		{"jsonrpc":"2.0","id":5,"method":"eth_baz"}
+	]`
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, requests+1, counter(t, "evm/rpc/requests/eth_blockNumber"))
+	require.Equal(t, errs+1, counter(t, "evm/rpc/errors/eth_call/32000"))
+	// the methods that weren't served or aren't registered are recorded as unknown
+	require.Equal(t, unknown+3, counter(t, "evm/rpc/requests/unknown"))
+	require.Nil(t, gethmetrics.DefaultRegistry.Get("evm/rpc/requests/eth_foo"))
+	require.Nil(t, gethmetrics.DefaultRegistry.Get("evm/rpc/requests/eth_bar"))
+	require.Nil(t, gethmetrics.DefaultRegistry.Get("evm/rpc/requests/eth_baz"))
+
+	require.Contains(t, logs.String(), "slow JSON-RPC request")
+	require.Contains(t, logs.String(), "eth_call")
+}
+
+func TestRedactParams(t *testing.T) {
+	long := json.RawMessage(`["0x` + strings.Repeat("ab", maxLoggedParamsSize) + `"]`)
+
+	testCases := []struct {
+		name   string
+		method string
+		params json.RawMessage
+		exp    string
+	}{
+		{"plain", "eth_getBalance", json.RawMessage(`["0x01","latest"]`), `["0x01","latest"]`},
+		{"no params", "personal_listAccounts", nil, ""},
+		{"secret", "personal_unlockAccount", json.RawMessage(`["0x01","password"]`), redactedParams},
+		{"truncated", "eth_sendRawTransaction", long, string(long[:maxLoggedParamsSize]) + "..."},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, RedactParams(tc.method, tc.params))
+		})
+	}
+}
+
+func TestResponseRecorderLimit(t *testing.T) {
+	w := httptest.NewRecorder()
+	rec := &responseRecorder{ResponseWriter: w}
+	body := bytes.Repeat([]byte("a"), maxRecordedResponseSize/2+1)
+
+	for range 2 {
+		n, err := rec.Write(body)
+		require.NoError(t, err)
+		require.Equal(t, len(body), n)
+	}
+	// the response is written in full, while its copy is capped
+	require.Equal(t, 2*len(body), w.Body.Len())
+	require.Equal(t, maxRecordedResponseSize, rec.body.Len())
+}
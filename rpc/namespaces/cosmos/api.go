@@ -0,0 +1,124 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/cosmos")
+
+// API is the collection of the Cosmos APIs, which let the Ethereum clients
+// query the Cosmos transactions, accounts and balances and broadcast Cosmos
+// transactions through the JSON-RPC server. The Cosmos transactions are
+// returned in the JSON format of the Cosmos SDK REST API.
+type API struct {
+	ctx       *server.Context
+	logger    log.Logger
+	clientCtx client.Context
+	backend   backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the cosmos namespace.
+func NewAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:       ctx,
+		logger:    ctx.Logger.With("module", "cosmos"),
+		clientCtx: clientCtx,
+		backend:   backend,
+	}
+}
+
+// GetCosmosTxByEthHash returns the Cosmos transaction including the Ethereum
+// transaction of the given hash, with its result and events.
+func (a *API) GetCosmosTxByEthHash(ctx context.Context, hash common.Hash) (_ json.RawMessage, err error) {
+	a.logger.Debug("cosmos_getCosmosTxByEthHash", "hash", hash)
+	ctx, span := tracer.Start(ctx, "cosmos_getCosmosTxByEthHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := a.backend.GetCosmosTxByEthHash(ctx, hash)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return a.clientCtx.Codec.MarshalJSON(res)
+}
+
+// GetEthTxsByCosmosHash returns the Ethereum transactions included in the
+// Cosmos transaction of the given hash.
+func (a *API) GetEthTxsByCosmosHash(ctx context.Context, hash string) (_ []*rpctypes.RPCTransaction, err error) {
+	a.logger.Debug("cosmos_getEthTxsByCosmosHash", "hash", hash)
+	ctx, span := tracer.Start(ctx, "cosmos_getEthTxsByCosmosHash", trace.WithAttributes(attribute.String("hash", hash)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return a.backend.GetEthTxsByCosmosHash(ctx, hash)
+}
+
+// GetAccountInfo returns the Cosmos account of the bech32 or hex address at the
+// given block, with its account number, sequence and vesting schedule.
+func (a *API) GetAccountInfo(ctx context.Context, address string, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *rpctypes.CosmosAccount, err error) {
+	a.logger.Debug("cosmos_getAccountInfo", "address", address, "block number or hash", blockNrOrHash)
+	ctx, span := tracer.Start(ctx, "cosmos_getAccountInfo", trace.WithAttributes(attribute.String("address", address)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	accAddr, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.GetCosmosAccount(ctx, accAddr, blockNrOrHash)
+}
+
+// GetDenomBalances returns the bank balances of the bech32 or hex address in
+// every denomination at the given block.
+func (a *API) GetDenomBalances(ctx context.Context, address string, blockNrOrHash rpctypes.BlockNumberOrHash) (_ sdk.Coins, err error) {
+	a.logger.Debug("cosmos_getDenomBalances", "address", address, "block number or hash", blockNrOrHash)
+	ctx, span := tracer.Start(ctx, "cosmos_getDenomBalances", trace.WithAttributes(attribute.String("address", address)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	accAddr, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.GetDenomBalances(ctx, accAddr, blockNrOrHash)
+}
+
+// BroadcastCosmosTx broadcasts a signed protobuf-encoded Cosmos transaction and
+// returns its hash.
+func (a *API) BroadcastCosmosTx(ctx context.Context, txBytes hexutil.Bytes) (_ string, err error) {
+	a.logger.Debug("cosmos_broadcastCosmosTx", "length", len(txBytes))
+	ctx, span := tracer.Start(ctx, "cosmos_broadcastCosmosTx")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return a.backend.BroadcastCosmosTx(ctx, txBytes)
+}
+
+// parseAddress parses a hex or bech32 account address.
+func parseAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	return accAddr, nil
+}
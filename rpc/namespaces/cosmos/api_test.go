@@ -0,0 +1,26 @@
+package cosmos
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseAddress(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	accAddr := sdk.AccAddress(addr.Bytes())
+
+	parsed, err := parseAddress(addr.Hex())
+	require.NoError(t, err)
+	require.Equal(t, accAddr, parsed)
+
+	parsed, err = parseAddress(accAddr.String())
+	require.NoError(t, err)
+	require.Equal(t, accAddr, parsed)
+
+	_, err = parseAddress("invalid")
+	require.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/middleware"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/admin")
+
+// protocolName is the name of the protocol reported for the peers and the node.
+const protocolName = "cometbft"
+
+var (
+	// ErrDialPeersUnsupported is returned when the node isn't running in-process,
+	// as the unsafe dial_peers endpoint is only exposed by the local client.
+	ErrDialPeersUnsupported = errors.New("adding peers requires the in-process CometBFT node")
+	// ErrRemovePeerUnsupported is returned by RemovePeer, as CometBFT doesn't
+	// expose an endpoint to disconnect a peer.
+	ErrRemovePeerUnsupported = errors.New("removing peers is not supported by CometBFT")
+)
+
+// peerDialer is implemented by the CometBFT clients exposing the unsafe
+// dial_peers endpoint.
+type peerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// API is the private admin prefixed set of APIs in the Admin JSON-RPC spec,
+// translated to the CometBFT peer and node info. Its methods are only served
+// over IPC.
+type API struct {
+	logger   log.Logger
+	tmClient rpcclient.Client
+	dataDir  string
+}
+
+// NewAPI creates an instance of the Admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context) *API {
+	return &API{
+		logger:   ctx.Logger.With("api", "admin"),
+		tmClient: clientCtx.Client.(rpcclient.Client),
+		dataDir:  ctx.Config.RootDir,
+	}
+}
+
+// NodeInfo returns the information about the CometBFT node.
+func (a *API) NodeInfo(ctx context.Context) (*NodeInfo, error) {
+	a.logger.Debug("admin_nodeInfo")
+	if err := checkIPC(ctx, "admin_nodeInfo"); err != nil {
+		return nil, err
+	}
+	ctx, span := tracer.Start(ctx, "admin_nodeInfo")
+	defer span.End()
+
+	status, err := a.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := status.NodeInfo
+	host, port := splitListenAddr(info.ListenAddr)
+	nodeInfo := &NodeInfo{
+		ID:         string(info.ID()),
+		Name:       info.Moniker,
+		Enode:      peerURL(string(info.ID()), host, port),
+		IP:         host,
+		ListenAddr: info.ListenAddr,
+		Protocols: map[string]interface{}{
+			protocolName: map[string]interface{}{
+				"network":           info.Network,
+				"version":           info.Version,
+				"protocolVersion":   info.ProtocolVersion,
+				"latestBlockHeight": status.SyncInfo.LatestBlockHeight,
+				"latestBlockHash":   status.SyncInfo.LatestBlockHash.String(),
+				"catchingUp":        status.SyncInfo.CatchingUp,
+			},
+		},
+	}
+	nodeInfo.Ports.Listener = port
+	return nodeInfo, nil
+}
+
+// Peers returns the information about the connected CometBFT peers.
+func (a *API) Peers(ctx context.Context) ([]*PeerInfo, error) {
+	a.logger.Debug("admin_peers")
+	if err := checkIPC(ctx, "admin_peers"); err != nil {
+		return nil, err
+	}
+	ctx, span := tracer.Start(ctx, "admin_peers")
+	defer span.End()
+
+	netInfo, err := a.tmClient.NetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, newPeerInfo(peer))
+	}
+	return peers, nil
+}
+
+// AddPeer dials the given peer, formatted as "id@host:port", and keeps it as a
+// persistent peer of the node.
+func (a *API) AddPeer(ctx context.Context, url string) (bool, error) {
+	a.logger.Debug("admin_addPeer", "peer", url)
+	if err := checkIPC(ctx, "admin_addPeer"); err != nil {
+		return false, err
+	}
+	ctx, span := tracer.Start(ctx, "admin_addPeer")
+	defer span.End()
+
+	dialer, ok := a.tmClient.(peerDialer)
+	if !ok {
+		return false, ErrDialPeersUnsupported
+	}
+	if _, err := dialer.DialPeers(ctx, []string{strings.TrimPrefix(url, "tcp://")}, true, false, false); err != nil {
+		return false, fmt.Errorf("invalid peer: %w", err)
+	}
+	return true, nil
+}
+
+// RemovePeer isn't supported, as CometBFT doesn't expose an endpoint to
+// disconnect a peer.
+func (a *API) RemovePeer(ctx context.Context, url string) (bool, error) {
+	a.logger.Debug("admin_removePeer", "peer", url)
+	if err := checkIPC(ctx, "admin_removePeer"); err != nil {
+		return false, err
+	}
+	return false, ErrRemovePeerUnsupported
+}
+
+// Datadir returns the home directory of the node.
+func (a *API) Datadir(ctx context.Context) (string, error) {
+	a.logger.Debug("admin_datadir")
+	if err := checkIPC(ctx, "admin_datadir"); err != nil {
+		return "", err
+	}
+	return a.dataDir, nil
+}
+
+// checkIPC rejects the calls of the clients not connected over IPC, in case the
+// namespace is served by another server.
+func checkIPC(ctx context.Context, method string) error {
+	if rpc.PeerInfoFromContext(ctx).Transport == "ipc" {
+		return nil
+	}
+	return middleware.NewIPCOnlyError(method)
+}
+
+// newPeerInfo converts a CometBFT peer to its admin representation.
+func newPeerInfo(peer coretypes.Peer) *PeerInfo {
+	info := peer.NodeInfo
+	_, port := splitListenAddr(info.ListenAddr)
+	peerInfo := &PeerInfo{
+		ID:    string(info.ID()),
+		Name:  info.Moniker,
+		Enode: peerURL(string(info.ID()), peer.RemoteIP, port),
+		Caps:  []string{fmt.Sprintf("%s/%d", protocolName, info.ProtocolVersion.P2P)},
+		Protocols: map[string]interface{}{
+			protocolName: map[string]interface{}{
+				"network":         info.Network,
+				"version":         info.Version,
+				"protocolVersion": info.ProtocolVersion,
+				"duration":        peer.ConnectionStatus.Duration.String(),
+			},
+		},
+	}
+	peerInfo.Network.RemoteAddress = net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))
+	peerInfo.Network.Inbound = !peer.IsOutbound
+	return peerInfo
+}
+
+// splitListenAddr returns the host and the port of a CometBFT listen address,
+// such as "tcp://0.0.0.0:26656".
+func splitListenAddr(addr string) (string, int) {
+	if _, rest, ok := strings.Cut(addr, "://"); ok {
+		addr = rest
+	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr, 0
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, 0
+	}
+	return host, port
+}
+
+// peerURL returns the address of a peer, as dialed by CometBFT.
+func peerURL(id, host string, port int) string {
+	return fmt.Sprintf("%s@%s", id, net.JoinHostPort(host, strconv.Itoa(port)))
+}
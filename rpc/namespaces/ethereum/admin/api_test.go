@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/middleware"
+
+	"cosmossdk.io/log/v2"
+)
+
+func TestSplitListenAddr(t *testing.T) {
+	host, port := splitListenAddr("tcp://0.0.0.0:26656")
+	require.Equal(t, "0.0.0.0", host)
+	require.Equal(t, 26656, port)
+
+	host, port = splitListenAddr("10.0.0.1:26656")
+	require.Equal(t, "10.0.0.1", host)
+	require.Equal(t, 26656, port)
+
+	host, port = splitListenAddr("invalid")
+	require.Equal(t, "invalid", host)
+	require.Equal(t, 0, port)
+}
+
+func TestNewPeerInfo(t *testing.T) {
+	peer := coretypes.Peer{
+		NodeInfo: p2p.DefaultNodeInfo{
+			ProtocolVersion: p2p.NewProtocolVersion(8, 11, 0),
+			DefaultNodeID:   "f3b7c1d2e4a5968778695a4b3c2d1e0f9a8b7c6d",
+			ListenAddr:      "tcp://0.0.0.0:26656",
+			Network:         "cosmos_262144-1",
+			Version:         "0.39.0",
+			Moniker:         "validator",
+		},
+		IsOutbound:       true,
+		ConnectionStatus: conn.ConnectionStatus{Duration: time.Minute},
+		RemoteIP:         "10.0.0.2",
+	}
+
+	info := newPeerInfo(peer)
+	require.Equal(t, "f3b7c1d2e4a5968778695a4b3c2d1e0f9a8b7c6d", info.ID)
+	require.Equal(t, "validator", info.Name)
+	require.Equal(t, "f3b7c1d2e4a5968778695a4b3c2d1e0f9a8b7c6d@10.0.0.2:26656", info.Enode)
+	require.Equal(t, []string{"cometbft/8"}, info.Caps)
+	require.Equal(t, "10.0.0.2:26656", info.Network.RemoteAddress)
+	require.False(t, info.Network.Inbound)
+}
+
+func TestCheckIPC(t *testing.T) {
+	// the calls without the peer info of the IPC transport are rejected
+	err := checkIPC(context.Background(), "admin_peers")
+	var rejection *middleware.Error
+	require.ErrorAs(t, err, &rejection)
+	require.Equal(t, middleware.MethodNotAllowedErrorCode, rejection.ErrorCode())
+
+	api := &API{logger: log.NewNopLogger()}
+	_, err = api.Datadir(context.Background())
+	require.ErrorAs(t, err, &rejection)
+}
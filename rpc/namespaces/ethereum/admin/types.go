@@ -0,0 +1,36 @@
+package admin
+
+// NodeInfo represents a short summary of the node, following the format of the
+// go-ethereum admin_nodeInfo response. The enode is the CometBFT address of the
+// node, formatted as "id@host:port".
+type NodeInfo struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Enode string `json:"enode"`
+	ENR   string `json:"enr"`
+	IP    string `json:"ip"`
+	Ports struct {
+		Discovery int `json:"discovery"`
+		Listener  int `json:"listener"`
+	} `json:"ports"`
+	ListenAddr string                 `json:"listenAddr"`
+	Protocols  map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfo represents a short summary of a connected peer, following the format
+// of the go-ethereum admin_peers response.
+type PeerInfo struct {
+	ENR     string   `json:"enr,omitempty"`
+	Enode   string   `json:"enode"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Caps    []string `json:"caps"`
+	Network struct {
+		LocalAddress  string `json:"localAddress"`
+		RemoteAddress string `json:"remoteAddress"`
+		Inbound       bool   `json:"inbound"`
+		Trusted       bool   `json:"trusted"`
+		Static        bool   `json:"static"`
+	} `json:"network"`
+	Protocols map[string]interface{} `json:"protocols"`
+}
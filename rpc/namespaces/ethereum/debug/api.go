@@ -71,18 +71,18 @@ func NewAPI(
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *API) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
+func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
-	ctx, span := tracer.Start(context.Background(), "eth_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.String())))
+	ctx, span := tracer.Start(ctx, "eth_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.String())))
 	defer span.End()
 	return a.backend.TraceTransaction(ctx, hash, config)
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *rpctypes.TraceConfig) (res []*evmtypes.TxTraceResult, err error) {
+func (a *API) TraceBlockByNumber(ctx context.Context, height rpctypes.BlockNumber, config *rpctypes.TraceConfig) (res []*evmtypes.TxTraceResult, err error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
-	ctx, span := tracer.Start(context.Background(), "eth_traceBlockByNumber", trace.WithAttributes(attribute.Int64("height", int64(height))))
+	ctx, span := tracer.Start(ctx, "eth_traceBlockByNumber", trace.WithAttributes(attribute.Int64("height", int64(height))))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -99,9 +99,9 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *rpctypes.T
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
-	ctx, span := tracer.Start(context.Background(), "eth_traceBlockByHash")
+	ctx, span := tracer.Start(ctx, "eth_traceBlockByHash")
 	defer span.End()
 	// Get CometBFT Block
 	resBlock, err := a.backend.CometBlockByHash(ctx, hash)
@@ -188,6 +188,46 @@ func (a *API) GetRawBlock(ctx context.Context, blockNrOrHash rpctypes.BlockNumbe
 	return rlp.EncodeToBytes(block)
 }
 
+// StorageRangeAt returns the storage of the given contract before the execution
+// of the transaction at txIndex in the given block. Only the states at the start
+// (txIndex 0) and at the end of the block are available. The storage is keyed
+// and paged by storage slot instead of the hash of the slot.
+func (a *API) StorageRangeAt(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (_ rpctypes.StorageRangeResult, err error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "txIndex", txIndex, "address", contractAddress)
+	ctx, span := tracer.Start(ctx, "debug_storageRangeAt", trace.WithAttributes(attribute.String("address", contractAddress.Hex()), attribute.Int("txIndex", txIndex)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.StorageRangeAt(ctx, blockNrOrHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the accounts at the end of the given block, starting
+// at the given address, and returns at most maxResults of them.
+func (a *API) AccountRange(ctx context.Context, blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool) (_ rpctypes.Dump, err error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "maxResults", maxResults)
+	ctx, span := tracer.Start(ctx, "debug_accountRange", trace.WithAttributes(attribute.Int("maxResults", maxResults)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.AccountRange(ctx, blockNrOrHash, start, maxResults, nocode, nostorage, incompletes)
+}
+
+// DumpBlock retrieves the accounts at the end of the given block, including
+// their code and storage. As the state may be very large, an error is returned
+// if it has more than AccountRangeMaxResults accounts, debug_accountRange being
+// able to page through them.
+func (a *API) DumpBlock(ctx context.Context, blockNr rpctypes.BlockNumber) (_ rpctypes.Dump, err error) {
+	a.logger.Debug("debug_dumpBlock", "number", blockNr)
+	ctx, span := tracer.Start(ctx, "debug_dumpBlock", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	dump, err := a.backend.AccountRange(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, nil, backend.AccountRangeMaxResults, false, false, false)
+	if err != nil {
+		return rpctypes.Dump{}, err
+	}
+	if dump.Next != nil {
+		return rpctypes.Dump{}, fmt.Errorf(
+			"the state has more than %d accounts, use debug_accountRange to page through them", backend.AccountRangeMaxResults,
+		)
+	}
+	return dump, nil
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -424,8 +464,8 @@ func (a *API) SetGCPercent(v int) (int, error) {
 }
 
 // GetHeaderRlp retrieves the RLP encoded for of a single header.
-func (a *API) GetHeaderRlp(number uint64) (_ hexutil.Bytes, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getHeaderRlp")
+func (a *API) GetHeaderRlp(ctx context.Context, number uint64) (_ hexutil.Bytes, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getHeaderRlp")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	if !a.profilingEnabled {
 		return nil, rpctypes.ErrProfilingDisabled
@@ -439,8 +479,8 @@ func (a *API) GetHeaderRlp(number uint64) (_ hexutil.Bytes, err error) {
 }
 
 // GetBlockRlp retrieves the RLP encoded for of a single block.
-func (a *API) GetBlockRlp(number uint64) (_ hexutil.Bytes, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBlockRlp")
+func (a *API) GetBlockRlp(ctx context.Context, number uint64) (_ hexutil.Bytes, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getBlockRlp")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	if !a.profilingEnabled {
 		return nil, rpctypes.ErrProfilingDisabled
@@ -454,8 +494,8 @@ func (a *API) GetBlockRlp(number uint64) (_ hexutil.Bytes, err error) {
 }
 
 // PrintBlock retrieves a block and returns its pretty printed form.
-func (a *API) PrintBlock(number uint64) (_ string, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_printBlock")
+func (a *API) PrintBlock(ctx context.Context, number uint64) (_ string, err error) {
+	ctx, span := tracer.Start(ctx, "eth_printBlock")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	if !a.profilingEnabled {
 		return "", rpctypes.ErrProfilingDisabled
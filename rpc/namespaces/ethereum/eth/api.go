@@ -3,6 +3,8 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"slices"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -13,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -32,29 +35,30 @@ type EthereumAPI interface {
 	// Getting Blocks
 	//
 	// Retrieves information from a particular block in the blockchain.
-	BlockNumber() (hexutil.Uint64, error)
-	GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
-	GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error)
-	GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint
-	GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint
+	BlockNumber(ctx context.Context) (hexutil.Uint64, error)
+	GetBlockByNumber(ctx context.Context, ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
+	GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error)
+	GetBlockTransactionCountByHash(ctx context.Context, hash common.Hash) *hexutil.Uint
+	GetBlockTransactionCountByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) *hexutil.Uint
 
 	// Reading Transactions
 	//
 	// Retrieves information on the state data for addresses regardless of whether
 	// it is a user or a smart contract.
-	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
-	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
-	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
-	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionByHash(ctx context.Context, hash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+	GetTransactionByBlockHashAndIndex(ctx context.Context, hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	// eth_getBlockReceipts
 
 	// Writing Transactions
 	//
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
-	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
-	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (map[string]interface{}, error)
+	SendTransaction(ctx context.Context, args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
 
@@ -62,26 +66,29 @@ type EthereumAPI interface {
 	//
 	// Returns information regarding an address's stored on-chain data.
 	Accounts() ([]common.Address, error)
-	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
-	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
-	GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
-	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
+	GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
+	GetStorageAt(ctx context.Context, address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
+	GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 
 	// EVM/Smart Contract Execution
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	Call(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(ctx context.Context, opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	CallMany(ctx context.Context, bundles []rpctypes.Bundle, stateCtx rpctypes.StateContext, overrides *json.RawMessage, timeoutMs *int64) ([][]map[string]interface{}, error)
+	CallBundle(ctx context.Context, args rpctypes.CallBundleArgs) (map[string]interface{}, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
-	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
-	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
-	MaxPriorityFeePerGas() (*hexutil.Big, error)
-	ChainId() (*hexutil.Big, error)
+	GasPrice(ctx context.Context) (*hexutil.Big, error)
+	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
+	FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
+	MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error)
+	ChainId(ctx context.Context) (*hexutil.Big, error)
 
 	// Getting Uncles
 	//
@@ -92,13 +99,13 @@ type EthereumAPI interface {
 	GetUncleCountByBlockNumber(blockNum rpctypes.BlockNumber) hexutil.Uint
 
 	// Other
-	Syncing() (interface{}, error)
+	Syncing(ctx context.Context) (interface{}, error)
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
-	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
+	GetTransactionLogs(ctx context.Context, txHash common.Hash) ([]*ethtypes.Log, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
-	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
+	FillTransaction(ctx context.Context, args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
-	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (*rpctypes.AccessListResult, error)
+	CreateAccessList(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (*rpctypes.AccessListResult, error)
 
 	// eth_signTransaction (on Ethereum.org)
 	// eth_getCompilers (on Ethereum.org)
@@ -116,13 +123,15 @@ var _ EthereumAPI = (*PublicAPI)(nil)
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
+	events  *stream.RPCStream
 }
 
 // NewPublicAPI creates an instance of the public ETH Web3 API.
-func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, stream *stream.RPCStream) *PublicAPI {
 	api := &PublicAPI{
 		logger:  logger.With("client", "json-rpc"),
 		backend: backend,
+		events:  stream,
 	}
 
 	return api
@@ -137,16 +146,16 @@ func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 //   - When blockNr is -2 the chain latest header is returned.
 //   - When blockNr is -3 the chain finalized header is returned.
 //   - When blockNr is -4 the chain safe header is returned.
-func (e *PublicAPI) GetHeaderByNumber(ethBlockNum rpctypes.BlockNumber) (_ map[string]interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getHeaderByNumber")
+func (e *PublicAPI) GetHeaderByNumber(ctx context.Context, ethBlockNum rpctypes.BlockNumber) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getHeaderByNumber")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getHeaderByNumber", "number", ethBlockNum)
 	return e.backend.GetHeaderByNumber(ctx, ethBlockNum)
 }
 
 // GetHeaderByHash returns the requested header by hash.
-func (e *PublicAPI) GetHeaderByHash(hash common.Hash) (_ map[string]interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getHeaderByHash")
+func (e *PublicAPI) GetHeaderByHash(ctx context.Context, hash common.Hash) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getHeaderByHash")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getHeaderByHash", "hash", hash.Hex())
 	return e.backend.GetHeaderByHash(ctx, hash)
@@ -157,24 +166,24 @@ func (e *PublicAPI) GetHeaderByHash(hash common.Hash) (_ map[string]interface{},
 ////////////////////////////////////////////////////////////////////////////////////////
 
 // BlockNumber returns the current block number.
-func (e *PublicAPI) BlockNumber() (_ hexutil.Uint64, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_blockNumber")
+func (e *PublicAPI) BlockNumber(ctx context.Context) (_ hexutil.Uint64, err error) {
+	ctx, span := tracer.Start(ctx, "eth_blockNumber")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_blockNumber")
 	return e.backend.BlockNumber(ctx)
 }
 
 // GetBlockByNumber returns the block identified by number.
-func (e *PublicAPI) GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (_ map[string]interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBlockByNumber")
+func (e *PublicAPI) GetBlockByNumber(ctx context.Context, ethBlockNum rpctypes.BlockNumber, fullTx bool) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getBlockByNumber")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getBlockByNumber", "number", ethBlockNum, "full", fullTx)
 	return e.backend.GetBlockByNumber(ctx, ethBlockNum, fullTx)
 }
 
 // GetBlockByHash returns the block identified by hash.
-func (e *PublicAPI) GetBlockByHash(hash common.Hash, fullTx bool) (_ map[string]interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBlockByHash")
+func (e *PublicAPI) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getBlockByHash")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getBlockByHash", "hash", hash.Hex(), "full", fullTx)
 	return e.backend.GetBlockByHash(ctx, hash, fullTx)
@@ -193,16 +202,16 @@ func (e *PublicAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpctypes
 ///////////////////////////////////////////////////////////////////////////////
 
 // GetTransactionByHash returns the transaction identified by hash.
-func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (_ *rpctypes.RPCTransaction, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByHash")
+func (e *PublicAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (_ *rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionByHash")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByHash", "hash", hash.Hex())
 	return e.backend.GetTransactionByHash(ctx, hash)
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
-func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *hexutil.Uint64, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionCount")
+func (e *PublicAPI) GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *hexutil.Uint64, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionCount")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionCount", "address", address.Hex(), "block number or hash", blockNrOrHash)
 	blockNum, err := e.backend.BlockNumberFromComet(ctx, blockNrOrHash)
@@ -213,8 +222,8 @@ func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rp
 }
 
 // GetTransactionReceipt returns the transaction receipt identified by hash.
-func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (_ map[string]interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionReceipt")
+func (e *PublicAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionReceipt")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	hexTx := hash.Hex()
 	e.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
@@ -222,32 +231,32 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (_ map[string]interf
 }
 
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
-func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) (_ *hexutil.Uint) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBlockTransactionCountByHash")
+func (e *PublicAPI) GetBlockTransactionCountByHash(ctx context.Context, hash common.Hash) (_ *hexutil.Uint) {
+	ctx, span := tracer.Start(ctx, "eth_getBlockTransactionCountByHash")
 	defer span.End()
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
 	return e.backend.GetBlockTransactionCountByHash(ctx, hash)
 }
 
 // GetBlockTransactionCountByNumber returns the number of transactions in the block identified by number.
-func (e *PublicAPI) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) (_ *hexutil.Uint) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBlockTransactionCountByNumber")
+func (e *PublicAPI) GetBlockTransactionCountByNumber(ctx context.Context, blockNum rpctypes.BlockNumber) (_ *hexutil.Uint) {
+	ctx, span := tracer.Start(ctx, "eth_getBlockTransactionCountByNumber")
 	defer span.End()
 	e.logger.Debug("eth_getBlockTransactionCountByNumber", "height", blockNum.Int64())
 	return e.backend.GetBlockTransactionCountByNumber(ctx, blockNum)
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
-func (e *PublicAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByBlockHashAndIndex")
+func (e *PublicAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, hash common.Hash, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionByBlockHashAndIndex")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
 	return e.backend.GetTransactionByBlockHashAndIndex(ctx, hash, idx)
 }
 
 // GetTransactionByBlockNumberAndIndex returns the transaction identified by number and index.
-func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionByBlockNumberAndIndex")
+func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNum rpctypes.BlockNumber, idx hexutil.Uint) (_ *rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionByBlockNumberAndIndex")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionByBlockNumberAndIndex", "number", blockNum, "index", idx)
 	return e.backend.GetTransactionByBlockNumberAndIndex(ctx, blockNum, idx)
@@ -258,16 +267,78 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 ///////////////////////////////////////////////////////////////////////////////
 
 // SendRawTransaction send a raw Ethereum transaction.
-func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_sendRawTransaction")
+func (e *PublicAPI) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (_ common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "eth_sendRawTransaction")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_sendRawTransaction", "length", len(data))
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendRawTransactionSync sends a raw Ethereum transaction and waits for its
+// inclusion in a block, as specified by EIP-7966. It returns the receipt of
+// the transaction, or a TxSyncTimeoutError if it isn't included within
+// timeoutMs milliseconds. The node defaults and caps the timeout.
+func (e *PublicAPI) SendRawTransactionSync(ctx context.Context, data hexutil.Bytes, timeoutMs *hexutil.Uint64) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_sendRawTransactionSync")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data))
+
+	timeout := e.backend.RPCTxSyncTimeout()
+	if timeoutMs != nil && *timeoutMs > 0 {
+		timeout = time.Duration(*timeoutMs) * time.Millisecond //#nosec G115 -- the timeout is capped below
+	}
+	if maxTimeout := e.backend.RPCTxSyncMaxTimeout(); maxTimeout > 0 && (timeout > maxTimeout || timeout <= 0) {
+		timeout = maxTimeout
+	}
+
+	// read the offset of the stream before submitting the transaction, so that
+	// its inclusion can't be missed
+	_, offset := e.events.MinedTxStream().ReadNonBlocking(-1)
+	hash, err := e.backend.SendRawTransaction(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	receipt, err := e.waitForReceipt(ctx, hash, offset)
+	if err != nil && ctx.Err() != nil {
+		return nil, rpctypes.NewTxSyncTimeoutError(hash, timeout)
+	}
+	return receipt, err
+}
+
+// waitForReceipt waits for the mined transactions stream to report the
+// transaction after the given offset and returns its receipt. The receipt may
+// only be served once the block is indexed, in which case it's fetched again
+// on the next blocks.
+func (e *PublicAPI) waitForReceipt(ctx context.Context, hash common.Hash, offset int) (map[string]interface{}, error) {
+	var hashes []common.Hash
+	for !slices.Contains(hashes, hash) {
+		hashes, offset = e.events.MinedTxStream().ReadBlocking(ctx, offset)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+
+	_, headerOffset := e.events.HeaderStream().ReadNonBlocking(-1)
+	for {
+		receipt, err := e.backend.GetTransactionReceipt(ctx, hash)
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		if _, headerOffset = e.events.HeaderStream().ReadBlocking(ctx, headerOffset); ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // SendTransaction sends an Ethereum transaction.
-func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
+func (e *PublicAPI) SendTransaction(ctx context.Context, args evmtypes.TransactionArgs) (_ common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "eth_sendTransaction")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_sendTransaction", "args", args)
 	return e.backend.SendTransaction(ctx, args)
@@ -284,35 +355,35 @@ func (e *PublicAPI) Accounts() ([]common.Address, error) {
 }
 
 // GetBalance returns the provided account's balance up to the provided block number.
-func (e *PublicAPI) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *hexutil.Big, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getBalance")
+func (e *PublicAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *hexutil.Big, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getBalance")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getBalance", "address", address.String(), "block number or hash", blockNrOrHash)
 	return e.backend.GetBalance(ctx, address, blockNrOrHash)
 }
 
 // GetStorageAt returns the contract storage at the given address, block number, and key.
-func (e *PublicAPI) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (_ hexutil.Bytes, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getStorageAt")
+func (e *PublicAPI) GetStorageAt(ctx context.Context, address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (_ hexutil.Bytes, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getStorageAt")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getStorageAt", "address", address.Hex(), "key", key, "block number or hash", blockNrOrHash)
 	return e.backend.GetStorageAt(ctx, address, key, blockNrOrHash)
 }
 
 // GetCode returns the contract code at the given address and block number.
-func (e *PublicAPI) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ hexutil.Bytes, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getCode")
+func (e *PublicAPI) GetCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ hexutil.Bytes, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getCode")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getCode", "address", address.Hex(), "block number or hash", blockNrOrHash)
 	return e.backend.GetCode(ctx, address, blockNrOrHash)
 }
 
 // GetProof returns an account object with proof and any storage proofs
-func (e *PublicAPI) GetProof(address common.Address,
+func (e *PublicAPI) GetProof(ctx context.Context, address common.Address,
 	storageKeys []string,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
 ) (_ *rpctypes.AccountResult, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getProof")
+	ctx, span := tracer.Start(ctx, "eth_getProof")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
 	return e.backend.GetProof(ctx, address, storageKeys, blockNrOrHash)
@@ -324,11 +395,12 @@ func (e *PublicAPI) GetProof(address common.Address,
 
 // Call performs a raw contract call.
 func (e *PublicAPI) Call(
+	ctx context.Context,
 	args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
 	overrides *json.RawMessage,
 ) (_ hexutil.Bytes, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_call")
+	ctx, span := tracer.Start(ctx, "eth_call")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_call", "args", args, "block number or hash", blockNrOrHash)
 
@@ -344,6 +416,48 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes series of transactions on top of a base state. The
+// transactions are packed into blocks, each of them with its own block and
+// state overrides.
+func (e *PublicAPI) SimulateV1(
+	ctx context.Context,
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (_ []map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_simulateV1")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	return e.backend.SimulateV1(ctx, opts, blockNrOrHash)
+}
+
+// CallMany executes bundles of calls on top of the state of a block, or of a
+// transaction of the block. The calls are executed one after another, each
+// seeing the state changes of the previous ones.
+func (e *PublicAPI) CallMany(
+	ctx context.Context,
+	bundles []rpctypes.Bundle,
+	stateCtx rpctypes.StateContext,
+	overrides *json.RawMessage,
+	timeoutMs *int64,
+) (_ [][]map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_callMany")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_callMany", "bundles", len(bundles), "block number or hash", stateCtx.BlockNumber)
+
+	return e.backend.CallMany(ctx, bundles, stateCtx, overrides, timeoutMs)
+}
+
+// CallBundle executes a bundle of signed transactions on top of the state of a
+// block and returns their results along with the value paid to the coinbase.
+func (e *PublicAPI) CallBundle(ctx context.Context, args rpctypes.CallBundleArgs) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_callBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_callBundle", "txs", len(args.Txs), "block number", args.BlockNumber)
+
+	return e.backend.CallBundle(ctx, args)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -360,35 +474,36 @@ func (e *PublicAPI) ProtocolVersion() hexutil.Uint {
 }
 
 // GasPrice returns the current gas price based on Cosmos EVM's gas price oracle.
-func (e *PublicAPI) GasPrice() (_ *hexutil.Big, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_gasPrice")
+func (e *PublicAPI) GasPrice(ctx context.Context) (_ *hexutil.Big, err error) {
+	ctx, span := tracer.Start(ctx, "eth_gasPrice")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_gasPrice")
 	return e.backend.GasPrice(ctx)
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (_ hexutil.Uint64, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_estimateGas")
+func (e *PublicAPI) EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (_ hexutil.Uint64, err error) {
+	ctx, span := tracer.Start(ctx, "eth_estimateGas")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_estimateGas")
 	return e.backend.EstimateGas(ctx, args, blockNrOrHash, overrides)
 }
 
 func (e *PublicAPI) FeeHistory(
+	ctx context.Context,
 	blockCount math.HexOrDecimal64,
 	lastBlock rpc.BlockNumber,
 	rewardPercentiles []float64,
 ) (_ *rpctypes.FeeHistoryResult, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_feeHistory")
+	ctx, span := tracer.Start(ctx, "eth_feeHistory")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_feeHistory")
 	return e.backend.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
 }
 
 // MaxPriorityFeePerGas returns a suggestion for a gas tip cap for dynamic fee transactions.
-func (e *PublicAPI) MaxPriorityFeePerGas() (_ *hexutil.Big, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_maxPriorityFeePerGas")
+func (e *PublicAPI) MaxPriorityFeePerGas(ctx context.Context) (_ *hexutil.Big, err error) {
+	ctx, span := tracer.Start(ctx, "eth_maxPriorityFeePerGas")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_maxPriorityFeePerGas")
 	head, err := e.backend.CurrentHeader(ctx)
@@ -403,8 +518,8 @@ func (e *PublicAPI) MaxPriorityFeePerGas() (_ *hexutil.Big, err error) {
 }
 
 // ChainId is the EIP-155 replay-protection chain id for the current ethereum chain config.
-func (e *PublicAPI) ChainId() (_ *hexutil.Big, err error) { //nolint
-	ctx, span := tracer.Start(context.Background(), "eth_chainId")
+func (e *PublicAPI) ChainId(ctx context.Context) (_ *hexutil.Big, err error) { //nolint
+	ctx, span := tracer.Start(ctx, "eth_chainId")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_chainId")
 	return e.backend.ChainID(ctx)
@@ -445,8 +560,8 @@ func (e *PublicAPI) GetUncleCountByBlockNumber(_ rpctypes.BlockNumber) hexutil.U
 // - highestBlock:  block number of the highest block header this node has received from peers
 // - pulledStates:  number of state entries processed until now
 // - knownStates:   number of known state entries that still need to be pulled
-func (e *PublicAPI) Syncing() (_ interface{}, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_syncing")
+func (e *PublicAPI) Syncing(ctx context.Context) (_ interface{}, err error) {
+	ctx, span := tracer.Start(ctx, "eth_syncing")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_syncing")
 	return e.backend.Syncing(ctx)
@@ -459,8 +574,8 @@ func (e *PublicAPI) Sign(address common.Address, data hexutil.Bytes) (hexutil.By
 }
 
 // GetTransactionLogs returns the logs given a transaction hash.
-func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) (_ []*ethtypes.Log, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_getTransactionLogs")
+func (e *PublicAPI) GetTransactionLogs(ctx context.Context, txHash common.Hash) (_ []*ethtypes.Log, err error) {
+	ctx, span := tracer.Start(ctx, "eth_getTransactionLogs")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	e.logger.Debug("eth_getTransactionLogs", "hash", txHash)
 
@@ -476,8 +591,8 @@ func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.Typ
 // FillTransaction fills the defaults (nonce, gas, gasPrice or 1559 fields)
 // on a given unsigned transaction, and returns it to the caller for further
 // processing (signing + broadcast).
-func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (_ *rpctypes.SignTransactionResult, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_fillTransaction")
+func (e *PublicAPI) FillTransaction(ctx context.Context, args evmtypes.TransactionArgs) (_ *rpctypes.SignTransactionResult, err error) {
+	ctx, span := tracer.Start(ctx, "eth_fillTransaction")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	// Set some sanity defaults and terminate on failure
 	args, err = e.backend.SetTxDefaults(ctx, args)
@@ -516,11 +631,12 @@ func (e *PublicAPI) Resend(ctx context.Context,
 // CreateAccessList returns the list of addresses and storage keys used by the transaction (except for the
 // sender account and precompiles), plus the estimated gas if the access list were added to the transaction.
 func (e *PublicAPI) CreateAccessList(
+	ctx context.Context,
 	args evmtypes.TransactionArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
 	overrides *json.RawMessage,
 ) (_ *rpctypes.AccessListResult, err error) {
-	ctx, span := tracer.Start(context.Background(), "eth_createAccessList")
+	ctx, span := tracer.Start(ctx, "eth_createAccessList")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	res, err := e.backend.CreateAccessList(ctx, args, blockNrOrHash, overrides)
 	if err != nil {
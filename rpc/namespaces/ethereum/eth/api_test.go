@@ -0,0 +1,181 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// eventsClient is an events client serving the events sent to its channels.
+type eventsClient struct {
+	blocks chan coretypes.ResultEvent
+	txs    chan coretypes.ResultEvent
+}
+
+func (c *eventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	if strings.Contains(query, cmttypes.EventNewBlock) {
+		return c.blocks, nil
+	}
+	return c.txs, nil
+}
+
+func (c *eventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *eventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+// mine publishes the inclusion of the transaction.
+func (c *eventsClient) mine(hash common.Hash) {
+	c.txs <- coretypes.ResultEvent{
+		Data:   cmttypes.EventDataTx{TxResult: abci.TxResult{Height: 1}},
+		Events: map[string][]string{evmtypes.TypeMsgEthereumTx + "." + evmtypes.AttributeKeyEthereumTxHash: {hash.Hex()}},
+	}
+}
+
+// newBlock publishes a new block.
+func (c *eventsClient) newBlock(height int64) {
+	c.blocks <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlock{
+		Block:               &cmttypes.Block{Header: cmttypes.Header{Height: height}},
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{},
+	}}
+}
+
+// syncBackend is the backend used to test eth_sendRawTransactionSync.
+type syncBackend struct {
+	backend.EVMBackend
+	hash          common.Hash
+	mine          func()
+	indexedAfter  int32
+	receiptCalls  atomic.Int32
+	timeout       time.Duration
+	maxTimeout    time.Duration
+	submissionErr error
+}
+
+func (b *syncBackend) RPCTxSyncTimeout() time.Duration    { return b.timeout }
+func (b *syncBackend) RPCTxSyncMaxTimeout() time.Duration { return b.maxTimeout }
+
+func (b *syncBackend) SendRawTransaction(context.Context, hexutil.Bytes) (common.Hash, error) {
+	if b.submissionErr != nil {
+		return common.Hash{}, b.submissionErr
+	}
+	if b.mine != nil {
+		go b.mine()
+	}
+	return b.hash, nil
+}
+
+func (b *syncBackend) GetTransactionReceipt(_ context.Context, hash common.Hash) (map[string]interface{}, error) {
+	if b.receiptCalls.Add(1) <= b.indexedAfter {
+		return nil, nil
+	}
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+func TestSendRawTransactionSync(t *testing.T) {
+	hash := common.HexToHash("0x1234")
+	shortTimeout := hexutil.Uint64(100)
+
+	testCases := []struct {
+		name       string
+		timeoutMs  *hexutil.Uint64
+		setup      func(*syncBackend, *eventsClient)
+		expTimeout bool
+		expErr     bool
+	}{
+		{
+			"submission error",
+			nil,
+			func(b *syncBackend, _ *eventsClient) { b.submissionErr = errors.New("invalid tx") },
+			false,
+			true,
+		},
+		{
+			"receipt after inclusion",
+			nil,
+			func(b *syncBackend, c *eventsClient) {
+				b.mine = func() {
+					c.mine(common.HexToHash("0x01"))
+					c.mine(hash)
+				}
+			},
+			false,
+			false,
+		},
+		{
+			"receipt after the block is indexed",
+			nil,
+			func(b *syncBackend, c *eventsClient) {
+				b.indexedAfter = 1
+				b.mine = func() {
+					c.mine(hash)
+					for b.receiptCalls.Load() == 0 {
+						time.Sleep(time.Millisecond)
+					}
+					c.newBlock(2)
+				}
+			},
+			false,
+			false,
+		},
+		{
+			"timeout of the request",
+			&shortTimeout,
+			nil,
+			true,
+			false,
+		},
+		{
+			"timeout capped by the node",
+			nil,
+			func(b *syncBackend, _ *eventsClient) { b.maxTimeout = 100 * time.Millisecond },
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &eventsClient{
+				blocks: make(chan coretypes.ResultEvent, 16),
+				txs:    make(chan coretypes.ResultEvent, 16),
+			}
+			b := &syncBackend{hash: hash, timeout: time.Minute}
+			if tc.setup != nil {
+				tc.setup(b, client)
+			}
+			api := NewPublicAPI(log.NewNopLogger(), b, stream.NewRPCStreams(client, log.NewNopLogger(), nil))
+
+			receipt, err := api.SendRawTransactionSync(context.Background(), hexutil.Bytes{0x01}, tc.timeoutMs)
+			switch {
+			case tc.expTimeout:
+				var timeoutErr *rpctypes.TxSyncTimeoutError
+				require.ErrorAs(t, err, &timeoutErr)
+				require.Equal(t, rpctypes.TxSyncTimeoutErrorCode, timeoutErr.ErrorCode())
+				require.Equal(t, hash.Hex(), timeoutErr.ErrorData())
+			case tc.expErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, hash, receipt["transactionHash"])
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	evmmetrics "github.com/cosmos/evm/metrics"
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
@@ -25,8 +26,7 @@ import (
 )
 
 var (
-	errInvalidBlockRange      = errors.New("invalid block range params")
-	errPendingLogsUnsupported = errors.New("pending logs are not supported")
+	errInvalidBlockRange = errors.New("invalid block range params")
 
 	tracer = otel.Tracer("evm/rpc/namespaces/ethereum/eth/filters")
 )
@@ -51,6 +51,8 @@ type Backend interface {
 	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	PendingLogs(ctx context.Context) ([]*ethtypes.Log, error)
+	IndexedLogHeights(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -63,6 +65,13 @@ type Backend interface {
 // consider a filter inactive if it has not been polled for within deadline
 const defaultDeadline = 5 * time.Minute
 
+// filterTypes are the names of the filter types in the metrics.
+var filterTypes = map[filters.Type]string{
+	filters.LogsSubscription:                "logs",
+	filters.PendingTransactionsSubscription: "pendingTransactions",
+	filters.BlocksSubscription:              "blocks",
+}
+
 // filter is a helper struct that holds meta information over the filter type
 // and associated subscription in the event system.
 type filter struct {
@@ -70,6 +79,8 @@ type filter struct {
 	deadline *time.Timer // filter is inactive when deadline triggers
 	crit     filters.FilterCriteria
 	offset   int // offset for stream subscription
+	// pendingTxs are the pending transactions whose logs were already returned
+	pendingTxs map[common.Hash]struct{}
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -132,6 +143,7 @@ func (api *PublicFilterAPI) timeoutLoop() {
 			select {
 			case <-f.deadline.C:
 				delete(api.filters, id)
+				evmmetrics.RPCFilters(filterTypes[f.typ]).Dec(1)
 			default:
 				continue
 			}
@@ -162,6 +174,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		deadline: time.NewTimer(api.deadline),
 		offset:   offset,
 	}
+	evmmetrics.RPCFilters(filterTypes[filters.PendingTransactionsSubscription]).Inc(1)
 
 	return id
 }
@@ -185,6 +198,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 		deadline: time.NewTimer(api.deadline),
 		offset:   offset,
 	}
+	evmmetrics.RPCFilters(filterTypes[filters.BlocksSubscription]).Inc(1)
 
 	return id
 }
@@ -210,6 +224,10 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		return "", fmt.Errorf("error creating filter: max limit reached")
 	}
 
+	if IsPendingBlock(criteria.FromBlock) && !IsPendingBlock(criteria.ToBlock) {
+		return "", errInvalidBlockRange
+	}
+
 	id := rpc.NewID()
 	_, offset := api.events.LogStream().ReadNonBlocking(-1)
 	api.filters[id] = &filter{
@@ -218,6 +236,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		crit:     criteria,
 		offset:   offset,
 	}
+	evmmetrics.RPCFilters(filterTypes[filters.LogsSubscription]).Inc(1)
 
 	return id, nil
 }
@@ -265,9 +284,10 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_uninstallfilter
 func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	api.filtersMu.Lock()
-	_, found := api.filters[id]
+	f, found := api.filters[id]
 	if found {
 		delete(api.filters, id)
+		evmmetrics.RPCFilters(filterTypes[f.typ]).Dec(1)
 	}
 	api.filtersMu.Unlock()
 
@@ -364,9 +384,22 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 			if len(chunk) == 0 {
 				break
 			}
+			if IsPendingBlock(f.crit.FromBlock) {
+				// only the pending logs are requested
+				continue
+			}
 			chunk = FilterLogs(chunk, f.crit.FromBlock, f.crit.ToBlock, f.crit.Addresses, f.crit.Topics)
 			logs = append(logs, chunk...)
 		}
+		if IsPendingBlock(f.crit.ToBlock) {
+			pending, err := api.backend.PendingLogs(context.Background())
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch pending logs: %w", err)
+			}
+			pending = FilterLogs(pending, nil, nil, f.crit.Addresses, f.crit.Topics)
+			pending, f.pendingTxs = UnseenPendingLogs(pending, f.pendingTxs)
+			logs = append(logs, pending...)
+		}
 		return returnLogs(logs), nil
 	default:
 		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
@@ -119,9 +119,22 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return f.blockLogs(blockRes, bloom)
 	}
 
-	// Disallow pending logs.
-	if f.criteria.FromBlock.Int64() == rpc.PendingBlockNumber.Int64() || f.criteria.ToBlock.Int64() == rpc.PendingBlockNumber.Int64() {
-		return nil, errPendingLogsUnsupported
+	// Pending logs can only be requested up to the pending block. When the range
+	// starts at the pending block, only the pending logs are returned.
+	fromPending := IsPendingBlock(f.criteria.FromBlock)
+	toPending := IsPendingBlock(f.criteria.ToBlock)
+	if fromPending && !toPending {
+		return nil, errInvalidBlockRange
+	}
+	if fromPending {
+		logs, err := f.pendingLogs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		return logs, nil
 	}
 
 	// Figure out the limits of the filter range
@@ -138,7 +151,7 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 	head := header.Number.Uint64()
 	resolveSpecial := func(number int64) (uint64, error) {
 		switch number {
-		case rpc.LatestBlockNumber.Int64(), rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64():
+		case rpc.LatestBlockNumber.Int64(), rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64(), rpc.PendingBlockNumber.Int64():
 			return head, nil
 		case rpc.EarliestBlockNumber.Int64():
 			return 1, nil
@@ -172,22 +185,23 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
-		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
-		if err != nil {
-			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
-			return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
-		}
-
-		bloom, err := f.backend.BlockBloomFromCometBlock(ctx, blockRes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
+	// Only visit the blocks known to contain matching logs when the range is
+	// covered by the log index of the EVM indexer.
+	heights, indexed, err := f.backend.IndexedLogHeights(ctx, int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the log index: %w", err)
+	}
+	if !indexed {
+		heights = make([]int64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, int64(height)) //#nosec G115
 		}
+	}
 
-		filtered, err := f.blockLogs(blockRes, bloom)
+	for _, height := range heights {
+		filtered, err := f.heightLogs(ctx, height)
 		if err != nil {
-			return nil, fmt.Errorf("failed to fetch block by number %d: %w", height, err)
+			return nil, err
 		}
 
 		// check logs limit
@@ -196,6 +210,52 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		}
 		logs = append(logs, filtered...)
 	}
+
+	if toPending {
+		pending, err := f.pendingLogs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs)+len(pending) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, pending...)
+	}
+	return logs, nil
+}
+
+// heightLogs returns the logs matching the filter criteria within the block at
+// the given height.
+func (f *Filter) heightLogs(ctx context.Context, height int64) ([]*ethtypes.Log, error) {
+	blockRes, err := f.backend.CometBlockResultByNumber(ctx, &height)
+	if err != nil {
+		f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
+		return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
+	}
+
+	bloom, err := f.backend.BlockBloomFromCometBlock(ctx, blockRes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query block bloom filter from block results: %w", err)
+	}
+
+	filtered, err := f.blockLogs(blockRes, bloom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block by number %d: %w", height, err)
+	}
+	return filtered, nil
+}
+
+// pendingLogs returns the logs of the pending block matching the filter criteria.
+func (f *Filter) pendingLogs(ctx context.Context) ([]*ethtypes.Log, error) {
+	unfiltered, err := f.backend.PendingLogs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending logs: %w", err)
+	}
+
+	logs := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+	if len(logs) == 0 {
+		return []*ethtypes.Log{}, nil
+	}
 	return logs, nil
 }
 
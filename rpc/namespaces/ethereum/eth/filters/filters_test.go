@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -58,6 +59,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().IndexedLogHeights(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return((*cmtrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -74,6 +76,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().IndexedLogHeights(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, false, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return(fakeBlockRes, nil)
 				backend.EXPECT().BlockBloomFromCometBlock(mock.Anything, fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -131,6 +134,16 @@ func TestLogs(t *testing.T) {
 
 func TestFilter(t *testing.T) {
 	logger := log.NewNopLogger()
+	pending := big.NewInt(rpc.PendingBlockNumber.Int64())
+	latest := big.NewInt(rpc.LatestBlockNumber.Int64())
+	addr := common.HexToAddress("0x1000")
+	pendingLogs := []*ethtypes.Log{
+		{Address: addr, BlockNumber: 6, TxHash: common.HexToHash("0x01")},
+		{Address: common.HexToAddress("0x2000"), BlockNumber: 6, TxHash: common.HexToHash("0x02")},
+	}
+	height := int64(5)
+	blockRes := &cmtrpctypes.ResultBlockResults{Height: height}
+
 	testCases := []struct {
 		name         string
 		filter       filters.FilterCriteria
@@ -146,6 +159,71 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "indexed logs only visit the matching blocks",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: latest, Addresses: []common.Address{addr}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(height)}, nil)
+				b.EXPECT().IndexedLogHeights(mock.Anything, int64(1), height, []common.Address{addr}, [][]common.Hash(nil)).Return([]int64{height}, true, nil)
+				b.EXPECT().CometBlockResultByNumber(mock.Anything, &height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(mock.Anything, blockRes).Return(ethtypes.Bloom{}, nil)
+			},
+		},
+		{
+			name:   "log index error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: latest, Addresses: []common.Address{addr}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(height)}, nil)
+				b.EXPECT().IndexedLogHeights(mock.Anything, int64(1), height, []common.Address{addr}, [][]common.Hash(nil)).Return(nil, false, errors.New("db closed"))
+			},
+			expErr: "failed to query the log index: db closed",
+		},
+		{
+			name:   "pending logs matching the criteria",
+			filter: filters.FilterCriteria{FromBlock: pending, ToBlock: pending, Addresses: []common.Address{addr}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().PendingLogs(mock.Anything).Return(pendingLogs, nil)
+			},
+			expLogs: pendingLogs[:1],
+		},
+		{
+			name:   "latest and pending logs",
+			filter: filters.FilterCriteria{FromBlock: latest, ToBlock: pending},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(height)}, nil)
+				b.EXPECT().IndexedLogHeights(mock.Anything, height, height, mock.Anything, mock.Anything).Return(nil, false, nil)
+				b.EXPECT().CometBlockResultByNumber(mock.Anything, &height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(mock.Anything, blockRes).Return(ethtypes.Bloom{}, nil)
+				b.EXPECT().PendingLogs(mock.Anything).Return(pendingLogs, nil)
+			},
+			expLogs: pendingLogs,
+		},
+		{
+			name:   "pending logs exceeding the logs limit",
+			filter: filters.FilterCriteria{FromBlock: pending, ToBlock: pending},
+			expectations: func(b *filtermocks.Backend) {
+				logs := make([]*ethtypes.Log, 16)
+				for i := range logs {
+					logs[i] = &ethtypes.Log{BlockNumber: 6}
+				}
+				b.EXPECT().PendingLogs(mock.Anything).Return(logs, nil)
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:         "range from pending to latest returns error",
+			filter:       filters.FilterCriteria{FromBlock: pending, ToBlock: latest},
+			expectations: func(*filtermocks.Backend) {},
+			expErr:       "invalid block range params",
+		},
+		{
+			name:   "pending logs error",
+			filter: filters.FilterCriteria{FromBlock: pending, ToBlock: pending},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().PendingLogs(mock.Anything).Return(nil, errors.New("pending logs are disabled"))
+			},
+			expErr: "failed to fetch pending logs: pending logs are disabled",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -166,3 +244,23 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestUnseenPendingLogs(t *testing.T) {
+	tx1, tx2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	logs := []*ethtypes.Log{{TxHash: tx1, Index: 0}, {TxHash: tx1, Index: 1}}
+
+	unseen, seen := UnseenPendingLogs(logs, nil)
+	require.Equal(t, logs, unseen)
+	require.Equal(t, map[common.Hash]struct{}{tx1: {}}, seen)
+
+	// the logs of a new pending transaction are the only ones returned
+	logs = append(logs, &ethtypes.Log{TxHash: tx2, Index: 2})
+	unseen, seen = UnseenPendingLogs(logs, seen)
+	require.Equal(t, logs[2:], unseen)
+	require.Len(t, seen, 2)
+
+	// mined transactions are removed from the seen set
+	unseen, seen = UnseenPendingLogs(logs[2:], seen)
+	require.Empty(t, unseen)
+	require.Equal(t, map[common.Hash]struct{}{tx2: {}}, seen)
+}
@@ -557,6 +557,133 @@ func (_c *Backend_HeaderByNumber_Call) RunAndReturn(run func(context.Context, rp
 	return _c
 }
 
+// IndexedLogHeights provides a mock function with given fields: ctx, from, to, addresses, topics
+func (_m *Backend) IndexedLogHeights(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error) {
+	ret := _m.Called(ctx, from, to, addresses, topics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IndexedLogHeights")
+	}
+
+	var r0 []int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]int64, bool, error)); ok {
+		return rf(ctx, from, to, addresses, topics)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) []int64); ok {
+		r0 = rf(ctx, from, to, addresses, topics)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) bool); ok {
+		r1 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) error); ok {
+		r2 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_IndexedLogHeights_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexedLogHeights'
+type Backend_IndexedLogHeights_Call struct {
+	*mock.Call
+}
+
+// IndexedLogHeights is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from int64
+//   - to int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+func (_e *Backend_Expecter) IndexedLogHeights(ctx interface{}, from interface{}, to interface{}, addresses interface{}, topics interface{}) *Backend_IndexedLogHeights_Call {
+	return &Backend_IndexedLogHeights_Call{Call: _e.mock.On("IndexedLogHeights", ctx, from, to, addresses, topics)}
+}
+
+func (_c *Backend_IndexedLogHeights_Call) Run(run func(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash)) *Backend_IndexedLogHeights_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]common.Address), args[4].([][]common.Hash))
+	})
+	return _c
+}
+
+func (_c *Backend_IndexedLogHeights_Call) Return(_a0 []int64, _a1 bool, _a2 error) *Backend_IndexedLogHeights_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_IndexedLogHeights_Call) RunAndReturn(run func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]int64, bool, error)) *Backend_IndexedLogHeights_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PendingLogs provides a mock function with given fields: ctx
+func (_m *Backend) PendingLogs(ctx context.Context) ([]*types.Log, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PendingLogs")
+	}
+
+	var r0 []*types.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*types.Log, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.Log); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_PendingLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PendingLogs'
+type Backend_PendingLogs_Call struct {
+	*mock.Call
+}
+
+// PendingLogs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Backend_Expecter) PendingLogs(ctx interface{}) *Backend_PendingLogs_Call {
+	return &Backend_PendingLogs_Call{Call: _e.mock.On("PendingLogs", ctx)}
+}
+
+func (_c *Backend_PendingLogs_Call) Run(run func(ctx context.Context)) *Backend_PendingLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Backend_PendingLogs_Call) Return(_a0 []*types.Log, _a1 error) *Backend_PendingLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_PendingLogs_Call) RunAndReturn(run func(context.Context) ([]*types.Log, error)) *Backend_PendingLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPCBlockRangeCap provides a mock function with no fields
 func (_m *Backend) RPCBlockRangeCap() int32 {
 	ret := _m.Called()
@@ -0,0 +1,127 @@
+package filters
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	evmmetrics "github.com/cosmos/evm/metrics"
+	"github.com/cosmos/evm/rpc/stream"
+)
+
+// The subscriptions below are served by the go-ethereum RPC server on the
+// transports supporting notifications, such as the IPC endpoint. The WebSocket
+// server handles eth_subscribe on its own.
+
+// NewHeads sends a notification each time a new header is appended to the
+// chain.
+func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	subCtx := api.cancelOnUnsubscribe(sub, "newHeads")
+	//nolint: errcheck
+	go api.events.HeaderStream().Subscribe(subCtx, func(headers []stream.RPCHeader, _ int) error {
+		for _, header := range headers {
+			if err := notifier.Notify(sub.ID, header.EthHeader); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return sub, nil
+}
+
+// Logs sends the logs matching the filter criteria. The pending logs are sent
+// when the criteria end at the pending block.
+func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	if IsPendingBlock(crit.FromBlock) && !IsPendingBlock(crit.ToBlock) {
+		return &rpc.Subscription{}, errInvalidBlockRange
+	}
+
+	notifyLogs := func(sub *rpc.Subscription, logs []*ethtypes.Log) error {
+		for _, ethLog := range logs {
+			if err := notifier.Notify(sub.ID, ethLog); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	sub := notifier.CreateSubscription()
+	subCtx := api.cancelOnUnsubscribe(sub, "logs")
+	if !IsPendingBlock(crit.FromBlock) {
+		//nolint: errcheck
+		go api.events.LogStream().Subscribe(subCtx, func(txLogs []*ethtypes.Log, _ int) error {
+			return notifyLogs(sub, FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics))
+		})
+	}
+
+	if IsPendingBlock(crit.ToBlock) {
+		// only the logs of the transactions that entered the mempool since the
+		// last notification are sent
+		var seen map[common.Hash]struct{}
+		//nolint: errcheck
+		go api.events.PendingTxStream().Subscribe(subCtx, func(_ []common.Hash, _ int) error {
+			logs, err := api.backend.PendingLogs(subCtx)
+			if err != nil {
+				api.logger.Debug("failed to fetch pending logs", "error", err.Error())
+				return nil
+			}
+			logs = FilterLogs(logs, nil, nil, crit.Addresses, crit.Topics)
+			logs, seen = UnseenPendingLogs(logs, seen)
+			return notifyLogs(sub, logs)
+		})
+	}
+
+	return sub, nil
+}
+
+// NewPendingTransactions sends the hash of each transaction entering the
+// mempool.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	subCtx := api.cancelOnUnsubscribe(sub, "newPendingTransactions")
+	//nolint: errcheck
+	go api.events.PendingTxStream().Subscribe(subCtx, func(hashes []common.Hash, _ int) error {
+		for _, hash := range hashes {
+			if err := notifier.Notify(sub.ID, hash); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return sub, nil
+}
+
+// cancelOnUnsubscribe returns a context canceled when the subscription ends,
+// either unsubscribed by the client or closed with its connection. The
+// subscription is counted in the metrics of its type until then.
+func (api *PublicFilterAPI) cancelOnUnsubscribe(sub *rpc.Subscription, typ string) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	active := evmmetrics.RPCSubscriptions(typ)
+	active.Inc(1)
+	go func() {
+		<-sub.Err()
+		active.Dec(1)
+		cancel()
+	}()
+	return ctx
+}
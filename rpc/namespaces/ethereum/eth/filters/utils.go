@@ -6,8 +6,31 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// IsPendingBlock returns true if the given block number of a filter criteria
+// refers to the pending block.
+func IsPendingBlock(number *big.Int) bool {
+	return number != nil && number.Int64() == rpc.PendingBlockNumber.Int64()
+}
+
+// UnseenPendingLogs returns the pending logs of the transactions that are not
+// in the seen set, along with the set of transactions of all the given logs to
+// be used as the seen set of the next call. Pending logs are delivered once per
+// transaction, even if its execution changes because of new pending transactions.
+func UnseenPendingLogs(logs []*ethtypes.Log, seen map[common.Hash]struct{}) ([]*ethtypes.Log, map[common.Hash]struct{}) {
+	var unseen []*ethtypes.Log
+	next := make(map[common.Hash]struct{}, len(seen))
+	for _, log := range logs {
+		next[log.TxHash] = struct{}{}
+		if _, ok := seen[log.TxHash]; !ok {
+			unseen = append(unseen, log)
+		}
+	}
+	return unseen, next
+}
+
 // FilterLogs creates a slice of logs matching the given criteria.
 // [] -> anything
 // [A] -> A in first position of log topics, anything after
@@ -36,17 +36,17 @@ func NewPrivateAPI(
 }
 
 // SetEtherbase sets the etherbase of the miner
-func (api *API) SetEtherbase(etherbase common.Address) bool {
+func (api *API) SetEtherbase(ctx context.Context, etherbase common.Address) bool {
 	api.logger.Debug("miner_setEtherbase")
-	ctx, span := tracer.Start(context.Background(), "miner_setEtherbase")
+	ctx, span := tracer.Start(ctx, "miner_setEtherbase")
 	defer span.End()
 	return api.backend.SetEtherbase(ctx, etherbase)
 }
 
 // SetGasPrice sets the minimum accepted gas price for the miner.
-func (api *API) SetGasPrice(gasPrice hexutil.Big) bool {
+func (api *API) SetGasPrice(ctx context.Context, gasPrice hexutil.Big) bool {
 	api.logger.Info(api.ctx.Viper.ConfigFileUsed())
-	ctx, span := tracer.Start(context.Background(), "miner_setGasPrice")
+	ctx, span := tracer.Start(ctx, "miner_setGasPrice")
 	defer span.End()
 	return api.backend.SetGasPrice(ctx, gasPrice)
 }
@@ -40,8 +40,8 @@ func (s *PublicAPI) Version() string {
 }
 
 // Listening returns if client is actively listening for network connections.
-func (s *PublicAPI) Listening() bool {
-	ctx, span := tracer.Start(context.Background(), "Listening")
+func (s *PublicAPI) Listening(ctx context.Context) bool {
+	ctx, span := tracer.Start(ctx, "Listening")
 	defer span.End()
 	netInfo, err := s.tmClient.NetInfo(ctx)
 	if err != nil {
@@ -51,8 +51,8 @@ func (s *PublicAPI) Listening() bool {
 }
 
 // PeerCount returns the number of peers currently connected to the client.
-func (s *PublicAPI) PeerCount() int {
-	ctx, span := tracer.Start(context.Background(), "PeerCount")
+func (s *PublicAPI) PeerCount(ctx context.Context) int {
+	ctx, span := tracer.Start(ctx, "PeerCount")
 	defer span.End()
 	netInfo, err := s.tmClient.NetInfo(ctx)
 	if err != nil {
@@ -0,0 +1,382 @@
+package ots
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	// apiLevel is the level of the Otterscan API implemented by the namespace,
+	// checked by Otterscan on startup.
+	apiLevel = 8
+
+	callTracer = "callTracer"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/ots")
+
+// ErrContractCreationNotIndexed is returned for the contracts not created by
+// the indexed transactions themselves, whose creation isn't indexed.
+var ErrContractCreationNotIndexed = errors.New("the creation of the contract isn't indexed, only the contracts created by the transactions are")
+
+// API is the collection of the Otterscan APIs. The transactions are searched by
+// address in the EVM indexer, and the internal operations are found by tracing
+// the transactions with the go-ethereum native call tracer.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the ots namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the level of the Otterscan API implemented by the node.
+func (a *API) GetApiLevel() uint64 { //nolint:revive,stylecheck // the method name defines the JSON-RPC method
+	a.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// HasCode returns true if the address holds code at the given block.
+func (a *API) HasCode(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ bool, err error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	ctx, span := tracer.Start(ctx, "ots_hasCode", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	code, err := a.backend.GetCode(ctx, address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self-destructs performed by the contracts called by the given transaction.
+func (a *API) GetInternalOperations(ctx context.Context, hash common.Hash) (_ []*InternalOperation, err error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	ctx, span := tracer.Start(ctx, "ots_getInternalOperations", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return frame.internalOperations(0, []*InternalOperation{}), nil
+}
+
+// TraceTransaction returns the call frames of the given transaction, in the
+// order they are entered.
+func (a *API) TraceTransaction(ctx context.Context, hash common.Hash) (_ []*TraceEntry, err error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	ctx, span := tracer.Start(ctx, "ots_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return frame.traceEntries(0, nil), nil
+}
+
+// GetTransactionError returns the revert data of the given transaction, which
+// is empty if the transaction succeeded.
+func (a *API) GetTransactionError(ctx context.Context, hash common.Hash) (_ hexutil.Bytes, err error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	ctx, span := tracer.Start(ctx, "ots_getTransactionError", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := a.traceCalls(ctx, hash, true)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// GetBlockDetails returns the given block without its transactions, along with
+// the fees paid by them.
+func (a *API) GetBlockDetails(ctx context.Context, blockNr rpctypes.BlockNumber) (_ *BlockDetails, err error) {
+	a.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	ctx, span := tracer.Start(ctx, "ots_getBlockDetails", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByNumber(ctx, blockNr, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+// GetBlockDetailsByHash returns the given block without its transactions, along
+// with the fees paid by them.
+func (a *API) GetBlockDetailsByHash(ctx context.Context, hash common.Hash) (_ *BlockDetails, err error) {
+	a.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	ctx, span := tracer.Start(ctx, "ots_getBlockDetailsByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByHash(ctx, hash, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+// GetBlockTransactions returns a page of the transactions of the given block
+// along with their receipts. The pages are numbered from the last transactions
+// of the block.
+func (a *API) GetBlockTransactions(ctx context.Context, blockNr rpctypes.BlockNumber, pageNumber, pageSize uint8) (_ map[string]interface{}, err error) {
+	a.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "size", pageSize)
+	ctx, span := tracer.Start(ctx, "ots_getBlockTransactions", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := a.backend.GetBlockByNumber(ctx, blockNr, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := a.blockReceipts(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	txs, ok := block["transactions"].([]interface{})
+	if !ok || len(txs) != len(receipts) {
+		return nil, fmt.Errorf("invalid transactions of block %d", blockNr)
+	}
+
+	end := max(len(txs)-int(pageNumber)*int(pageSize), 0)
+	start := max(end-int(pageSize), 0)
+
+	// the block may be cached by the backend, so it's copied before being paged
+	block = maps.Clone(block)
+	block["transactions"] = txs[start:end]
+	return map[string]interface{}{
+		"fullblock": block,
+		"receipts":  receipts[start:end],
+	}, nil
+}
+
+// SearchTransactionsBefore returns the transactions sent or received by the
+// address in the blocks before the given one, from the most recent one. The
+// search starts from the latest block if blockNum is zero. Whole blocks are
+// returned until pageSize transactions are found. Only the transactions sent by
+// the address, sent to it or creating it are found, not their internal calls,
+// and the search fails below the first block covered by the address index.
+func (a *API) SearchTransactionsBefore(ctx context.Context, address common.Address, blockNum uint64, pageSize uint16) (_ *TransactionsWithReceipts, err error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "number", blockNum, "size", pageSize)
+	ctx, span := tracer.Start(ctx, "ots_searchTransactionsBefore", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.Int64("blockNum", int64(blockNum)), //#nosec G115 -- int overflow is not a concern here
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hashes, more, err := a.backend.AddressTxs(ctx, address, int64(blockNum), true, int(pageSize)) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+	res, err := a.txsWithReceipts(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = blockNum == 0
+	res.LastPage = !more
+	return res, nil
+}
+
+// SearchTransactionsAfter returns the transactions sent or received by the
+// address in the blocks after the given one, from the most recent one. The
+// search starts from the genesis if blockNum is zero. Whole blocks are
+// returned until pageSize transactions are found. Only the transactions sent by
+// the address, sent to it or creating it are found, not their internal calls,
+// and the search fails below the first block covered by the address index.
+func (a *API) SearchTransactionsAfter(ctx context.Context, address common.Address, blockNum uint64, pageSize uint16) (_ *TransactionsWithReceipts, err error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "number", blockNum, "size", pageSize)
+	ctx, span := tracer.Start(ctx, "ots_searchTransactionsAfter", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.Int64("blockNum", int64(blockNum)), //#nosec G115 -- int overflow is not a concern here
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hashes, more, err := a.backend.AddressTxs(ctx, address, int64(blockNum), false, int(pageSize)) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+	// the pages are ordered from the most recent transaction
+	slices.Reverse(hashes)
+	res, err := a.txsWithReceipts(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage = !more
+	res.LastPage = blockNum == 0
+	return res, nil
+}
+
+// GetContractCreator returns the transaction that created the given contract
+// and its sender, or nil if the address isn't a contract. Only the contracts
+// created by the transactions themselves are indexed, so that an error is
+// returned for the other contracts, e.g. the ones created by contracts.
+func (a *API) GetContractCreator(ctx context.Context, address common.Address) (_ *ContractCreator, err error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	ctx, span := tracer.Start(ctx, "ots_getContractCreator", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hash, err := a.backend.ContractCreationTx(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if hash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		hasCode, err := a.HasCode(ctx, address, rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+		if err != nil || !hasCode {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", ErrContractCreationNotIndexed, address.Hex())
+	}
+	tx, err := a.backend.GetTransactionByHash(ctx, *hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+	}
+	return &ContractCreator{Hash: *hash, Creator: tx.From}, nil
+}
+
+// traceCalls traces the given transaction with the native call tracer,
+// returning its top call frame.
+func (a *API) traceCalls(ctx context.Context, hash common.Hash, onlyTopCall bool) (*callFrame, error) {
+	config := &rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{Tracer: callTracer}}
+	if onlyTopCall {
+		config.TracerConfig = json.RawMessage(`{"onlyTopCall":true}`)
+	}
+	res, err := a.backend.TraceTransaction(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// blockDetails returns the details of the given block, which is copied as it
+// may be cached by the backend.
+func (a *API) blockDetails(ctx context.Context, block map[string]interface{}) (*BlockDetails, error) {
+	receipts, err := a.blockReceipts(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		gasUsed, ok := receipt["gasUsed"].(hexutil.Uint64)
+		if !ok {
+			return nil, fmt.Errorf("invalid gas used type: %T", receipt["gasUsed"])
+		}
+		gasPrice, ok := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if !ok {
+			return nil, fmt.Errorf("invalid effective gas price type: %T", receipt["effectiveGasPrice"])
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()))
+	}
+
+	block = maps.Clone(block)
+	delete(block, "transactions")
+	block["transactionCount"] = hexutil.Uint64(len(receipts))
+	block["logsBloom"] = nil
+	zero := (*hexutil.Big)(new(big.Int))
+	return &BlockDetails{
+		Block:     block,
+		Issuance:  Issuance{BlockReward: zero, UncleReward: zero, Issuance: zero},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// blockReceipts returns the receipts of the given block.
+func (a *API) blockReceipts(ctx context.Context, block map[string]interface{}) ([]map[string]interface{}, error) {
+	number, ok := block["number"].(*hexutil.Big)
+	if !ok {
+		return nil, fmt.Errorf("invalid block number type: %T", block["number"])
+	}
+	blockNr := rpctypes.BlockNumber(number.ToInt().Int64())
+	return a.backend.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+}
+
+// txsWithReceipts returns the given transactions along with their receipts,
+// which hold the timestamp of their block.
+func (a *API) txsWithReceipts(ctx context.Context, hashes []common.Hash) (*TransactionsWithReceipts, error) {
+	res := &TransactionsWithReceipts{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+		Receipts: make([]map[string]interface{}, 0, len(hashes)),
+	}
+	timestamps := make(map[hexutil.Uint64]hexutil.Uint64)
+	for _, hash := range hashes {
+		tx, err := a.backend.GetTransactionByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := a.backend.GetTransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+
+		number, ok := receipt["blockNumber"].(hexutil.Uint64)
+		if !ok {
+			return nil, fmt.Errorf("invalid block number type: %T", receipt["blockNumber"])
+		}
+		timestamp, ok := timestamps[number]
+		if !ok {
+			header, err := a.backend.HeaderByNumber(ctx, rpctypes.BlockNumber(number)) //#nosec G115 -- int overflow is not a concern here
+			if err != nil {
+				return nil, err
+			}
+			timestamp = hexutil.Uint64(header.Time)
+			timestamps[number] = timestamp
+		}
+
+		// the receipt may be cached by the backend, so it's copied before being extended
+		receipt = maps.Clone(receipt)
+		receipt["timestamp"] = timestamp
+		res.Txs = append(res.Txs, tx)
+		res.Receipts = append(res.Receipts, receipt)
+	}
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package ots
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// creationsBackend is a backend serving the indexed contract creations and the
+// code of the contracts.
+type creationsBackend struct {
+	backend.EVMBackend
+	creations map[common.Address]common.Hash
+	senders   map[common.Hash]common.Address
+	codes     map[common.Address][]byte
+}
+
+func (b *creationsBackend) ContractCreationTx(_ context.Context, address common.Address) (*common.Hash, error) {
+	hash, found := b.creations[address]
+	if !found {
+		return nil, nil
+	}
+	return &hash, nil
+}
+
+func (b *creationsBackend) GetTransactionByHash(_ context.Context, hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	return &rpctypes.RPCTransaction{Hash: hash, From: b.senders[hash]}, nil
+}
+
+func (b *creationsBackend) GetCode(_ context.Context, address common.Address, _ rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return b.codes[address], nil
+}
+
+func TestGetContractCreator(t *testing.T) {
+	deployed, created, account := common.HexToAddress("0x1000"), common.HexToAddress("0x2000"), common.HexToAddress("0x3000")
+	sender, hash := common.HexToAddress("0x1"), common.HexToHash("0xabcd")
+	api := &API{
+		logger: log.NewNopLogger(),
+		backend: &creationsBackend{
+			creations: map[common.Address]common.Hash{deployed: hash},
+			senders:   map[common.Hash]common.Address{hash: sender},
+			codes:     map[common.Address][]byte{deployed: {0x1}, created: {0x1}},
+		},
+	}
+
+	creator, err := api.GetContractCreator(context.Background(), deployed)
+	require.NoError(t, err)
+	require.Equal(t, &ContractCreator{Hash: hash, Creator: sender}, creator)
+
+	// the creation of the contracts created by other contracts isn't indexed
+	_, err = api.GetContractCreator(context.Background(), created)
+	require.ErrorIs(t, err, ErrContractCreationNotIndexed)
+
+	creator, err = api.GetContractCreator(context.Background(), account)
+	require.NoError(t, err)
+	require.Nil(t, creator)
+}
@@ -0,0 +1,158 @@
+package ots
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Types of the internal operations, as defined by Otterscan.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is a value transfer, contract creation or self-destruct
+// performed by a contract during the execution of a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call frame of a transaction, in the order it is entered.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// BlockDetails is a block without its transactions, along with the fees paid
+// by them. The issuance is always zero since the block rewards aren't paid by
+// the EVM.
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  Issuance               `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// Issuance is the native currency issued by a block.
+type Issuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address, ordered
+// from the most recent one. The receipts hold the timestamp of their block.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// ContractCreator is the transaction that created a contract, along with its
+// sender.
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// callFrame is a call frame produced by the native call tracer.
+type callFrame struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Value  *hexutil.Big    `json:"value"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Calls  []callFrame     `json:"calls"`
+}
+
+// to returns the recipient of the frame, which is the zero address for failed
+// contract creations.
+func (f *callFrame) to() common.Address {
+	if f.To == nil {
+		return common.Address{}
+	}
+	return *f.To
+}
+
+// internalOperations appends the internal operations of the frame and its
+// subcalls to ops. The operations of the reverted frames are discarded, as
+// well as the ones of the transaction itself at depth zero.
+func (f *callFrame) internalOperations(depth int, ops []*InternalOperation) []*InternalOperation {
+	if f.Error != "" {
+		return ops
+	}
+	if depth > 0 {
+		if op := f.internalOperation(); op != nil {
+			ops = append(ops, op)
+		}
+	}
+	for i := range f.Calls {
+		ops = f.Calls[i].internalOperations(depth+1, ops)
+	}
+	return ops
+}
+
+// internalOperation returns the internal operation performed by the frame, if
+// any. Plain calls are only value transfers if they carry value.
+func (f *callFrame) internalOperation() *InternalOperation {
+	op := &InternalOperation{From: f.From, To: f.to(), Value: f.Value}
+	switch f.Type {
+	case vm.CALL.String():
+		if f.Value == nil || f.Value.ToInt().Sign() == 0 {
+			return nil
+		}
+		op.Type = OpTransfer
+	case vm.CREATE.String():
+		op.Type = OpCreate
+	case vm.CREATE2.String():
+		op.Type = OpCreate2
+	case vm.SELFDESTRUCT.String():
+		op.Type = OpSelfDestruct
+	default:
+		return nil
+	}
+	if op.Value == nil {
+		op.Value = (*hexutil.Big)(new(big.Int))
+	}
+	return op
+}
+
+// traceEntries appends the trace entries of the frame and its subcalls to
+// entries, in the order they are entered.
+func (f *callFrame) traceEntries(depth int, entries []*TraceEntry) []*TraceEntry {
+	value := f.Value
+	// the delegated and static calls don't transfer any value
+	if f.Type == vm.DELEGATECALL.String() || f.Type == vm.STATICCALL.String() {
+		value = nil
+	}
+	entries = append(entries, &TraceEntry{
+		Type:   f.Type,
+		Depth:  depth,
+		From:   f.From,
+		To:     f.to(),
+		Value:  value,
+		Input:  f.Input,
+		Output: f.Output,
+	})
+	for i := range f.Calls {
+		entries = f.Calls[i].traceEntries(depth+1, entries)
+	}
+	return entries
+}
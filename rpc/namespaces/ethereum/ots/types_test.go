@@ -0,0 +1,62 @@
+package ots
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// callTrace is the result of the callTracer for a transaction calling a factory
+// which creates a contract, transfers value through a reverted call and a
+// successful one, reads a contract and self-destructs a contract.
+const callTrace = `{
+	"type": "CALL", "from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000001000", "value": "0x5", "input": "0x01",
+	"calls": [
+		{"type": "CREATE2", "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000002000", "value": "0x0", "input": "0x6001", "output": "0x60"},
+		{"type": "CALL", "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000003000", "value": "0x2", "error": "execution reverted",
+			"calls": [{"type": "CALL", "from": "0x0000000000000000000000000000000000003000", "to": "0x0000000000000000000000000000000000004000", "value": "0x1"}]},
+		{"type": "CALL", "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000004000", "value": "0x1"},
+		{"type": "STATICCALL", "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000002000", "input": "0x02", "output": "0x03",
+			"calls": [{"type": "SELFDESTRUCT", "from": "0x0000000000000000000000000000000000002000", "to": "0x0000000000000000000000000000000000001000"}]}
+	]
+}`
+
+func TestInternalOperations(t *testing.T) {
+	var frame callFrame
+	require.NoError(t, json.Unmarshal([]byte(callTrace), &frame))
+	ops := frame.internalOperations(0, nil)
+
+	bz, err := json.Marshal(ops)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type": 3, "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000002000", "value": "0x0"},
+		{"type": 0, "from": "0x0000000000000000000000000000000000001000", "to": "0x0000000000000000000000000000000000004000", "value": "0x1"},
+		{"type": 1, "from": "0x0000000000000000000000000000000000002000", "to": "0x0000000000000000000000000000000000001000", "value": "0x0"}
+	]`, string(bz))
+
+	// reverted transactions don't perform any operation
+	frame.Error = "execution reverted"
+	require.Empty(t, frame.internalOperations(0, nil))
+}
+
+func TestTraceEntries(t *testing.T) {
+	var frame callFrame
+	require.NoError(t, json.Unmarshal([]byte(callTrace), &frame))
+	entries := frame.traceEntries(0, nil)
+
+	expTypes := []string{"CALL", "CREATE2", "CALL", "CALL", "CALL", "STATICCALL", "SELFDESTRUCT"}
+	expDepths := []int{0, 1, 1, 2, 1, 1, 2}
+	require.Len(t, entries, len(expTypes))
+	for i, entry := range entries {
+		require.Equal(t, expTypes[i], entry.Type)
+		require.Equal(t, expDepths[i], entry.Depth)
+	}
+	require.Equal(t, common.HexToAddress("0x01"), entries[0].From)
+	require.Equal(t, "0x5", entries[0].Value.String())
+	require.Equal(t, common.HexToAddress("0x2000"), entries[1].To)
+	// static calls don't carry value
+	require.Nil(t, entries[5].Value)
+	require.Equal(t, "0x03", entries[5].Output.String())
+}
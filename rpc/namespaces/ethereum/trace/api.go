@@ -0,0 +1,291 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtracers "github.com/cosmos/evm/x/vm/tracers"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flatCallTracer = "flatCallTracer"
+	prestateTracer = "prestateTracer"
+	muxTracer      = "muxTracer"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/trace")
+
+// API is the collection of Parity/OpenEthereum style tracing APIs, built on
+// top of the go-ethereum native tracers.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions in the given block.
+func (a *API) Block(ctx context.Context, blockNr rpctypes.BlockNumber) (_ []*FlatTrace, err error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	ctx, span := tracer.Start(ctx, "trace_block", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	return a.blockTraces(ctx, blockNr)
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (a *API) Transaction(ctx context.Context, hash common.Hash) (_ []*FlatTrace, err error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	ctx, span := tracer.Start(ctx, "trace_transaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := a.backend.TraceTransaction(ctx, hash, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: flatCallTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*FlatTrace
+	if err := decodeResult(res, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// Filter returns the flat call traces matching the given filter. The block range
+// is capped by the JSON-RPC BlockRangeCap.
+func (a *API) Filter(ctx context.Context, args FilterArgs) (_ []*FlatTrace, err error) {
+	a.logger.Debug("trace_filter", "args", args)
+	ctx, span := tracer.Start(ctx, "trace_filter")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	from, err := a.resolveBlockNumber(ctx, args.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := a.resolveBlockNumber(ctx, args.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d > to %d", from, to)
+	}
+	// genesis is not traceable
+	from = max(from, 1)
+	if blockLimit := int64(a.backend.RPCBlockRangeCap()); blockLimit > 0 && to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+
+	res := []*FlatTrace{}
+	for height := from; height <= to; height++ {
+		traces, err := a.blockTraces(ctx, rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, callTrace := range traces {
+			if !args.matches(callTrace) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			res = append(res, callTrace)
+			if args.Count != nil && uint64(len(res)) >= count {
+				return res, nil
+			}
+		}
+	}
+	return res, nil
+}
+
+// ReplayBlockTransactions replays all the transactions in the given block,
+// returning the requested trace types for each of them: "trace", "stateDiff"
+// and "vmTrace".
+func (a *API) ReplayBlockTransactions(ctx context.Context, blockNr rpctypes.BlockNumber, traceTypes []string) (_ []*TraceResults, err error) {
+	a.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "types", traceTypes)
+	ctx, span := tracer.Start(ctx, "trace_replayBlockTransactions", trace.WithAttributes(attribute.Int64("blockNr", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var withTrace, withStateDiff, withVMTrace bool
+	for _, typ := range traceTypes {
+		switch typ {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			withVMTrace = true
+		default:
+			return nil, fmt.Errorf("invalid trace type: %s", typ)
+		}
+	}
+
+	// the flat call traces are always needed to get the output of the transactions
+	tracerConfig := map[string]json.RawMessage{flatCallTracer: json.RawMessage("{}")}
+	if withStateDiff {
+		tracerConfig[prestateTracer] = json.RawMessage(`{"diffMode":true}`)
+	}
+	if withVMTrace {
+		tracerConfig[evmtracers.VMTraceTracerName] = json.RawMessage("{}")
+	}
+	bz, err := json.Marshal(tracerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := a.traceBlock(ctx, blockNr, &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: muxTracer},
+		TracerConfig: bz,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*TraceResults, 0, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, result.Error)
+		}
+
+		var muxResult struct {
+			Traces    []*FlatTrace    `json:"flatCallTracer"`
+			StateDiff *prestateDiff   `json:"prestateTracer"`
+			VMTrace   json.RawMessage `json:"vmTraceTracer"`
+		}
+		if err := decodeResult(result.Result, &muxResult); err != nil {
+			return nil, err
+		}
+
+		replay := &TraceResults{Output: hexutil.Bytes{}}
+		for _, callTrace := range muxResult.Traces {
+			if len(callTrace.TraceAddress) > 0 {
+				continue
+			}
+			replay.TransactionHash = callTrace.TransactionHash
+			if callTrace.Result != nil && callTrace.Result.Output != nil {
+				replay.Output = *callTrace.Result.Output
+			}
+		}
+		if withTrace {
+			replay.Trace = make([]*FlatTrace, len(muxResult.Traces))
+			for j, callTrace := range muxResult.Traces {
+				// the block and transaction are implicit in replays
+				callTrace.BlockHash, callTrace.BlockNumber = nil, nil
+				callTrace.TransactionHash, callTrace.TransactionPosition = nil, nil
+				replay.Trace[j] = callTrace
+			}
+		} else {
+			replay.Trace = []*FlatTrace{}
+		}
+		if withStateDiff && muxResult.StateDiff != nil {
+			replay.StateDiff = muxResult.StateDiff.toStateDiff()
+		}
+		if withVMTrace {
+			replay.VMTrace = muxResult.VMTrace
+		}
+		replays = append(replays, replay)
+	}
+	return replays, nil
+}
+
+// blockTraces returns the flat call traces of all the transactions in a block.
+func (a *API) blockTraces(ctx context.Context, blockNr rpctypes.BlockNumber) ([]*FlatTrace, error) {
+	results, err := a.traceBlock(ctx, blockNr, &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: flatCallTracer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*FlatTrace{}
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %d: %s", i, result.Error)
+		}
+		var txTraces []*FlatTrace
+		if err := decodeResult(result.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// traceBlock traces all the transactions in a block with the given config.
+func (a *API) traceBlock(ctx context.Context, blockNr rpctypes.BlockNumber, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := a.backend.CometBlockByNumber(ctx, blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNr, "error", err.Error())
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+
+	return a.backend.TraceBlock(ctx, rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+}
+
+// resolveBlockNumber returns the height of the given block number, defaulting
+// to the latest block.
+func (a *API) resolveBlockNumber(ctx context.Context, blockNr *rpctypes.BlockNumber) (int64, error) {
+	if blockNr != nil && *blockNr == rpctypes.EthEarliestBlockNumber {
+		return 1, nil
+	}
+	if blockNr != nil && *blockNr >= 0 {
+		return blockNr.Int64(), nil
+	}
+	latest, err := a.backend.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(latest), nil //#nosec G115 -- int overflow is not a concern here
+}
+
+// decodeResult converts a decoded tracer result to the given type.
+func decodeResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
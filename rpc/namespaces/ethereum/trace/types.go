@@ -0,0 +1,231 @@
+package trace
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Trace types supported by trace_replayBlockTransactions.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// FlatTrace is a single call frame in the Parity/OpenEthereum flat format, as
+// returned by the go-ethereum `flatCallTracer`.
+type FlatTrace struct {
+	Action              TraceAction  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              *TraceResult `json:"result,omitempty"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// TraceAction is the action performed by a call frame.
+type TraceAction struct {
+	Author         *common.Address `json:"author,omitempty"`
+	RewardType     string          `json:"rewardType,omitempty"`
+	SelfDestructed *common.Address `json:"address,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+}
+
+// TraceResult is the result of a successful call frame.
+type TraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// FilterArgs are the arguments of trace_filter.
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// matches returns true if the trace matches the address filters. Empty
+// filters match every trace.
+func (args FilterArgs) matches(trace *FlatTrace) bool {
+	if len(args.FromAddress) > 0 {
+		from := trace.Action.From
+		if from == nil {
+			from = trace.Action.Author
+		}
+		if from == nil || !slices.Contains(args.FromAddress, *from) {
+			return false
+		}
+	}
+	if len(args.ToAddress) > 0 {
+		to := trace.Action.To
+		if to == nil {
+			to = trace.Action.RefundAddress
+		}
+		if to == nil && trace.Result != nil {
+			// contract creations
+			to = trace.Result.Address
+		}
+		if to == nil || !slices.Contains(args.ToAddress, *to) {
+			return false
+		}
+	}
+	return true
+}
+
+// TraceResults is the replay of a transaction returned by
+// trace_replayBlockTransactions.
+type TraceResults struct {
+	Output          hexutil.Bytes   `json:"output"`
+	StateDiff       StateDiff       `json:"stateDiff"`
+	Trace           []*FlatTrace    `json:"trace"`
+	VMTrace         json.RawMessage `json:"vmTrace"`
+	TransactionHash *common.Hash    `json:"transactionHash,omitempty"`
+}
+
+// StateDiff is the Parity/OpenEthereum state diff of a transaction, keyed by
+// the modified accounts.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the changes of an account. Every field is either "=" when
+// unchanged, or a map with a single "+" (born), "-" (died) or "*" (changed) key.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// prestateAccount is an account as returned by the go-ethereum `prestateTracer`.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateDiff is the result of the go-ethereum `prestateTracer` in diff mode.
+// Unmodified accounts are omitted, and the post state only contains the
+// modified fields.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+const diffSame = "="
+
+func diffBorn(v interface{}) interface{} {
+	return map[string]interface{}{"+": v}
+}
+
+func diffDied(v interface{}) interface{} {
+	return map[string]interface{}{"-": v}
+}
+
+func diffChanged(from, to interface{}) interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+// toStateDiff converts the prestate diff of a transaction to the Parity state
+// diff format.
+func (d prestateDiff) toStateDiff() StateDiff {
+	res := make(StateDiff)
+	for addr, pre := range d.Pre {
+		post, ok := d.Post[addr]
+		if !ok {
+			// accounts only present in the pre state were deleted
+			diff := &AccountDiff{
+				Balance: diffDied(balanceOrZero(pre.Balance)),
+				Code:    diffDied(codeOrEmpty(pre.Code)),
+				Nonce:   diffDied(hexutil.Uint64(pre.Nonce)),
+				Storage: make(map[common.Hash]interface{}, len(pre.Storage)),
+			}
+			for key, val := range pre.Storage {
+				diff.Storage[key] = diffDied(val)
+			}
+			res[addr] = diff
+			continue
+		}
+
+		diff := &AccountDiff{
+			Balance: diffSame,
+			Code:    diffSame,
+			Nonce:   diffSame,
+			Storage: make(map[common.Hash]interface{}),
+		}
+		if post.Balance != nil {
+			diff.Balance = diffChanged(balanceOrZero(pre.Balance), post.Balance)
+		}
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			diff.Nonce = diffChanged(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+		}
+		if len(post.Code) > 0 {
+			diff.Code = diffChanged(codeOrEmpty(pre.Code), post.Code)
+		}
+		// zero slots are omitted from both states
+		for key, val := range pre.Storage {
+			diff.Storage[key] = diffChanged(val, post.Storage[key])
+		}
+		for key, val := range post.Storage {
+			if _, ok := pre.Storage[key]; !ok {
+				diff.Storage[key] = diffChanged(common.Hash{}, val)
+			}
+		}
+		res[addr] = diff
+	}
+
+	for addr, post := range d.Post {
+		if _, ok := d.Pre[addr]; ok {
+			continue
+		}
+		// accounts only present in the post state were created
+		diff := &AccountDiff{
+			Balance: diffBorn(balanceOrZero(post.Balance)),
+			Code:    diffBorn(codeOrEmpty(post.Code)),
+			Nonce:   diffBorn(hexutil.Uint64(post.Nonce)),
+			Storage: make(map[common.Hash]interface{}, len(post.Storage)),
+		}
+		for key, val := range post.Storage {
+			diff.Storage[key] = diffBorn(val)
+		}
+		res[addr] = diff
+	}
+	return res
+}
+
+func balanceOrZero(balance *hexutil.Big) *hexutil.Big {
+	if balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return balance
+}
+
+func codeOrEmpty(code hexutil.Bytes) hexutil.Bytes {
+	if code == nil {
+		return hexutil.Bytes{}
+	}
+	return code
+}
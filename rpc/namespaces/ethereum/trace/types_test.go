@@ -0,0 +1,87 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToStateDiff(t *testing.T) {
+	var (
+		modified = common.HexToAddress("0x1000")
+		created  = common.HexToAddress("0x2000")
+		deleted  = common.HexToAddress("0x3000")
+		slot1    = common.HexToHash("0x01")
+		slot2    = common.HexToHash("0x02")
+	)
+
+	// result of the prestateTracer in diff mode
+	raw := `{
+		"pre": {
+			"0x0000000000000000000000000000000000001000": {"balance": "0x10", "nonce": 1, "storage": {"` + slot1.Hex() + `": "` + common.HexToHash("0x05").Hex() + `"}},
+			"0x0000000000000000000000000000000000003000": {"balance": "0x1", "code": "0x6001"}
+		},
+		"post": {
+			"0x0000000000000000000000000000000000001000": {"balance": "0x8", "storage": {"` + slot2.Hex() + `": "` + common.HexToHash("0x07").Hex() + `"}},
+			"0x0000000000000000000000000000000000002000": {"balance": "0x2", "nonce": 1, "code": "0x60016000"}
+		}
+	}`
+
+	var diff prestateDiff
+	require.NoError(t, json.Unmarshal([]byte(raw), &diff))
+	bz, err := json.Marshal(diff.toStateDiff())
+	require.NoError(t, err)
+
+	var res map[common.Address]map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Len(t, res, 3)
+
+	require.Equal(t, map[string]interface{}{"*": map[string]interface{}{"from": "0x10", "to": "0x8"}}, res[modified]["balance"])
+	require.Equal(t, "=", res[modified]["nonce"])
+	require.Equal(t, "=", res[modified]["code"])
+	require.Equal(t, map[string]interface{}{
+		slot1.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.HexToHash("0x05").Hex(), "to": common.Hash{}.Hex()}},
+		slot2.Hex(): map[string]interface{}{"*": map[string]interface{}{"from": common.Hash{}.Hex(), "to": common.HexToHash("0x07").Hex()}},
+	}, res[modified]["storage"])
+
+	require.Equal(t, map[string]interface{}{"+": "0x2"}, res[created]["balance"])
+	require.Equal(t, map[string]interface{}{"+": "0x1"}, res[created]["nonce"])
+	require.Equal(t, map[string]interface{}{"+": "0x60016000"}, res[created]["code"])
+
+	require.Equal(t, map[string]interface{}{"-": "0x1"}, res[deleted]["balance"])
+	require.Equal(t, map[string]interface{}{"-": "0x0"}, res[deleted]["nonce"])
+	require.Equal(t, map[string]interface{}{"-": "0x6001"}, res[deleted]["code"])
+}
+
+func TestFilterArgsMatches(t *testing.T) {
+	var (
+		from    = common.HexToAddress("0x1000")
+		to      = common.HexToAddress("0x2000")
+		created = common.HexToAddress("0x3000")
+		other   = common.HexToAddress("0x4000")
+	)
+	call := &FlatTrace{Action: TraceAction{From: &from, To: &to}}
+	create := &FlatTrace{Action: TraceAction{From: &from}, Result: &TraceResult{Address: &created}}
+
+	testCases := []struct {
+		name     string
+		args     FilterArgs
+		trace    *FlatTrace
+		expMatch bool
+	}{
+		{"no filters", FilterArgs{}, call, true},
+		{"from address", FilterArgs{FromAddress: []common.Address{from}}, call, true},
+		{"other from address", FilterArgs{FromAddress: []common.Address{other}}, call, false},
+		{"to address", FilterArgs{ToAddress: []common.Address{other, to}}, call, true},
+		{"from and to addresses", FilterArgs{FromAddress: []common.Address{from}, ToAddress: []common.Address{other}}, call, false},
+		{"created contract address", FilterArgs{ToAddress: []common.Address{created}}, create, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.args.matches(tc.trace))
+		})
+	}
+}
@@ -34,33 +34,33 @@ func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 }
 
 // Content returns the transactions contained within the transaction pool
-func (api *PublicAPI) Content() (_ map[string]map[string]map[string]*types.RPCTransaction, err error) {
+func (api *PublicAPI) Content(ctx context.Context) (_ map[string]map[string]map[string]*types.RPCTransaction, err error) {
 	api.logger.Debug("txpool_content")
-	ctx, span := tracer.Start(context.Background(), "Content")
+	ctx, span := tracer.Start(ctx, "Content")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.Content(ctx)
 }
 
 // ContentFrom returns the transactions contained within the transaction pool
-func (api *PublicAPI) ContentFrom(address common.Address) (_ map[string]map[string]*types.RPCTransaction, err error) {
+func (api *PublicAPI) ContentFrom(ctx context.Context, address common.Address) (_ map[string]map[string]*types.RPCTransaction, err error) {
 	api.logger.Debug("txpool_contentFrom")
-	ctx, span := tracer.Start(context.Background(), "ContentFrom", trace.WithAttributes(attribute.String("address", address.Hex())))
+	ctx, span := tracer.Start(ctx, "ContentFrom", trace.WithAttributes(attribute.String("address", address.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.ContentFrom(ctx, address)
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an easily inspectable list
-func (api *PublicAPI) Inspect() (_ map[string]map[string]map[string]string, err error) {
+func (api *PublicAPI) Inspect(ctx context.Context) (_ map[string]map[string]map[string]string, err error) {
 	api.logger.Debug("txpool_inspect")
-	ctx, span := tracer.Start(context.Background(), "Inspect")
+	ctx, span := tracer.Start(ctx, "Inspect")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.Inspect(ctx)
 }
 
 // Status returns the number of pending and queued transaction in the pool
-func (api *PublicAPI) Status() (_ map[string]hexutil.Uint, err error) {
+func (api *PublicAPI) Status(ctx context.Context) (_ map[string]hexutil.Uint, err error) {
 	api.logger.Debug("txpool_status")
-	ctx, span := tracer.Start(context.Background(), "Status")
+	ctx, span := tracer.Start(ctx, "Status")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.Status(ctx)
 }
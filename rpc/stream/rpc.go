@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -30,6 +32,8 @@ const (
 	headerStreamCapacity    = 128 * 32
 	txStreamSegmentSize     = 1024
 	txStreamCapacity        = 1024 * 32
+	minedTxSegmentSize      = 1024
+	minedTxCapacity         = 1024 * 32
 	logStreamSegmentSize    = 2048
 	logStreamCapacity       = 2048 * 32
 )
@@ -50,22 +54,46 @@ type RPCHeader struct {
 	Hash      common.Hash
 }
 
-// RPCStream provides data streams for newHeads, logs, and pendingTransactions.
+// RPCStream provides data streams for newHeads, logs, pendingTransactions and
+// the transactions included in blocks.
 type RPCStream struct {
 	evtClient rpcclient.EventsClient
 	logger    log.Logger
 	txDecoder sdk.TxDecoder
 
-	// headerStream/logStream are backed by cometbft event subscription
-	headerStream *Stream[RPCHeader]
-	logStream    *Stream[*ethtypes.Log]
+	// headerStream/logStream/minedTxStream are backed by cometbft event subscription
+	headerStream  *Stream[RPCHeader]
+	logStream     *Stream[*ethtypes.Log]
+	minedTxStream *Stream[common.Hash]
 
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
 
+	// subscribedAt and lastBlockAt are the unix nano times of the subscription
+	// to the events and of the last block received, closed is set once the
+	// subscriptions end.
+	subscribedAt atomic.Int64
+	lastBlockAt  atomic.Int64
+	closed       atomic.Bool
+
 	wg sync.WaitGroup
 }
 
+// Status holds the liveness of the subscriptions to the CometBFT events backing
+// the streams.
+type Status struct {
+	// Subscribed is true once the streams are subscribed to the events, which
+	// happens on their first use.
+	Subscribed bool
+	// Closed is true if the subscriptions ended, e.g. after a connection loss
+	// to the node.
+	Closed bool
+	// SubscribedAt is the time of the subscription.
+	SubscribedAt time.Time
+	// LastBlockAt is the time the last block was received, zero if none was.
+	LastBlockAt time.Time
+}
+
 func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecoder sdk.TxDecoder) *RPCStream {
 	return &RPCStream{
 		evtClient:       evtClient,
@@ -83,6 +111,7 @@ func (s *RPCStream) initSubscriptions() {
 
 	s.headerStream = NewStream[RPCHeader](headerStreamSegmentSize, headerStreamCapacity)
 	s.logStream = NewStream[*ethtypes.Log](logStreamSegmentSize, logStreamCapacity)
+	s.minedTxStream = NewStream[common.Hash](minedTxSegmentSize, minedTxCapacity)
 
 	ctx := context.Background()
 
@@ -99,9 +128,23 @@ func (s *RPCStream) initSubscriptions() {
 		panic(err)
 	}
 
+	s.subscribedAt.Store(time.Now().UnixNano())
 	go s.start(&s.wg, chBlocks, chLogs)
 }
 
+// Status returns the liveness of the subscriptions backing the streams.
+func (s *RPCStream) Status() Status {
+	status := Status{Closed: s.closed.Load()}
+	if at := s.subscribedAt.Load(); at != 0 {
+		status.Subscribed = true
+		status.SubscribedAt = time.Unix(0, at)
+	}
+	if at := s.lastBlockAt.Load(); at != 0 {
+		status.LastBlockAt = time.Unix(0, at)
+	}
+	return status
+}
+
 func (s *RPCStream) Close() error {
 	if s.headerStream == nil {
 		// not initialized
@@ -129,6 +172,13 @@ func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	return s.logStream
 }
 
+// MinedTxStream returns the stream of the hashes of the Ethereum transactions
+// included in the committed blocks.
+func (s *RPCStream) MinedTxStream() *Stream[common.Hash] {
+	s.initSubscriptions()
+	return s.minedTxStream
+}
+
 // ListenPendingTx is a callback passed to application to listen for pending transactions in CheckTx.
 func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
@@ -141,6 +191,7 @@ func (s *RPCStream) start(
 ) {
 	wg.Add(1)
 	defer func() {
+		s.closed.Store(true)
 		wg.Done()
 		if err := s.evtClient.UnsubscribeAll(context.Background(), streamSubscriberName); err != nil {
 			s.logger.Error("failed to unsubscribe", "err", err)
@@ -165,6 +216,7 @@ func (s *RPCStream) start(
 			// TODO: After indexer improvement, we should get eth header event from indexer
 			// Currently, many fields are missing or incorrect (e.g. bloom, receiptsRoot, ...)
 			header := types.EthHeaderFromComet(data.Block.Header, ethtypes.Bloom{}, baseFee)
+			s.lastBlockAt.Store(time.Now().UnixNano())
 			s.headerStream.Add(RPCHeader{EthHeader: header, Hash: common.BytesToHash(data.BlockID.Hash)})
 
 		case ev, ok := <-chLogs:
@@ -173,11 +225,18 @@ func (s *RPCStream) start(
 				break
 			}
 
-			if _, ok := ev.Events[evmTxHashKey]; !ok {
+			txHashes, ok := ev.Events[evmTxHashKey]
+			if !ok {
 				// ignore transaction as it's not from the evm module
 				continue
 			}
 
+			minedTxs := make([]common.Hash, len(txHashes))
+			for i, txHash := range txHashes {
+				minedTxs[i] = common.HexToHash(txHash)
+			}
+			s.minedTxStream.Add(minedTxs...)
+
 			// get transaction result data
 			dataTx, ok := ev.Data.(cmttypes.EventDataTx)
 			if !ok {
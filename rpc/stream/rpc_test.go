@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// eventsClient is an events client serving the events sent to its channels.
+type eventsClient struct {
+	blocks chan coretypes.ResultEvent
+	txs    chan coretypes.ResultEvent
+}
+
+func newEventsClient() *eventsClient {
+	return &eventsClient{
+		blocks: make(chan coretypes.ResultEvent, 16),
+		txs:    make(chan coretypes.ResultEvent, 16),
+	}
+}
+
+func (c *eventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	if query == blockEvents {
+		return c.blocks, nil
+	}
+	return c.txs, nil
+}
+
+func (c *eventsClient) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *eventsClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+func TestMinedTxStream(t *testing.T) {
+	client := newEventsClient()
+	rpcStream := NewRPCStreams(client, log.NewNopLogger(), nil)
+	hashes := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}
+
+	_, offset := rpcStream.MinedTxStream().ReadNonBlocking(-1)
+	client.txs <- coretypes.ResultEvent{
+		Data:   cmttypes.EventDataTx{TxResult: abci.TxResult{Height: 1}},
+		Events: map[string][]string{evmTxHashKey: {hashes[0].Hex(), hashes[1].Hex()}},
+	}
+	// transactions of other modules are ignored
+	client.txs <- coretypes.ResultEvent{Data: cmttypes.EventDataTx{TxResult: abci.TxResult{Height: 1}}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	mined, _ := rpcStream.MinedTxStream().ReadBlocking(ctx, offset)
+	require.Equal(t, hashes, mined)
+}
+
+func TestStatus(t *testing.T) {
+	client := newEventsClient()
+	rpcStream := NewRPCStreams(client, log.NewNopLogger(), nil)
+	require.Equal(t, Status{}, rpcStream.Status())
+
+	_, offset := rpcStream.HeaderStream().ReadNonBlocking(-1)
+	status := rpcStream.Status()
+	require.True(t, status.Subscribed)
+	require.False(t, status.Closed)
+	require.True(t, status.LastBlockAt.IsZero())
+
+	client.blocks <- coretypes.ResultEvent{Data: cmttypes.EventDataNewBlock{
+		Block: &cmttypes.Block{Header: cmttypes.Header{Height: 1}},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, _ = rpcStream.HeaderStream().ReadBlocking(ctx, offset)
+	require.False(t, rpcStream.Status().LastBlockAt.IsZero())
+
+	// the status is closed once the subscriptions end
+	close(client.blocks)
+	close(client.txs)
+	require.Eventually(t, func() bool { return rpcStream.Status().Closed }, 5*time.Second, 10*time.Millisecond)
+}
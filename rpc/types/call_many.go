@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// Bundle is a list of calls executed sequentially by eth_callMany, all of them
+// sharing the same block header overrides.
+type Bundle struct {
+	Transactions  []evmtypes.TransactionArgs `json:"transactions"`
+	BlockOverride *BundleBlockOverrides      `json:"blockOverride"`
+}
+
+// BundleBlockOverrides is a set of header fields to override for the calls of
+// a bundle.
+type BundleBlockOverrides struct {
+	BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+	Coinbase    *common.Address `json:"coinbase"`
+	Timestamp   *hexutil.Uint64 `json:"timestamp"`
+	GasLimit    *hexutil.Uint64 `json:"gasLimit"`
+	BaseFee     *hexutil.Big    `json:"baseFee"`
+}
+
+// ToBlockOverrides converts the bundle overrides to the block overrides of the
+// simulated blocks.
+func (o *BundleBlockOverrides) ToBlockOverrides() *BlockOverrides {
+	if o == nil {
+		return &BlockOverrides{}
+	}
+	overrides := &BlockOverrides{
+		Time:          o.Timestamp,
+		GasLimit:      o.GasLimit,
+		FeeRecipient:  o.Coinbase,
+		BaseFeePerGas: o.BaseFee,
+	}
+	if o.BlockNumber != nil {
+		overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(uint64(*o.BlockNumber)))
+	}
+	return overrides
+}
+
+// StateContext is the position in the chain eth_callMany executes the bundles
+// at. A nil or negative transaction index executes them at the end of the
+// block, otherwise after the transactions of the block preceding the index.
+type StateContext struct {
+	BlockNumber      BlockNumberOrHash `json:"blockNumber"`
+	TransactionIndex *int              `json:"transactionIndex"`
+}
+
+// CallBundleArgs are the inputs to eth_callBundle.
+type CallBundleArgs struct {
+	Txs              []hexutil.Bytes   `json:"txs"`
+	BlockNumber      BlockNumber       `json:"blockNumber"`
+	StateBlockNumber BlockNumberOrHash `json:"stateBlockNumber"`
+	Coinbase         *common.Address   `json:"coinbase"`
+	Timestamp        *uint64           `json:"timestamp"`
+	GasLimit         *uint64           `json:"gasLimit"`
+	BaseFee          *big.Int          `json:"baseFee"`
+	// Timeout is the maximum duration in seconds of the execution of the bundle.
+	Timeout *int64 `json:"timeout"`
+}
+
+// BundleCallResult is the result of a call of a bundle, as returned by the
+// CallMany gRPC query.
+type BundleCallResult struct {
+	TxHash      common.Hash     `json:"txHash"`
+	From        common.Address  `json:"fromAddress"`
+	To          *common.Address `json:"toAddress"`
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	// GasPrice is the effective gas price of the call, which is paid to the
+	// validators in full.
+	GasPrice *hexutil.Big `json:"gasPrice"`
+	// EthSentToCoinbase is the balance increase of the coinbase during the
+	// call, excluding the gas fees.
+	EthSentToCoinbase *hexutil.Big `json:"ethSentToCoinbase"`
+	VMError           string       `json:"vmError,omitempty"`
+}
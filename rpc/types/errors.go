@@ -1,5 +1,43 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// TxSyncTimeoutErrorCode is the JSON-RPC error code of TxSyncTimeoutError, as
+// defined by EIP-7966.
+const TxSyncTimeoutErrorCode = 4
+
+// TxSyncTimeoutError is returned by eth_sendRawTransactionSync when the
+// submitted transaction isn't included in a block within the timeout. The
+// hash is returned as error data so that the client can keep tracking it.
+type TxSyncTimeoutError struct {
+	Hash    common.Hash
+	Timeout time.Duration
+}
+
+// NewTxSyncTimeoutError returns a new TxSyncTimeoutError.
+func NewTxSyncTimeoutError(hash common.Hash, timeout time.Duration) *TxSyncTimeoutError {
+	return &TxSyncTimeoutError{Hash: hash, Timeout: timeout}
+}
+
+// Error implements error.
+func (e *TxSyncTimeoutError) Error() string {
+	return fmt.Sprintf("the transaction was added to the transaction pool but wasn't processed in %dms", e.Timeout.Milliseconds())
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *TxSyncTimeoutError) ErrorCode() int {
+	return TxSyncTimeoutErrorCode
+}
+
+// ErrorData returns the hash of the transaction.
+func (e *TxSyncTimeoutError) ErrorData() interface{} {
+	return e.Hash.Hex()
+}
@@ -11,16 +11,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Auth and bank module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Auth      authtypes.QueryClient
+	Bank      banktypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -29,6 +34,8 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Auth:          authtypes.NewQueryClient(clientCtx),
+		Bank:          banktypes.NewQueryClient(clientCtx),
 	}
 }
 
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks (including the empty
+	// blocks used to fill number gaps) that can be simulated in a single
+	// eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default increment between the timestamps
+	// of two consecutive simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+var (
+	// SimulateTransferTopic is the topic of the ERC-20 compatible Transfer event
+	// used to represent native transfers: keccak256("Transfer(address,address,uint256)").
+	SimulateTransferTopic = common.HexToHash("ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	// SimulateTransferAddress is the pseudo address (ERC-7528) emitting the logs
+	// of the traced native transfers.
+	SimulateTransferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// state left by the previous blocks.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override for a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block, as returned by the
+// SimulateV1 gRPC query. The header fields that depend on the chain (parent
+// and block hashes) are filled in by the RPC backend.
+type SimBlockResult struct {
+	Number       hexutil.Uint64   `json:"number"`
+	Time         hexutil.Uint64   `json:"timestamp"`
+	GasLimit     hexutil.Uint64   `json:"gasLimit"`
+	GasUsed      hexutil.Uint64   `json:"gasUsed"`
+	BaseFee      *hexutil.Big     `json:"baseFeePerGas,omitempty"`
+	Coinbase     common.Address   `json:"miner"`
+	Transactions []hexutil.Bytes  `json:"transactions"`
+	Senders      []common.Address `json:"senders"`
+	Calls        []SimCallResult  `json:"calls"`
+}
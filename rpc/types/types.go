@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -37,6 +40,38 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key of the account
+}
+
+// StorageMap is the storage of an account, keyed by storage slot.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage slot of a StorageMap.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// Dump is the result of the debug_accountRange and debug_dumpBlock API calls,
+// the go-ethereum state dump with the storage of the accounts capped.
+type Dump struct {
+	Root     string                 `json:"root"`
+	Accounts map[string]DumpAccount `json:"accounts"`
+	Next     []byte                 `json:"next,omitempty"` // nil if Accounts includes the last account
+}
+
+// DumpAccount is an account of a Dump. If its storage is truncated, the
+// remaining slots can be retrieved with debug_storageRangeAt from
+// NextStorageKey.
+type DumpAccount struct {
+	state.DumpAccount
+	StorageTruncated bool         `json:"storageTruncated,omitempty"`
+	NextStorageKey   *common.Hash `json:"nextStorageKey,omitempty"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash           *common.Hash                    `json:"blockHash"`
@@ -189,3 +224,27 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// CosmosAccount is the Cosmos account of an address, returned by the cosmos
+// namespace.
+type CosmosAccount struct {
+	Address       string          `json:"address"`
+	EthAddress    common.Address  `json:"ethAddress"`
+	AccountNumber hexutil.Uint64  `json:"accountNumber"`
+	Sequence      hexutil.Uint64  `json:"sequence"`
+	PubKey        hexutil.Bytes   `json:"pubKey,omitempty"`
+	Vesting       *VestingAccount `json:"vesting,omitempty"`
+}
+
+// VestingAccount holds the vesting schedule of a vesting account. The vested
+// and vesting coins are computed at the time of the queried block.
+type VestingAccount struct {
+	Type             string    `json:"type"`
+	StartTime        int64     `json:"startTime"`
+	EndTime          int64     `json:"endTime"`
+	OriginalVesting  sdk.Coins `json:"originalVesting"`
+	DelegatedFree    sdk.Coins `json:"delegatedFree"`
+	DelegatedVesting sdk.Coins `json:"delegatedVesting"`
+	VestedCoins      sdk.Coins `json:"vestedCoins"`
+	VestingCoins     sdk.Coins `json:"vestingCoins"`
+}
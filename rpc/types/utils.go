@@ -127,13 +127,15 @@ func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Cont
 	return gasLimit, nil
 }
 
-// MakeHeader make initial ethereum header based on cometbft header.
+// MakeHeader make initial ethereum header based on cometbft header, with the
+// forks active in the given chain config.
 //
 // This method refers to chainMaker.makeHeader method of go-ethereum v1.16.3
 // (https://github.com/ethereum/go-ethereum/blob/d818a9af7bd5919808df78f31580f59382c53150/core/chain_makers.go#L596-L623)
 func MakeHeader(
 	cmtHeader cmttypes.Header, gasLimit int64,
 	validatorAddr common.Address, baseFee *big.Int,
+	config *ethparams.ChainConfig,
 ) *ethtypes.Header {
 	header := &ethtypes.Header{
 		Root:       common.BytesToHash(hexutil.Bytes(cmtHeader.AppHash)),
@@ -145,15 +147,15 @@ func MakeHeader(
 		Time:       uint64(cmtHeader.Time.UTC().Unix()), //nolint:gosec // G115 // timestamp won't exceed uint64
 	}
 
-	if evmtypes.GetEthChainConfig().IsLondon(header.Number) {
+	if config.IsLondon(header.Number) {
 		header.BaseFee = baseFee
 	}
-	if evmtypes.GetEthChainConfig().IsCancun(header.Number, header.Time) {
+	if config.IsCancun(header.Number, header.Time) {
 		header.ExcessBlobGas = new(uint64)
 		header.BlobGasUsed = new(uint64)
 		header.ParentBeaconRoot = new(common.Hash)
 	}
-	if evmtypes.GetEthChainConfig().IsPrague(header.Number, header.Time) {
+	if config.IsPrague(header.Number, header.Time) {
 		header.RequestsHash = &ethtypes.EmptyRequestsHash
 	}
 	return header
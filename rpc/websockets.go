@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -23,6 +24,8 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	evmmetrics "github.com/cosmos/evm/metrics"
+	"github.com/cosmos/evm/rpc/middleware"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/server/config"
@@ -34,6 +37,9 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// invalidRequestErrorCode is the error code of the invalid subscription requests
+	invalidRequestErrorCode = -32600
 )
 
 type WebsocketsServer interface {
@@ -73,12 +79,22 @@ type websocketsServer struct {
 	wsAddr         string // listen address of ws server
 	certFile       string
 	keyFile        string
-	allowedOrigins []string // allowed origins for WebSocket connections
+	allowedOrigins []string                  // allowed origins for WebSocket connections
+	access         *middleware.AccessControl // authentication and rate limits of the clients, nil if disabled
+	metrics        *middleware.RequestMetrics
 	api            *pubSubAPI
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	backend rpcfilters.Backend,
+	cfg *config.Config,
+	access *middleware.AccessControl,
+	metrics *middleware.RequestMetrics,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -86,7 +102,9 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		access:         access,
+		metrics:        metrics,
+		api:            newPubSubAPI(clientCtx, logger, stream, backend),
 		logger:         logger,
 	}
 }
@@ -94,6 +112,10 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	if s.access != nil {
+		// the API key can be set as the URL path
+		ws.Handle(middleware.APIKeyRoute, s)
+	}
 
 	go func() {
 		var err error
@@ -181,6 +203,15 @@ func (s *websocketsServer) checkOrigin(r *http.Request) bool {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var client *middleware.Client
+	if s.access != nil {
+		var err error
+		if client, err = s.access.Authenticate(r); err != nil {
+			middleware.WriteError(w, nil, err)
+			return
+		}
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: s.checkOrigin,
 	}
@@ -194,8 +225,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: client,
 	}
 
 	s.readLoop(ws)
@@ -205,7 +237,7 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(invalidRequestErrorCode),
 			Message: msg,
 		},
 		ID: nil,
@@ -214,9 +246,21 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// sendRejection sends the JSON-RPC error response of a message rejected by the
+// access control.
+func (s *websocketsServer) sendRejection(wsConn *wsConn, msg []byte, err error) {
+	var rejection *middleware.Error
+	if !errors.As(err, &rejection) {
+		s.sendErrResponse(wsConn, err.Error())
+		return
+	}
+	_ = wsConn.WriteJSON(json.RawMessage(middleware.ErrorResponse(msg, rejection))) // #nosec G703
+}
+
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   *websocket.Conn
+	mux    *sync.Mutex
+	client *middleware.Client // authenticated client, nil if the access control is disabled
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -259,6 +303,13 @@ readLoop:
 			return
 		}
 
+		if s.access != nil {
+			if err := s.access.AllowMessage(wsConn.client, mb); err != nil {
+				s.sendRejection(wsConn, mb, err)
+				continue
+			}
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -300,20 +351,26 @@ readLoop:
 			continue
 		}
 
+		// the subscriptions are served here, and the other requests are
+		// recorded by the HTTP server they are forwarded to
+		start := time.Now()
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.metrics.RecordRequest(method, nil, invalidRequestErrorCode, time.Since(start), false)
 				continue
 			}
 
 			subID := rpc.NewID()
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
+				s.metrics.RecordRequest(method, nil, invalidRequestErrorCode, time.Since(start), false)
 				s.sendErrResponse(wsConn, err.Error())
 				continue
 			}
 			subscriptions[subID] = unsubFn
+			s.metrics.RecordRequest(method, nil, 0, time.Since(start), false)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -328,11 +385,13 @@ readLoop:
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.metrics.RecordRequest(method, nil, invalidRequestErrorCode, time.Since(start), false)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
+				s.metrics.RecordRequest(method, nil, invalidRequestErrorCode, time.Since(start), false)
 				s.sendErrResponse(wsConn, "invalid parameters")
 				continue
 			}
@@ -343,6 +402,7 @@ readLoop:
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			s.metrics.RecordRequest(method, nil, 0, time.Since(start), false)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -388,6 +448,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.access != nil {
+		// the message was already checked by the access control
+		s.access.SetForwarded(req)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -415,15 +479,17 @@ type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
 	clientCtx client.Context
+	backend   rpcfilters.Backend
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, backend rpcfilters.Backend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    stream,
 		logger:    logger,
 		clientCtx: clientCtx,
+		backend:   backend,
 	}
 }
 
@@ -433,22 +499,41 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 		return nil, errors.New("invalid parameters")
 	}
 
+	var (
+		cancel context.CancelFunc
+		err    error
+	)
 	switch method {
 	case "newHeads":
 		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		cancel, err = api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			cancel, err = api.subscribeLogs(wsConn, subID, params[1])
+		} else {
+			cancel, err = api.subscribeLogs(wsConn, subID, nil)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		cancel, err = api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		cancel, err = api.subscribeSyncing(wsConn, subID)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the active subscriptions are counted until canceled
+	active := evmmetrics.RPCSubscriptions(method)
+	active.Inc(1)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			active.Dec(1)
+			cancel()
+		})
+	}, nil
 }
 
 func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
@@ -588,16 +673,22 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 				crit.Topics[topicIdx] = subtopicsCollect
 			}
 		}
-	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
-		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-		if len(logs) == 0 {
-			return nil
+		// pending logs are opted in with the "pending" block tag, either for
+		// the whole range or for its end only
+		pendingBlock := big.NewInt(rpc.PendingBlockNumber.Int64())
+		if params["fromBlock"] == rpc.PendingBlockNumber.String() {
+			crit.FromBlock = pendingBlock
+		}
+		if params["toBlock"] == rpc.PendingBlockNumber.String() {
+			crit.ToBlock = pendingBlock
 		}
+		if rpcfilters.IsPendingBlock(crit.FromBlock) && !rpcfilters.IsPendingBlock(crit.ToBlock) {
+			return nil, errors.New("invalid block range params")
+		}
+	}
 
+	writeLogs := func(logs []*ethtypes.Log) error {
 		for _, ethLog := range logs {
 			res := &SubscriptionNotification{
 				Jsonrpc: "2.0",
@@ -621,7 +712,37 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 			}
 		}
 		return nil
-	})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if !rpcfilters.IsPendingBlock(crit.FromBlock) {
+		//nolint: errcheck
+		go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
+			return writeLogs(rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics))
+		})
+	}
+
+	if rpcfilters.IsPendingBlock(crit.ToBlock) {
+		if api.backend == nil {
+			cancel()
+			return nil, errors.New("pending logs are not supported")
+		}
+
+		// the pending state is executed again whenever new transactions enter
+		// the mempool, and only the logs of the new transactions are sent
+		var seen map[common.Hash]struct{}
+		//nolint: errcheck
+		go api.events.PendingTxStream().Subscribe(ctx, func(_ []common.Hash, _ int) error {
+			logs, err := api.backend.PendingLogs(ctx)
+			if err != nil {
+				api.logger.Debug("failed to fetch pending logs", "error", err.Error())
+				return nil
+			}
+			logs = rpcfilters.FilterLogs(logs, nil, nil, crit.Addresses, crit.Topics)
+			logs, seen = rpcfilters.UnseenPendingLogs(logs, seen)
+			return writeLogs(logs)
+		})
+	}
 
 	return cancel, nil
 }
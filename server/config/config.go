@@ -1,10 +1,13 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/netip"
+	"os"
 	"path"
+	"strconv"
 	"time"
 
 	"github.com/spf13/viper"
@@ -55,6 +58,9 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
+	// DefaultEVMLiveTracer is the default live tracer, which disables live tracing
+	DefaultEVMLiveTracer = ""
+
 	// DefaultEnablePreimageRecording is the default value for EnablePreimageRecording
 	DefaultEnablePreimageRecording = false
 
@@ -100,6 +106,9 @@ const (
 	// DefaultHTTPIdleTimeout is the default idle timeout of the http json-rpc server
 	DefaultHTTPIdleTimeout = 120 * time.Second
 
+	// DefaultSlowRequestThreshold is the default serving time above which the JSON-RPC requests are logged
+	DefaultSlowRequestThreshold = 5 * time.Second
+
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
@@ -122,9 +131,87 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultEnableGraphQL toggles whether the GraphQL endpoint is served by the JSON-RPC server
+	DefaultEnableGraphQL = false
+
+	// DefaultEnableUnsafeAdmin toggles whether the `admin` namespace can be enabled
+	DefaultEnableUnsafeAdmin = false
+
+	// DefaultPendingLogsTxsCap is the default max number of pending transactions executed to build the pending logs
+	DefaultPendingLogsTxsCap = 500
+
+	// DefaultPendingLogsTimeout is the default timeout for the execution of the pending transactions
+	DefaultPendingLogsTimeout = 5 * time.Second
+
+	// DefaultBlockCacheSize is the default number of CometBFT blocks and block results cached by the backend
+	DefaultBlockCacheSize = 256
+
+	// DefaultRPCBlockCacheSize is the default number of Ethereum blocks and block receipts cached by the backend
+	DefaultRPCBlockCacheSize = 256
+
+	// DefaultReceiptCacheSize is the default number of transaction receipts cached by the backend
+	DefaultReceiptCacheSize = 2048
+
+	// DefaultTxSyncTimeout is the default time eth_sendRawTransactionSync waits for the receipt
+	DefaultTxSyncTimeout = 20 * time.Second
+
+	// DefaultTxSyncMaxTimeout is the max time eth_sendRawTransactionSync can be asked to wait for the receipt
+	DefaultTxSyncMaxTimeout = time.Minute
+
+	// DefaultIPCPermissions is the default file permissions of the IPC socket
+	DefaultIPCPermissions = "0600"
+
+	// DefaultGPOBlocks is the default number of latest blocks sampled by the gas price oracle
+	DefaultGPOBlocks = 20
+
+	// DefaultGPOPercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGPOPercentile = 60
+
+	// DefaultGPOMaxPrice is the default max gas tip cap suggested by the gas price oracle (500 gwei)
+	DefaultGPOMaxPrice = 500_000_000_000
+
+	// DefaultGPOIgnorePrice is the default gas tip below which the txs are ignored by the gas price oracle
+	DefaultGPOIgnorePrice = 2
+
+	// DefaultAPIKeyHeader is the default HTTP header holding the JSON-RPC API key
+	DefaultAPIKeyHeader = "X-API-Key"
+
+	// DefaultIPRate is the default number of request units refilled every second in the bucket of a client IP
+	DefaultIPRate = 50
+
+	// DefaultIPBurst is the default number of request units held by the bucket of a client IP
+	DefaultIPBurst = 100
+
+	// DefaultHealthMaxIndexerLag is the default max number of blocks the EVM indexer can lag behind
+	// the node while ready
+	DefaultHealthMaxIndexerLag = 10
+
+	// DefaultHealthMaxBlockAge is the default max time since the last block received by the event
+	// stream while ready
+	DefaultHealthMaxBlockAge = time.Minute
+
+	// DefaultTelemetryEndpoint is the default endpoint of the OTLP gRPC collector
+	DefaultTelemetryEndpoint = "127.0.0.1:4317"
+
+	// DefaultTelemetrySampleRatio is the default ratio of the traces sampled
+	DefaultTelemetrySampleRatio = 1.0
+
+	// DefaultTelemetryServiceName is the default service name of the exported spans
+	DefaultTelemetryServiceName = "cosmos-evm"
+)
+
+// Span exporters of the telemetry configuration.
+const (
+	TelemetryExporterOTLPGRPC = "otlp-grpc"
+	TelemetryExporterOTLPHTTP = "otlp-http"
+	TelemetryExporterFile     = "file"
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var (
+	evmTracers         = []string{"json", "markdown", "struct", "access_list"}
+	telemetryExporters = []string{TelemetryExporterOTLPGRPC, TelemetryExporterOTLPHTTP, TelemetryExporterFile}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -141,6 +228,11 @@ type EVMConfig struct {
 	// Tracer defines vm.Tracer type that the EVM will use if the node is run in
 	// trace mode. Default: 'json'.
 	Tracer string `mapstructure:"tracer"`
+	// LiveTracer defines the name of the live tracer streaming the execution of
+	// every block, e.g. 'callTracer', 'jsonl' or 'supply'. Empty disables it.
+	LiveTracer string `mapstructure:"live-tracer"`
+	// LiveTracerConfig defines the JSON configuration of the live tracer.
+	LiveTracerConfig string `mapstructure:"live-tracer-config"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
 	// Enables tracking of SHA3 preimages in the VM
@@ -153,6 +245,8 @@ type EVMConfig struct {
 	GethMetricsAddress string `mapstructure:"geth-metrics-address"`
 	// Mempool defines the EVM mempool configuration
 	Mempool MempoolConfig `mapstructure:"mempool"`
+	// Telemetry defines the OpenTelemetry trace exporter configuration
+	Telemetry TelemetryConfig `mapstructure:"telemetry"`
 }
 
 // MempoolConfig defines the configuration for the EVM mempool transaction pool.
@@ -212,6 +306,49 @@ func (c MempoolConfig) Validate() error {
 	return nil
 }
 
+// TelemetryConfig defines the export of the OpenTelemetry spans of the node.
+type TelemetryConfig struct {
+	// Exporter defines the span exporter: 'otlp-grpc', 'otlp-http' or 'file'. Empty disables the export.
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint defines the host:port of the OTLP collector, or the path of the file the spans are
+	// written to, relative to the node home if not absolute.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables the TLS of the connection to the OTLP collector.
+	Insecure bool `mapstructure:"insecure"`
+	// SampleRatio defines the ratio of the traces sampled, between 0 and 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+	// ServiceName defines the service name of the exported spans.
+	ServiceName string `mapstructure:"service-name"`
+}
+
+// DefaultTelemetryConfig returns the default telemetry configuration, with the export disabled.
+func DefaultTelemetryConfig() TelemetryConfig {
+	return TelemetryConfig{
+		Exporter:    "",
+		Endpoint:    DefaultTelemetryEndpoint,
+		Insecure:    false,
+		SampleRatio: DefaultTelemetrySampleRatio,
+		ServiceName: DefaultTelemetryServiceName,
+	}
+}
+
+// Validate returns an error if the telemetry configuration is invalid.
+func (c TelemetryConfig) Validate() error {
+	if c.Exporter != "" && !strings.StringInSlice(c.Exporter, telemetryExporters) {
+		return fmt.Errorf("invalid exporter %s, available exporters: %v", c.Exporter, telemetryExporters)
+	}
+	if c.Exporter != "" && c.Endpoint == "" {
+		return errors.New("endpoint cannot be empty")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio must be between 0 and 1, got %f", c.SampleRatio)
+	}
+	if c.Exporter != "" && c.ServiceName == "" {
+		return errors.New("service name cannot be empty")
+	}
+	return nil
+}
+
 // JSONRPCConfig defines configuration for the EVM RPC server.
 type JSONRPCConfig struct {
 	// API defines a list of JSON-RPC namespaces that should be enabled
@@ -220,6 +357,13 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// IPCPath defines the Unix socket of the IPC endpoint, relative to the node home if not absolute.
+	// The IPC endpoint is disabled when empty.
+	IPCPath string `mapstructure:"ipc-path"`
+	// IPCPermissions defines the file permissions of the IPC socket, in octal
+	IPCPermissions string `mapstructure:"ipc-permissions"`
+	// IPCAPI defines a list of JSON-RPC namespaces that should be enabled on the IPC endpoint
+	IPCAPI []string `mapstructure:"ipc-api"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
@@ -242,6 +386,9 @@ type JSONRPCConfig struct {
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// SlowRequestThreshold is the serving time above which the JSON-RPC requests are logged with their
+	// redacted params (0=disabled).
+	SlowRequestThreshold time.Duration `mapstructure:"slow-request-threshold"`
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
@@ -260,6 +407,106 @@ type JSONRPCConfig struct {
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableGraphQL enables the EIP-1767 GraphQL endpoint at the /graphql path of the JSON-RPC server.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// EnableUnsafeAdmin allows the `admin` namespace, which manages the CometBFT peers of the node, to be
+	// enabled in the IPC API namespaces. It is only served over IPC.
+	EnableUnsafeAdmin bool `mapstructure:"enable-unsafe-admin"`
+	// PendingLogsTxsCap is the max number of pending transactions executed on top of the latest state to
+	// build the logs of the pending block. Pending logs are disabled when set to 0.
+	PendingLogsTxsCap int `mapstructure:"pending-logs-txs-cap"`
+	// PendingLogsTimeout is the timeout for the execution of the pending transactions.
+	PendingLogsTimeout time.Duration `mapstructure:"pending-logs-timeout"`
+	// BlockCacheSize is the number of finalized CometBFT blocks and block results cached in memory.
+	// The cache is disabled when set to 0.
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// RPCBlockCacheSize is the number of Ethereum blocks and block receipts, as returned by the
+	// JSON-RPC server, cached in memory. The cache is disabled when set to 0.
+	RPCBlockCacheSize int `mapstructure:"rpc-block-cache-size"`
+	// ReceiptCacheSize is the number of transaction receipts cached in memory. The cache is disabled
+	// when set to 0.
+	ReceiptCacheSize int `mapstructure:"receipt-cache-size"`
+	// TxSyncTimeout is the time `eth_sendRawTransactionSync` waits for the receipt when the request
+	// doesn't set a timeout.
+	TxSyncTimeout time.Duration `mapstructure:"tx-sync-timeout"`
+	// TxSyncMaxTimeout is the max time `eth_sendRawTransactionSync` waits for the receipt, whatever
+	// the timeout set in the request. It's unbounded when set to 0, which requires a TxSyncTimeout.
+	TxSyncMaxTimeout time.Duration `mapstructure:"tx-sync-max-timeout"`
+	// GasPriceOracle defines the sampling of the gas tip caps suggested by the JSON-RPC server
+	GasPriceOracle GasPriceOracleConfig `mapstructure:"gas-price-oracle"`
+	// Health defines the health and readiness endpoints of the JSON-RPC server
+	Health HealthConfig `mapstructure:"health"`
+	// AccessControl defines the API-key authentication and the rate limits of the JSON-RPC server
+	AccessControl AccessControlConfig `mapstructure:"access-control"`
+}
+
+// GasPriceOracleConfig defines the gas price oracle of the JSON-RPC server, which suggests the
+// gas tip caps from the effective tips paid by the transactions of the latest blocks.
+type GasPriceOracleConfig struct {
+	// Blocks is the number of latest blocks sampled
+	Blocks int `mapstructure:"blocks"`
+	// Percentile is the percentile of the sampled tips suggested, from 0 to 100
+	Percentile int `mapstructure:"percentile"`
+	// MaxPrice is the max suggested gas tip cap in wei (0=unlimited)
+	MaxPrice uint64 `mapstructure:"max-price"`
+	// IgnorePrice is the gas tip in wei below which the transactions are not sampled
+	IgnorePrice uint64 `mapstructure:"ignore-price"`
+}
+
+// HealthConfig defines the /health and /ready endpoints of the JSON-RPC HTTP server, and the
+// thresholds of their checks. A node is healthy while it reaches CometBFT and its event stream is
+// subscribed, and ready when none of the checks fails.
+type HealthConfig struct {
+	// Enable serves the endpoints
+	Enable bool `mapstructure:"enable"`
+	// MaxIndexerLag is the max number of blocks the EVM indexer can lag behind the node
+	MaxIndexerLag int64 `mapstructure:"max-indexer-lag"`
+	// MaxBlockAge is the max time since the last block received by the event stream (0=unchecked)
+	MaxBlockAge time.Duration `mapstructure:"max-block-age"`
+}
+
+// AccessControlConfig defines the API-key authentication and the token bucket rate limits of the
+// JSON-RPC server. The buckets hold request units, and every request consumes the cost of its
+// methods. Requests with an API key are limited by the bucket of the key, the others by the bucket
+// of their client IP.
+type AccessControlConfig struct {
+	// Enable enables the API-key authentication and the rate limits
+	Enable bool `mapstructure:"enable"`
+	// RequireAPIKey rejects the requests without a valid API key
+	RequireAPIKey bool `mapstructure:"require-api-key"`
+	// APIKeyHeader is the HTTP header holding the API key. The key can also be set as the URL path.
+	APIKeyHeader string `mapstructure:"api-key-header"`
+	// IPRate is the number of request units refilled every second in the bucket of a client IP (0=unlimited)
+	IPRate float64 `mapstructure:"ip-rate"`
+	// IPBurst is the number of request units held by the bucket of a client IP
+	IPBurst int `mapstructure:"ip-burst"`
+	// CostClasses assigns costs to the methods, which cost one request unit otherwise
+	CostClasses []CostClassConfig `mapstructure:"cost-classes"`
+	// APIKeys defines the API keys accepted by the server
+	APIKeys []APIKeyConfig `mapstructure:"api-keys"`
+}
+
+// CostClassConfig defines the cost in request units of a class of methods.
+type CostClassConfig struct {
+	// Name is the name of the class
+	Name string `mapstructure:"name"`
+	// Cost is the number of request units consumed by a call to the methods of the class
+	Cost int `mapstructure:"cost"`
+	// Methods are the methods of the class. A trailing '*' matches any method with the prefix, e.g. 'debug_trace*'
+	Methods []string `mapstructure:"methods"`
+}
+
+// APIKeyConfig defines an API key, along with its rate limit and allowed namespaces.
+type APIKeyConfig struct {
+	// Key is the API key
+	Key string `mapstructure:"key"`
+	// Rate is the number of request units refilled every second in the bucket of the key (0=unlimited)
+	Rate float64 `mapstructure:"rate"`
+	// Burst is the number of request units held by the bucket of the key
+	Burst int `mapstructure:"burst"`
+	// Namespaces are the JSON-RPC namespaces allowed for the key, all the enabled ones if empty.
+	// The graphql namespace allows the GraphQL API.
+	Namespaces []string `mapstructure:"namespaces"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -274,12 +521,14 @@ type TLSConfig struct {
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:                  DefaultEVMTracer,
+		LiveTracer:              DefaultEVMLiveTracer,
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
 		MinTip:                  DefaultEVMMinTip,
 		GethMetricsAddress:      DefaultGethMetricsAddress,
 		Mempool:                 DefaultMempoolConfig(),
+		Telemetry:               DefaultTelemetryConfig(),
 	}
 }
 
@@ -289,6 +538,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.LiveTracerConfig != "" && !json.Valid([]byte(c.LiveTracerConfig)) {
+		return fmt.Errorf("invalid live tracer config %q: not a valid JSON", c.LiveTracerConfig)
+	}
+
 	if _, err := netip.ParseAddrPort(c.GethMetricsAddress); err != nil {
 		return fmt.Errorf("invalid geth metrics address %q: %w", c.GethMetricsAddress, err)
 	}
@@ -297,6 +550,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid mempool config: %w", err)
 	}
 
+	if err := c.Telemetry.Validate(); err != nil {
+		return fmt.Errorf("invalid telemetry config: %w", err)
+	}
+
 	return nil
 }
 
@@ -307,7 +564,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "cosmos", "admin"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -322,6 +579,9 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		API:                  GetDefaultAPINamespaces(),
 		Address:              DefaultJSONRPCAddress,
 		WsAddress:            DefaultJSONRPCWsAddress,
+		IPCPath:              "",
+		IPCPermissions:       DefaultIPCPermissions,
+		IPCAPI:               GetDefaultAPINamespaces(),
 		GasCap:               DefaultGasCap,
 		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:           DefaultEVMTimeout,
@@ -332,6 +592,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		LogsCap:              DefaultLogsCap,
 		HTTPTimeout:          DefaultHTTPTimeout,
 		HTTPIdleTimeout:      DefaultHTTPIdleTimeout,
+		SlowRequestThreshold: DefaultSlowRequestThreshold,
 		AllowUnprotectedTxs:  DefaultAllowUnprotectedTxs,
 		BatchRequestLimit:    DefaultBatchRequestLimit,
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
@@ -340,6 +601,18 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		EnableGraphQL:        DefaultEnableGraphQL,
+		EnableUnsafeAdmin:    DefaultEnableUnsafeAdmin,
+		PendingLogsTxsCap:    DefaultPendingLogsTxsCap,
+		PendingLogsTimeout:   DefaultPendingLogsTimeout,
+		BlockCacheSize:       DefaultBlockCacheSize,
+		RPCBlockCacheSize:    DefaultRPCBlockCacheSize,
+		ReceiptCacheSize:     DefaultReceiptCacheSize,
+		TxSyncTimeout:        DefaultTxSyncTimeout,
+		TxSyncMaxTimeout:     DefaultTxSyncMaxTimeout,
+		GasPriceOracle:       DefaultGasPriceOracleConfig(),
+		Health:               DefaultHealthConfig(),
+		AccessControl:        DefaultAccessControlConfig(),
 	}
 }
 
@@ -381,6 +654,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.SlowRequestThreshold < 0 {
+		return errors.New("JSON-RPC slow request threshold cannot be negative")
+	}
+
 	if c.BatchRequestLimit < 0 {
 		return errors.New("JSON-RPC batch request limit cannot be negative")
 	}
@@ -389,16 +666,222 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.PendingLogsTxsCap < 0 {
+		return errors.New("JSON-RPC pending logs txs cap cannot be negative")
+	}
+
+	if c.PendingLogsTimeout < 0 {
+		return errors.New("JSON-RPC pending logs timeout duration cannot be negative")
+	}
+
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
+	if c.RPCBlockCacheSize < 0 {
+		return errors.New("JSON-RPC rpc block cache size cannot be negative")
+	}
+
+	if c.ReceiptCacheSize < 0 {
+		return errors.New("JSON-RPC receipt cache size cannot be negative")
+	}
+
+	if c.TxSyncTimeout < 0 {
+		return errors.New("JSON-RPC tx sync timeout duration cannot be negative")
+	}
+
+	if c.TxSyncMaxTimeout < 0 {
+		return errors.New("JSON-RPC tx sync max timeout duration cannot be negative")
+	}
+
+	if c.TxSyncTimeout == 0 && c.TxSyncMaxTimeout == 0 {
+		return errors.New("JSON-RPC tx sync timeout and max timeout cannot both be unbounded")
+	}
+
+	if c.IPCPath != "" {
+		if _, err := c.IPCFileMode(); err != nil {
+			return err
+		}
+		if len(c.IPCAPI) == 0 {
+			return errors.New("cannot enable the JSON-RPC IPC endpoint without defining any API namespace")
+		}
+	}
+
+	if err := c.GasPriceOracle.Validate(); err != nil {
+		return fmt.Errorf("JSON-RPC gas price oracle: %w", err)
+	}
+
+	if err := c.Health.Validate(); err != nil {
+		return fmt.Errorf("JSON-RPC health: %w", err)
+	}
+
+	if err := c.AccessControl.Validate(); err != nil {
+		return fmt.Errorf("JSON-RPC access control: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
 		if seenAPIs[api] {
 			return fmt.Errorf("repeated API namespace '%s'", api)
 		}
+		if api == "admin" {
+			return errors.New("the admin namespace is only served over IPC, it must be enabled in the IPC API namespaces")
+		}
 
 		seenAPIs[api] = true
 	}
 
+	seenIPCAPIs := make(map[string]bool)
+	for _, api := range c.IPCAPI {
+		if seenIPCAPIs[api] {
+			return fmt.Errorf("repeated IPC API namespace '%s'", api)
+		}
+
+		seenIPCAPIs[api] = true
+	}
+
+	return nil
+}
+
+// IPCFileMode returns the file permissions of the IPC socket.
+func (c JSONRPCConfig) IPCFileMode() (os.FileMode, error) {
+	perm, err := strconv.ParseUint(c.IPCPermissions, 8, 32)
+	if err != nil || perm > 0o777 {
+		return 0, fmt.Errorf("invalid JSON-RPC IPC permissions %q, expected octal permissions, e.g. '0600'", c.IPCPermissions)
+	}
+	return os.FileMode(perm), nil
+}
+
+// DefaultGasPriceOracleConfig returns the default gas price oracle configuration.
+func DefaultGasPriceOracleConfig() GasPriceOracleConfig {
+	return GasPriceOracleConfig{
+		Blocks:      DefaultGPOBlocks,
+		Percentile:  DefaultGPOPercentile,
+		MaxPrice:    DefaultGPOMaxPrice,
+		IgnorePrice: DefaultGPOIgnorePrice,
+	}
+}
+
+// Validate returns an error if the gas price oracle configuration is invalid.
+func (c GasPriceOracleConfig) Validate() error {
+	if c.Blocks < 1 {
+		return fmt.Errorf("blocks must be at least 1, got %d", c.Blocks)
+	}
+	if c.Percentile < 0 || c.Percentile > 100 {
+		return fmt.Errorf("percentile must be between 0 and 100, got %d", c.Percentile)
+	}
+	return nil
+}
+
+// DefaultHealthConfig returns the default health endpoints configuration, which is enabled.
+func DefaultHealthConfig() HealthConfig {
+	return HealthConfig{
+		Enable:        true,
+		MaxIndexerLag: DefaultHealthMaxIndexerLag,
+		MaxBlockAge:   DefaultHealthMaxBlockAge,
+	}
+}
+
+// Validate returns an error if the health endpoints configuration is invalid.
+func (c HealthConfig) Validate() error {
+	if c.MaxIndexerLag < 0 {
+		return fmt.Errorf("max indexer lag cannot be negative, got %d", c.MaxIndexerLag)
+	}
+	if c.MaxBlockAge < 0 {
+		return fmt.Errorf("max block age cannot be negative, got %s", c.MaxBlockAge)
+	}
+	return nil
+}
+
+// DefaultAccessControlConfig returns the default access control configuration, which is disabled.
+// The tracing methods, the methods executing transactions and the GraphQL queries cost more than
+// the other ones.
+func DefaultAccessControlConfig() AccessControlConfig {
+	return AccessControlConfig{
+		Enable:        false,
+		RequireAPIKey: false,
+		APIKeyHeader:  DefaultAPIKeyHeader,
+		IPRate:        DefaultIPRate,
+		IPBurst:       DefaultIPBurst,
+		CostClasses: []CostClassConfig{
+			{
+				Name: "tracing",
+				Cost: 20,
+				Methods: []string{
+					"debug_trace*", "debug_storageRangeAt", "debug_accountRange", "debug_dumpBlock", "trace_*",
+					"ots_traceTransaction", "ots_getInternalOperations", "ots_getTransactionError", "ots_searchTransactions*",
+				},
+			},
+			{
+				Name: "execution",
+				Cost: 5,
+				Methods: []string{
+					"eth_call", "eth_estimateGas", "eth_createAccessList", "eth_simulateV1", "eth_callMany", "eth_callBundle",
+					"eth_getLogs",
+					"eth_getBlockReceipts", "eth_feeHistory", "eth_sendRawTransactionSync",
+				},
+			},
+			{
+				Name:    "graphql",
+				Cost:    5,
+				Methods: []string{"graphql"},
+			},
+		},
+		APIKeys: []APIKeyConfig{},
+	}
+}
+
+// Validate returns an error if the access control configuration is invalid.
+func (c AccessControlConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.APIKeyHeader == "" {
+		return errors.New("api key header cannot be empty")
+	}
+
+	maxCost := 1
+	seenClasses := make(map[string]bool)
+	for _, class := range c.CostClasses {
+		if seenClasses[class.Name] {
+			return fmt.Errorf("repeated cost class '%s'", class.Name)
+		}
+		seenClasses[class.Name] = true
+		if class.Cost < 1 {
+			return fmt.Errorf("cost of class '%s' must be at least 1, got %d", class.Name, class.Cost)
+		}
+		maxCost = max(maxCost, class.Cost)
+	}
+
+	if err := validateBucket(c.IPRate, c.IPBurst, maxCost); err != nil {
+		return fmt.Errorf("ip bucket: %w", err)
+	}
+	seenKeys := make(map[string]bool)
+	for i, key := range c.APIKeys {
+		if key.Key == "" {
+			return fmt.Errorf("api key %d cannot be empty", i)
+		}
+		if seenKeys[key.Key] {
+			return fmt.Errorf("repeated api key %d", i)
+		}
+		seenKeys[key.Key] = true
+		if err := validateBucket(key.Rate, key.Burst, maxCost); err != nil {
+			return fmt.Errorf("bucket of api key %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateBucket returns an error if a rate limited bucket can't hold the most
+// expensive method.
+func validateBucket(rate float64, burst, maxCost int) error {
+	if rate < 0 {
+		return fmt.Errorf("rate cannot be negative, got %f", rate)
+	}
+	if rate > 0 && burst < maxCost {
+		return fmt.Errorf("burst must be at least the max method cost %d, got %d", maxCost, burst)
+	}
 	return nil
 }
 
@@ -1,9 +1,13 @@
 package config_test
 
 import (
+	"bytes"
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
+	"text/template"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -69,3 +73,97 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessControlConfigTemplate(t *testing.T) {
+	cfg := serverconfig.DefaultConfig()
+	cfg.JSONRPC.AccessControl.Enable = true
+	cfg.JSONRPC.AccessControl.APIKeys = []serverconfig.APIKeyConfig{
+		{Key: "key1", Rate: 100, Burst: 200, Namespaces: []string{"eth", "net"}},
+		{Key: "key2", Namespaces: []string{}},
+	}
+
+	tmpl, err := template.New("evm").Parse(serverconfig.DefaultEVMConfigTemplate)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	got, err := serverconfig.GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.JSONRPC.AccessControl, got.JSONRPC.AccessControl)
+	require.NoError(t, got.JSONRPC.AccessControl.Validate())
+
+	// the burst must fit the most expensive request
+	got.JSONRPC.AccessControl.APIKeys[0].Burst = 10
+	require.Error(t, got.JSONRPC.AccessControl.Validate())
+}
+
+func TestTxSyncTimeouts(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timeout, maxTime time.Duration
+		expErr           bool
+	}{
+		{"defaults", serverconfig.DefaultTxSyncTimeout, serverconfig.DefaultTxSyncMaxTimeout, false},
+		{"unbounded max timeout", time.Second, 0, false},
+		{"no default timeout", 0, time.Second, false},
+		{"unbounded", 0, 0, true},
+		{"negative timeout", -time.Second, time.Second, true},
+		{"negative max timeout", time.Second, -time.Second, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			cfg.TxSyncTimeout = tc.timeout
+			cfg.TxSyncMaxTimeout = tc.maxTime
+			if tc.expErr {
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestIPCFileMode(t *testing.T) {
+	testCases := []struct {
+		perm    string
+		expMode os.FileMode
+		expErr  bool
+	}{
+		{"0600", 0o600, false},
+		{"660", 0o660, false},
+		{"0777", 0o777, false},
+		{"1777", 0, true},
+		{"0800", 0, true},
+		{"", 0, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.perm, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			cfg.IPCPath = "evm.ipc"
+			cfg.IPCPermissions = tc.perm
+			mode, err := cfg.IPCFileMode()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMode, mode)
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestAdminNamespaceOverIPCOnly(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.IPCPath = "evm.ipc"
+	cfg.IPCAPI = append(cfg.IPCAPI, "admin")
+	require.NoError(t, cfg.Validate())
+
+	cfg.API = append(cfg.API, "admin")
+	require.ErrorContains(t, cfg.Validate(), "only served over IPC")
+}
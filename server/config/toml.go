@@ -13,6 +13,15 @@ const DefaultEVMConfigTemplate = `
 # Valid types are: json|struct|access_list|markdown
 tracer = "{{ .EVM.Tracer }}"
 
+# LiveTracer defines the live tracer streaming the execution of every block as the chain
+# executes it, e.g. 'callTracer', 'jsonl' or 'supply'. Leave it empty to disable live tracing.
+live-tracer = "{{ .EVM.LiveTracer }}"
+
+# LiveTracerConfig defines the JSON configuration of the live tracer. The output is written
+# to rotating files in a directory, e.g. '{"path": "/tmp/traces", "maxSize": 100}', or to a
+# registered in-process consumer, e.g. '{"consumer": "archive"}'.
+live-tracer-config = '{{ .EVM.LiveTracerConfig }}'
+
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
@@ -52,6 +61,25 @@ global-queue = {{ .EVM.Mempool.GlobalQueue }}
 # Lifetime is the maximum amount of time non-executable transaction are queued
 lifetime = "{{ .EVM.Mempool.Lifetime }}"
 
+# Export of the OpenTelemetry spans of the node
+[evm.telemetry]
+
+# Exporter defines the span exporter: 'otlp-grpc', 'otlp-http' or 'file'. Empty disables the export.
+exporter = "{{ .EVM.Telemetry.Exporter }}"
+
+# Endpoint defines the host:port of the OTLP collector, or the path of the file the spans are
+# written to, relative to the node home if not absolute.
+endpoint = "{{ .EVM.Telemetry.Endpoint }}"
+
+# Insecure disables the TLS of the connection to the OTLP collector.
+insecure = {{ .EVM.Telemetry.Insecure }}
+
+# SampleRatio defines the ratio of the traces sampled, between 0 and 1.
+sample-ratio = {{ .EVM.Telemetry.SampleRatio }}
+
+# ServiceName defines the service name of the exported spans.
+service-name = "{{ .EVM.Telemetry.ServiceName }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -75,6 +103,17 @@ ws-origins = [{{range $index, $elmt := .JSONRPC.WSOrigins}}{{if $index}}, {{end}
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
+# IPCPath defines the Unix socket of the IPC endpoint, relative to the node home if not absolute,
+# e.g. "evm.ipc". The IPC endpoint is disabled when empty.
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# IPCPermissions defines the file permissions of the IPC socket, in octal.
+ipc-permissions = "{{ .JSONRPC.IPCPermissions }}"
+
+# IPCAPI defines a list of JSON-RPC namespaces that should be enabled on the IPC endpoint
+# Example: "eth,txpool,personal,net,debug,web3"
+ipc-api = "{{range $index, $elmt := .JSONRPC.IPCAPI}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
 gas-cap = {{ .JSONRPC.GasCap }}
 
@@ -105,6 +144,10 @@ http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# SlowRequestThreshold is the serving time above which the JSON-RPC requests are logged with their
+# redacted params (0=disabled).
+slow-request-threshold = "{{ .JSONRPC.SlowRequestThreshold }}"
+
 # AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
@@ -129,6 +172,112 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# EnableGraphQL serves the EIP-1767 GraphQL API at the /graphql path of the JSON-RPC server.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# EnableUnsafeAdmin allows the 'admin' namespace, which manages the CometBFT peers of the node, to be
+# enabled in the ipc-api list. It can't be enabled in the api list, as it is only served over IPC.
+enable-unsafe-admin = {{ .JSONRPC.EnableUnsafeAdmin }}
+
+# PendingLogsTxsCap is the max number of pending transactions executed on top of the latest state
+# to serve the logs of the 'pending' block. Pending logs are disabled when set to 0.
+pending-logs-txs-cap = {{ .JSONRPC.PendingLogsTxsCap }}
+
+# PendingLogsTimeout is the timeout for the execution of the pending transactions (0=infinite).
+pending-logs-timeout = "{{ .JSONRPC.PendingLogsTimeout }}"
+
+# BlockCacheSize is the number of finalized CometBFT blocks and block results cached in memory (0=disabled).
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
+# RPCBlockCacheSize is the number of Ethereum blocks and block receipts cached in memory (0=disabled).
+rpc-block-cache-size = {{ .JSONRPC.RPCBlockCacheSize }}
+
+# ReceiptCacheSize is the number of transaction receipts cached in memory (0=disabled).
+receipt-cache-size = {{ .JSONRPC.ReceiptCacheSize }}
+
+# TxSyncTimeout is the time eth_sendRawTransactionSync waits for the receipt when the request doesn't set one.
+tx-sync-timeout = "{{ .JSONRPC.TxSyncTimeout }}"
+
+# TxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the receipt (0=unbounded, requiring a
+# tx-sync-timeout).
+tx-sync-max-timeout = "{{ .JSONRPC.TxSyncMaxTimeout }}"
+
+[json-rpc.gas-price-oracle]
+
+# Blocks is the number of latest blocks whose effective tips are sampled by the gas price oracle,
+# which suggests the gas tip caps of eth_maxPriorityFeePerGas and eth_gasPrice.
+blocks = {{ .JSONRPC.GasPriceOracle.Blocks }}
+
+# Percentile is the percentile of the sampled tips suggested, from 0 to 100.
+percentile = {{ .JSONRPC.GasPriceOracle.Percentile }}
+
+# MaxPrice is the max suggested gas tip cap in wei (0=unlimited).
+max-price = {{ .JSONRPC.GasPriceOracle.MaxPrice }}
+
+# IgnorePrice is the gas tip in wei below which the transactions are not sampled.
+ignore-price = {{ .JSONRPC.GasPriceOracle.IgnorePrice }}
+
+[json-rpc.health]
+
+# Enable serves the /health and /ready endpoints on the JSON-RPC HTTP server. A node is healthy while
+# it reaches CometBFT and its event stream is subscribed, and ready when it is also synced, its EVM
+# indexer and event stream are up to date and its mempool is available.
+enable = {{ .JSONRPC.Health.Enable }}
+
+# MaxIndexerLag is the max number of blocks the EVM indexer can lag behind the node while ready.
+max-indexer-lag = {{ .JSONRPC.Health.MaxIndexerLag }}
+
+# MaxBlockAge is the max time since the last block received by the event stream while ready (0=unchecked).
+max-block-age = "{{ .JSONRPC.Health.MaxBlockAge }}"
+
+[json-rpc.access-control]
+
+# Enable enables the API-key authentication and the token bucket rate limits of the JSON-RPC server.
+# The buckets hold request units, and every request consumes the cost of its methods. Requests with
+# an API key are limited by the bucket of the key, the others by the bucket of their client IP.
+enable = {{ .JSONRPC.AccessControl.Enable }}
+
+# RequireAPIKey rejects the requests without a valid API key.
+require-api-key = {{ .JSONRPC.AccessControl.RequireAPIKey }}
+
+# APIKeyHeader is the HTTP header holding the API key. The key can also be set as the URL path,
+# e.g. http://127.0.0.1:8545/<key>.
+api-key-header = "{{ .JSONRPC.AccessControl.APIKeyHeader }}"
+
+# IPRate is the number of request units refilled every second in the bucket of a client IP (0=unlimited).
+ip-rate = {{ .JSONRPC.AccessControl.IPRate }}
+
+# IPBurst is the number of request units held by the bucket of a client IP.
+ip-burst = {{ .JSONRPC.AccessControl.IPBurst }}
+
+# CostClasses assign costs in request units to the methods, which cost one unit otherwise.
+# A trailing '*' matches any method with the prefix, and the "graphql" method is charged for the GraphQL queries.
+{{- range .JSONRPC.AccessControl.CostClasses }}
+
+[[json-rpc.access-control.cost-classes]]
+name = "{{ .Name }}"
+cost = {{ .Cost }}
+methods = [{{range $index, $elmt := .Methods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+{{- end }}
+
+# APIKeys defines the accepted API keys, along with the rate (0=unlimited) and the burst of their
+# bucket and their allowed namespaces (all the enabled ones if empty, "graphql" allowing the
+# GraphQL API), e.g.
+#
+# [[json-rpc.access-control.api-keys]]
+# key = "my-secret-key"
+# rate = 200
+# burst = 400
+# namespaces = ["eth", "net", "web3"]
+{{- range .JSONRPC.AccessControl.APIKeys }}
+
+[[json-rpc.access-control.api-keys]]
+key = "{{ .Key }}"
+rate = {{ .Rate }}
+burst = {{ .Burst }}
+namespaces = [{{range $index, $elmt := .Namespaces}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+{{- end }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -29,6 +29,13 @@ const (
 	GRPCWebAddress = "grpc-web.address"
 )
 
+// RPC-only node flags
+const (
+	// PendingTxsPollInterval is the interval of the polls of the pending txs of
+	// the remote node
+	PendingTxsPollInterval = "pending-txs-poll-interval"
+)
+
 // Cosmos API flags
 const (
 	RPCEnable         = "api.enable"
@@ -42,6 +49,9 @@ const (
 	JSONRPCAddress              = "json-rpc.address"
 	JSONWsAddress               = "json-rpc.ws-address"
 	JSONRPCWSOrigins            = "json-rpc.ws-origins"
+	JSONRPCIPCPath              = "json-rpc.ipc-path"
+	JSONRPCIPCPermissions       = "json-rpc.ipc-permissions"
+	JSONRPCIPCAPI               = "json-rpc.ipc-api"
 	JSONRPCGasCap               = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
 	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
@@ -51,12 +61,34 @@ const (
 	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
 	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
+	JSONRPCSlowRequestThreshold = "json-rpc.slow-request-threshold"
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnableGraphQL        = "json-rpc.enable-graphql"
+	JSONRPCEnableUnsafeAdmin    = "json-rpc.enable-unsafe-admin"
+	JSONRPCPendingLogsTxsCap    = "json-rpc.pending-logs-txs-cap"
+	JSONRPCPendingLogsTimeout   = "json-rpc.pending-logs-timeout"
+	JSONRPCBlockCacheSize       = "json-rpc.block-cache-size"
+	JSONRPCRPCBlockCacheSize    = "json-rpc.rpc-block-cache-size"
+	JSONRPCReceiptCacheSize     = "json-rpc.receipt-cache-size"
+	JSONRPCTxSyncTimeout        = "json-rpc.tx-sync-timeout"
+	JSONRPCTxSyncMaxTimeout     = "json-rpc.tx-sync-max-timeout"
+	JSONRPCGPOBlocks            = "json-rpc.gas-price-oracle.blocks"
+	JSONRPCGPOPercentile        = "json-rpc.gas-price-oracle.percentile"
+	JSONRPCGPOMaxPrice          = "json-rpc.gas-price-oracle.max-price"
+	JSONRPCGPOIgnorePrice       = "json-rpc.gas-price-oracle.ignore-price"
+	JSONRPCHealthEnable         = "json-rpc.health.enable"
+	JSONRPCHealthMaxIndexerLag  = "json-rpc.health.max-indexer-lag"
+	JSONRPCHealthMaxBlockAge    = "json-rpc.health.max-block-age"
+	JSONRPCAccessControlEnable  = "json-rpc.access-control.enable"
+	JSONRPCRequireAPIKey        = "json-rpc.access-control.require-api-key"
+	JSONRPCAPIKeyHeader         = "json-rpc.access-control.api-key-header"
+	JSONRPCIPRate               = "json-rpc.access-control.ip-rate"
+	JSONRPCIPBurst              = "json-rpc.access-control.ip-burst"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -66,6 +98,8 @@ const (
 // EVM flags
 const (
 	EVMTracer                  = "evm.tracer"
+	EVMLiveTracer              = "evm.live-tracer"
+	EVMLiveTracerConfig        = "evm.live-tracer-config"
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
@@ -79,6 +113,12 @@ const (
 	EVMMempoolAccountQueue = "evm.mempool.account-queue"
 	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
 	EVMMempoolLifetime     = "evm.mempool.lifetime"
+
+	EVMTelemetryExporter    = "evm.telemetry.exporter"
+	EVMTelemetryEndpoint    = "evm.telemetry.endpoint"
+	EVMTelemetryInsecure    = "evm.telemetry.insecure"
+	EVMTelemetrySampleRatio = "evm.telemetry.sample-ratio"
+	EVMTelemetryServiceName = "evm.telemetry.service-name"
 )
 
 // TLS flags
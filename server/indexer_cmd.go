@@ -24,6 +24,8 @@ func NewIndexTxCmd() *cobra.Command {
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
+		The indexed blocks are tracked by the log index, so running the command on an existing indexer db backfills the log index of the past heights.
+
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
 		`,
@@ -83,7 +85,8 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				// the address index starts with or after the log index
+				first, err := idxer.FirstIndexedAddressBlock()
 				if err != nil {
 					return err
 				}
@@ -97,7 +100,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := idxer.LastIndexedLogBlock()
 				if err != nil {
 					return err
 				}
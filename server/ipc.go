@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/stream"
+	serverconfig "github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/server/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// StartIPC starts the JSON-RPC server on the Unix socket of the IPC endpoint,
+// serving the namespaces enabled for IPC. The socket is removed when the
+// context is canceled.
+func StartIPC(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	stream *stream.RPCStream,
+	indexer types.EVMTxIndexer,
+	mempool *evmmempool.ExperimentalEVMMempool,
+) error {
+	logger := srvCtx.Logger.With("module", "geth")
+
+	ipcPath := config.JSONRPC.IPCPath
+	if !filepath.IsAbs(ipcPath) {
+		ipcPath = filepath.Join(srvCtx.Config.RootDir, ipcPath)
+	}
+	perm, err := config.JSONRPC.IPCFileMode()
+	if err != nil {
+		return err
+	}
+
+	ipcServer := ethrpc.NewServer()
+	ipcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, config.JSONRPC.AllowUnprotectedTxs, indexer, config.JSONRPC.IPCAPI, mempool)
+	for _, api := range apis {
+		if err := ipcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			logger.Error(
+				"failed to register service in JSON RPC IPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return err
+		}
+	}
+
+	ln, err := listenIPC(ipcPath, perm)
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+		go ipcServer.ServeListener(ln) //nolint: errcheck
+
+		<-ctx.Done()
+		logger.Info("stopping JSON-RPC IPC server...", "path", ipcPath)
+		// closing the listener removes the socket file
+		if err := ln.Close(); err != nil {
+			logger.Error("failed to close JSON-RPC IPC listener", "error", err.Error())
+		}
+		ipcServer.Stop()
+		return nil
+	})
+
+	return nil
+}
+
+// listenIPC listens on the Unix socket of the given path with the given file
+// permissions, replacing the stale socket left by an unclean shutdown. The path
+// must not be another file, or a socket still accepting connections.
+func listenIPC(path string, perm os.FileMode) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on the IPC socket %s: %w", path, err)
+	}
+	if err := os.Chmod(path, perm); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
+
+// removeStaleSocket removes the socket of the given path if no server accepts
+// its connections anymore.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("the IPC path %s exists and is not a socket", path)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("the IPC socket %s is in use", path)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove the stale IPC socket %s: %w", path, err)
+	}
+	return nil
+}
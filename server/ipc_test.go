@@ -0,0 +1,56 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testIPCService struct{}
+
+func (testIPCService) Echo(s string) string { return s }
+
+func TestListenIPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "evm.ipc")
+
+	// a stale socket is replaced
+	stale, err := listenIPC(path, 0o600)
+	require.NoError(t, err)
+	stale.(interface{ SetUnlinkOnClose(bool) }).SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	require.FileExists(t, path)
+
+	ln, err := listenIPC(path, 0o660)
+	require.NoError(t, err)
+	// a socket in use is kept
+	_, err = listenIPC(path, 0o660)
+	require.ErrorContains(t, err, "in use")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o660), info.Mode().Perm())
+
+	srv := ethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testIPCService{}))
+	go srv.ServeListener(ln) //nolint: errcheck
+
+	client, err := ethrpc.Dial(path)
+	require.NoError(t, err)
+	var res string
+	require.NoError(t, client.Call(&res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+	client.Close()
+
+	// the socket is removed with the listener
+	require.NoError(t, ln.Close())
+	srv.Stop()
+	require.NoFileExists(t, path)
+
+	// other files are kept
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+	_, err = listenIPC(path, 0o660)
+	require.ErrorContains(t, err, "not a socket")
+	require.FileExists(t, path)
+}
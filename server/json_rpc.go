@@ -17,8 +17,13 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/health"
+	"github.com/cosmos/evm/rpc/middleware"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
 	"github.com/cosmos/evm/server/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -75,8 +80,58 @@ func StartJSONRPC(
 		}
 	}
 
+	if config.JSONRPC.IPCPath != "" {
+		if err := StartIPC(ctx, srvCtx, clientCtx, g, config, stream, indexer, mempool); err != nil {
+			return nil, err
+		}
+	}
+
+	evmBackend := backend.NewBackend(srvCtx, srvCtx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+
 	r := mux.NewRouter()
 	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	// the GraphQL route is registered before the API key route to take precedence over it
+	if config.JSONRPC.EnableGraphQL {
+		graphQLHandler, err := graphql.NewHandler(evmBackend, srvCtx.Logger.With("module", "graphql"), config.JSONRPC.HTTPTimeout)
+		if err != nil {
+			return nil, err
+		}
+		r.Handle(middleware.GraphQLPath, graphQLHandler).Methods("POST")
+	}
+
+	var (
+		httpHandler http.Handler = r
+		access      *middleware.AccessControl
+	)
+	if config.JSONRPC.AccessControl.Enable {
+		var err error
+		access, err = middleware.NewAccessControl(config.JSONRPC.AccessControl)
+		if err != nil {
+			return nil, err
+		}
+		// the API key can be set as the URL path
+		r.HandleFunc(middleware.APIKeyRoute, rpcServer.ServeHTTP).Methods("POST")
+		r.Use(access.Handler)
+	}
+
+	// the metrics are recorded when the EVM RPC metrics server is enabled
+	requestMetrics := middleware.NewRequestMetrics(
+		logger,
+		srvCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics),
+		config.JSONRPC.SlowRequestThreshold,
+		rpc.MethodNames(apis),
+	)
+	if requestMetrics.Active() {
+		httpHandler = requestMetrics.Handler(httpHandler)
+	}
+	// the health endpoints bypass the access control and the request metrics
+	if config.JSONRPC.Health.Enable {
+		// the streams are subscribed to the events upfront for their liveness to be checked
+		stream.HeaderStream()
+		checker := health.NewChecker(clientCtx.Client, indexer, stream, config.JSONRPC.Health)
+		httpHandler = checker.Handler(httpHandler)
+	}
+	httpHandler = middleware.TraceContext(httpHandler)
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -85,7 +140,7 @@ func StartJSONRPC(
 
 	httpSrv := &http.Server{
 		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Handler:           handlerWithCors.Handler(httpHandler),
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -131,7 +186,7 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, evmBackend, config, access, requestMetrics)
 	wsSrv.Start()
 	return httpSrv, nil
 }
@@ -0,0 +1,370 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// DefaultPendingTxsPollInterval is the default interval of the polls of the
+	// pending txs of the remote node.
+	DefaultPendingTxsPollInterval = 500 * time.Millisecond
+
+	// maxPolledPendingTxs is the max number of pending txs read from the remote
+	// node on each poll, which is the max page size of CometBFT.
+	maxPolledPendingTxs = 100
+)
+
+// NewRPCServerCmd creates the command running the JSON-RPC and WebSocket
+// servers, with the EVM indexer, against a remote full node.
+func NewRPCServerCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rpc-server",
+		Short: "Run the JSON-RPC server of a remote full node",
+		Long: `Run the Ethereum JSON-RPC and WebSocket servers without a local node, serving the
+chain of the remote full node given by the '--node' CometBFT RPC endpoint and the
+'--grpc-addr' gRPC endpoint. The queries are forwarded to the remote node, the
+transactions are broadcast to it and its pending transactions are polled.
+
+The JSON-RPC server is configured by the [json-rpc] section of app.toml in the
+home directory, where the EVM indexer keeps its own database, subscribed to the
+blocks of the remote node. The EVM chain ID and coin are read from the remote node.
+`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			// Bind flags to the Context's Viper so that the JSON-RPC config is
+			// read with the flags overriding app.toml.
+			return serverCtx.Viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the remote gRPC client decodes the responses with the codec of
+			// the app, like the client of the local gRPC server
+			if grpcAddr, _ := cmd.Flags().GetString(flags.FlagGRPC); grpcAddr != "" {
+				grpcInsecure, _ := cmd.Flags().GetBool(flags.FlagGRPCInsecure)
+				grpcClient, err := newRemoteGRPCClient(clientCtx, grpcAddr, grpcInsecure)
+				if err != nil {
+					return err
+				}
+				defer grpcClient.Close()
+				clientCtx = clientCtx.WithGRPCClient(grpcClient)
+			}
+
+			return startRPCServer(serverCtx, clientCtx)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "The CometBFT RPC endpoint of the remote node")
+	cmd.Flags().String(flags.FlagGRPC, "", "The gRPC endpoint of the remote node, queried over the CometBFT RPC if empty")
+	cmd.Flags().Bool(flags.FlagGRPCInsecure, false, "Allow the gRPC connection to the remote node without TLS")
+	cmd.Flags().Duration(srvflags.PendingTxsPollInterval, DefaultPendingTxsPollInterval, "The interval of the polls of the pending txs of the remote node")
+	cmd.Flags().String(srvflags.AppDBBackend, "", "The type of database of the EVM indexer")
+
+	addJSONRPCFlags(cmd)
+	addTelemetryFlags(cmd)
+
+	return cmd
+}
+
+// startRPCServer starts the JSON-RPC server and the EVM indexer against the
+// remote node of the client context, and blocks until the process is stopped.
+func startRPCServer(svrCtx *server.Context, clientCtx client.Context) error {
+	home := svrCtx.Config.RootDir
+	logger := svrCtx.Logger
+	g, ctx := getCtx(svrCtx, true)
+
+	config, err := cosmosevmserverconfig.GetConfig(svrCtx.Viper)
+	if err != nil {
+		logger.Error("failed to get server config", "error", err.Error())
+		return err
+	}
+	config.JSONRPC.Enable = true
+
+	if err := config.ValidateBasic(); err != nil {
+		logger.Error("invalid server config", "error", err.Error())
+		return err
+	}
+
+	// the remote client must be started to subscribe to the events of the node
+	cometClient, ok := clientCtx.Client.(rpcclient.Client)
+	if !ok {
+		return fmt.Errorf("client %T does not implement the CometBFT RPC client", clientCtx.Client)
+	}
+	if !cometClient.IsRunning() {
+		if err := cometClient.Start(); err != nil {
+			return fmt.Errorf("failed to connect to the remote node: %w", err)
+		}
+		defer func() {
+			if err := cometClient.Stop(); err != nil {
+				logger.Error("failed to close the connection to the remote node", "error", err.Error())
+			}
+		}()
+	}
+
+	status, err := cometClient.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the status of the remote node: %w", err)
+	}
+	clientCtx = clientCtx.
+		WithHomeDir(home).
+		WithChainID(status.NodeInfo.Network)
+
+	evmChainID, err := configureRemoteEVM(ctx, clientCtx)
+	if err != nil {
+		return err
+	}
+	// the backend reads the EVM chain ID from the config
+	svrCtx.Viper.Set(srvflags.EVMChainID, evmChainID)
+	config.EVM.EVMChainID = evmChainID
+	logger.Info("serving the remote node", "node", clientCtx.NodeURI, "chain-id", clientCtx.ChainID, "evm-chain-id", evmChainID)
+
+	stopTracing, err := startTracing(ctx, home, config.EVM.Telemetry)
+	if err != nil {
+		logger.Error("failed to start the span export", "error", err.Error())
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := stopTracing(shutdownCtx); err != nil {
+			logger.Error("failed to stop the span export", "error", err.Error())
+		}
+	}()
+
+	// Flag not added in config to avoid user enabling in config without passing in CLI
+	if svrCtx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
+	var idxer servertypes.EVMTxIndexer
+	if config.JSONRPC.EnableIndexer {
+		idxer, err = startEVMIndexer(ctx, svrCtx, clientCtx, g, home)
+		if err != nil {
+			return err
+		}
+	}
+
+	pendingTxs := NewRemotePendingTxs(
+		cometClient,
+		clientCtx.TxConfig.TxDecoder(),
+		logger.With("module", "pending-txs"),
+	)
+	interval := svrCtx.Viper.GetDuration(srvflags.PendingTxsPollInterval)
+	if interval <= 0 {
+		interval = DefaultPendingTxsPollInterval
+	}
+	g.Go(func() error {
+		pendingTxs.Run(ctx, interval)
+		return nil
+	})
+
+	// the txs are broadcast to the remote node, without a local mempool
+	if _, err := StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, pendingTxs, nil); err != nil {
+		return err
+	}
+
+	// wait for signal capture and gracefully return
+	return g.Wait()
+}
+
+// newRemoteGRPCClient creates the client of the gRPC server of the remote node.
+func newRemoteGRPCClient(clientCtx client.Context, addr string, insecureConn bool) (*grpc.ClientConn, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if insecureConn {
+		creds = insecure.NewCredentials()
+	}
+
+	return grpc.NewClient(
+		addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(codec.NewProtoCodec(clientCtx.InterfaceRegistry).GRPCCodec()),
+			grpc.MaxCallRecvMsgSize(serverconfig.DefaultGRPCMaxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(serverconfig.DefaultGRPCMaxSendMsgSize),
+		),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+}
+
+// configureRemoteEVM sets the global EVM chain config and coin info from the
+// ones of the remote node, and returns its EVM chain ID.
+func configureRemoteEVM(ctx context.Context, clientCtx client.Context) (uint64, error) {
+	queryClient := evmtypes.NewQueryClient(clientCtx)
+
+	configRes, err := queryClient.Config(ctx, &evmtypes.QueryConfigRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the EVM config of the remote node: %w", err)
+	}
+	chainConfig := configRes.Config
+	if chainConfig == nil {
+		return 0, errors.New("the remote node returned no EVM config")
+	}
+
+	if err := evmtypes.SetChainConfig(chainConfig); err != nil {
+		// the config of the app creating the codecs may already be set
+		current := evmtypes.GetChainConfig()
+		if current == nil || current.ChainId != chainConfig.ChainId {
+			return 0, fmt.Errorf("failed to set the EVM chain config: %w", err)
+		}
+	}
+
+	paramsRes, err := queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the EVM params of the remote node: %w", err)
+	}
+
+	coinInfo := evmtypes.EvmCoinInfo{
+		Denom:         chainConfig.Denom,
+		ExtendedDenom: chainConfig.Denom,
+		DisplayDenom:  chainConfig.Denom,
+		Decimals:      uint32(chainConfig.Decimals), //nolint:gosec // decimals are at most 18
+	}
+	if evmtypes.Decimals(coinInfo.Decimals) != evmtypes.EighteenDecimals {
+		opts := paramsRes.Params.ExtendedDenomOptions
+		if opts == nil {
+			return 0, errors.New("extended denom options cannot be nil for non-18-decimal chains")
+		}
+		coinInfo.ExtendedDenom = opts.ExtendedDenom
+	}
+
+	metadataRes, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: coinInfo.Denom})
+	if err == nil && metadataRes.Metadata.Display != "" {
+		coinInfo.DisplayDenom = metadataRes.Metadata.Display
+	}
+
+	if err := evmtypes.NewEVMConfigurator().WithEVMCoinInfo(coinInfo).Configure(); err != nil {
+		return 0, fmt.Errorf("failed to set the EVM coin info: %w", err)
+	}
+
+	return chainConfig.ChainId, nil
+}
+
+// RemotePendingTxs streams the hashes of the Ethereum txs entering the mempool
+// of a remote node, read by polling its unconfirmed txs. It stands in for the
+// app in the RPC-only mode.
+type RemotePendingTxs struct {
+	client  rpcclient.MempoolClient
+	decoder sdk.TxDecoder
+	logger  log.Logger
+
+	mtx       sync.Mutex
+	listeners []func(common.Hash)
+	// seen holds the hashes of the txs pending at the last poll
+	seen map[common.Hash]struct{}
+}
+
+var _ AppWithPendingTxStream = (*RemotePendingTxs)(nil)
+
+// NewRemotePendingTxs creates the stream of the pending txs of the remote node
+// of the client.
+func NewRemotePendingTxs(client rpcclient.MempoolClient, decoder sdk.TxDecoder, logger log.Logger) *RemotePendingTxs {
+	return &RemotePendingTxs{
+		client:  client,
+		decoder: decoder,
+		logger:  logger,
+		seen:    make(map[common.Hash]struct{}),
+	}
+}
+
+// RegisterPendingTxListener registers a listener called with the hash of each
+// new pending Ethereum tx.
+func (p *RemotePendingTxs) RegisterPendingTxListener(listener func(common.Hash)) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.listeners = append(p.listeners, listener)
+}
+
+// Run polls the pending txs at the interval until the context is canceled.
+func (p *RemotePendingTxs) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.Poll(ctx); err != nil && ctx.Err() == nil {
+				p.logger.Debug("failed to poll the pending txs", "error", err.Error())
+			}
+		}
+	}
+}
+
+// Poll reads the pending txs of the remote node, and notifies the listeners of
+// the Ethereum txs that weren't pending at the last poll.
+func (p *RemotePendingTxs) Poll(ctx context.Context) error {
+	limit := maxPolledPendingTxs
+	res, err := p.client.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[common.Hash]struct{}, len(res.Txs))
+	var hashes []common.Hash
+	for _, txBz := range res.Txs {
+		tx, err := p.decoder(txBz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			hash := ethMsg.Hash()
+			pending[hash] = struct{}{}
+			if _, ok := p.seen[hash]; !ok {
+				hashes = append(hashes, hash)
+			}
+		}
+	}
+
+	p.mtx.Lock()
+	p.seen = pending
+	listeners := p.listeners
+	p.mtx.Unlock()
+
+	for _, hash := range hashes {
+		for _, listener := range listeners {
+			listener(hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+func TestRemotePendingTxs(t *testing.T) {
+	cfg := encoding.MakeConfig(1)
+	evmtypes.RegisterInterfaces(cfg.InterfaceRegistry)
+	addr, key := utiltx.NewAddrKey()
+
+	newTx := func(nonce uint64) (cmttypes.Tx, common.Hash) {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   big.NewInt(1),
+			Nonce:     nonce,
+			Amount:    big.NewInt(10),
+			GasLimit:  100000,
+			GasFeeCap: big.NewInt(1),
+			GasTipCap: big.NewInt(1),
+		})
+		msg.From = addr.Bytes()
+		require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(big.NewInt(1)), utiltx.NewSigner(key)))
+		tx, err := msg.BuildTxWithEvmParams(cfg.TxConfig.NewTxBuilder(), evmtypes.DefaultParams())
+		require.NoError(t, err)
+		bz, err := cfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz, msg.Hash()
+	}
+	tx1, hash1 := newTx(1)
+	tx2, hash2 := newTx(2)
+
+	client := mocks.NewClient(t)
+	pendingTxs := NewRemotePendingTxs(client, cfg.TxConfig.TxDecoder(), log.NewNopLogger())
+	var notified []common.Hash
+	pendingTxs.RegisterPendingTxListener(func(hash common.Hash) {
+		notified = append(notified, hash)
+	})
+
+	poll := func(txs ...cmttypes.Tx) []common.Hash {
+		client.On("UnconfirmedTxs", mock.Anything, mock.Anything).
+			Return(&coretypes.ResultUnconfirmedTxs{Txs: txs}, nil).Once()
+		notified = nil
+		require.NoError(t, pendingTxs.Poll(context.Background()))
+		return notified
+	}
+
+	require.Equal(t, []common.Hash{hash1}, poll(tx1, cmttypes.Tx("invalid")))
+	// the txs still pending are not notified again
+	require.Equal(t, []common.Hash{hash2}, poll(tx1, tx2))
+	require.Empty(t, poll(tx2))
+	// a tx pending again after leaving the mempool is notified
+	require.Equal(t, []common.Hash{hash1}, poll(tx1))
+}
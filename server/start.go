@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"time"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
@@ -195,27 +196,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.RPCEnable, cosmosevmserverconfig.DefaultAPIEnable, "Defines if Cosmos-sdk REST server should be enabled")
 	cmd.Flags().Bool(srvflags.EnabledUnsafeCors, false, "Defines if CORS should be enabled (unsafe - use it at your own risk)")
 
-	cmd.Flags().Bool(srvflags.JSONRPCEnable, cosmosevmserverconfig.DefaultJSONRPCEnable, "Define if the JSON-RPC server should be enabled")
-	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
-	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
-	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
-	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
-	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
-	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
-	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
-	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
-	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
-	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
-	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
-	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
-	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, cosmosevmserverconfig.DefaultBatchRequestLimit, "Maximum number of requests in a batch")
-	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
-	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
-	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
-	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
-	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
-	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
-	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	addJSONRPCFlags(cmd)
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -223,6 +204,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 	cmd.Flags().Uint64(srvflags.EVMMinTip, cosmosevmserverconfig.DefaultEVMMinTip, "the minimum priority fee for the mempool")
 	cmd.Flags().String(srvflags.EvmGethMetricsAddress, cosmosevmserverconfig.DefaultGethMetricsAddress, "the address to bind the geth metrics server to")
+	cmd.Flags().String(srvflags.EVMLiveTracer, cosmosevmserverconfig.DefaultEVMLiveTracer, "the live tracer streaming the execution of every block (callTracer|jsonl|supply|noop)")
+	cmd.Flags().String(srvflags.EVMLiveTracerConfig, "", "the JSON configuration of the live tracer")
 
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceLimit, cosmosevmserverconfig.DefaultMempoolConfig().PriceLimit, "the minimum gas price to enforce for acceptance into the pool (in wei)")
 	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultMempoolConfig().PriceBump, "the minimum price bump percentage to replace an already existing transaction (nonce)")
@@ -232,6 +215,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
 
+	addTelemetryFlags(cmd)
+
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
@@ -243,6 +228,66 @@ which accepts a path for the resulting pprof file.
 	return cmd
 }
 
+// addJSONRPCFlags adds the flags configuring the JSON-RPC server.
+func addJSONRPCFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(srvflags.JSONRPCEnable, cosmosevmserverconfig.DefaultJSONRPCEnable, "Define if the JSON-RPC server should be enabled")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
+	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the JSON-RPC IPC socket path, relative to the node home if not absolute (empty=disabled)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPermissions, cosmosevmserverconfig.DefaultIPCPermissions, "Sets the file permissions of the JSON-RPC IPC socket, in octal")
+	cmd.Flags().StringSlice(srvflags.JSONRPCIPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled on the IPC endpoint")
+	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
+	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCSlowRequestThreshold, cosmosevmserverconfig.DefaultSlowRequestThreshold, "Sets the serving time above which the json-rpc requests are logged (0=disabled)")
+	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, cosmosevmserverconfig.DefaultBatchRequestLimit, "Maximum number of requests in a batch")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, cosmosevmserverconfig.DefaultBatchResponseMaxSize, "Maximum size of server response")
+	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, cosmosevmserverconfig.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
+	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, cosmosevmserverconfig.DefaultEnableGraphQL, "Serve the GraphQL API at the /graphql path of the json-rpc server")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableUnsafeAdmin, cosmosevmserverconfig.DefaultEnableUnsafeAdmin, "Allows the admin namespace to be enabled in the IPC API namespaces")
+	cmd.Flags().Int(srvflags.JSONRPCPendingLogsTxsCap, cosmosevmserverconfig.DefaultPendingLogsTxsCap, "Sets the max number of pending transactions executed to serve the pending logs (0=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCPendingLogsTimeout, cosmosevmserverconfig.DefaultPendingLogsTimeout, "Sets a timeout for the execution of the pending transactions (0=infinite)")
+	cmd.Flags().Int(srvflags.JSONRPCBlockCacheSize, cosmosevmserverconfig.DefaultBlockCacheSize, "Sets the number of CometBFT blocks and block results cached by the JSON-RPC backend (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCRPCBlockCacheSize, cosmosevmserverconfig.DefaultRPCBlockCacheSize, "Sets the number of Ethereum blocks and block receipts cached by the JSON-RPC backend (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCReceiptCacheSize, cosmosevmserverconfig.DefaultReceiptCacheSize, "Sets the number of transaction receipts cached by the JSON-RPC backend (0=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncTimeout, cosmosevmserverconfig.DefaultTxSyncTimeout, "Sets the default time eth_sendRawTransactionSync waits for the receipt")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncMaxTimeout, cosmosevmserverconfig.DefaultTxSyncMaxTimeout, "Sets the max time eth_sendRawTransactionSync waits for the receipt (0=unbounded)")
+	cmd.Flags().Int(srvflags.JSONRPCGPOBlocks, cosmosevmserverconfig.DefaultGPOBlocks, "Sets the number of latest blocks sampled by the gas price oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGPOPercentile, cosmosevmserverconfig.DefaultGPOPercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMaxPrice, cosmosevmserverconfig.DefaultGPOMaxPrice, "Sets the max gas tip cap in wei suggested by the gas price oracle (0=unlimited)")
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOIgnorePrice, cosmosevmserverconfig.DefaultGPOIgnorePrice, "Sets the gas tip in wei below which the txs are ignored by the gas price oracle")
+	cmd.Flags().Bool(srvflags.JSONRPCHealthEnable, cosmosevmserverconfig.DefaultHealthConfig().Enable, "Serves the /health and /ready endpoints on the JSON-RPC HTTP server")
+	cmd.Flags().Int64(srvflags.JSONRPCHealthMaxIndexerLag, cosmosevmserverconfig.DefaultHealthMaxIndexerLag, "Sets the max number of blocks the EVM indexer can lag behind the node while ready")
+	cmd.Flags().Duration(srvflags.JSONRPCHealthMaxBlockAge, cosmosevmserverconfig.DefaultHealthMaxBlockAge, "Sets the max time since the last block received by the event stream while ready (0=unchecked)")
+	cmd.Flags().Bool(srvflags.JSONRPCAccessControlEnable, false, "Enables the API-key authentication and the rate limits of the JSON-RPC server")
+	cmd.Flags().Bool(srvflags.JSONRPCRequireAPIKey, false, "Rejects the JSON-RPC requests without a valid API key")
+	cmd.Flags().String(srvflags.JSONRPCAPIKeyHeader, cosmosevmserverconfig.DefaultAPIKeyHeader, "Sets the HTTP header holding the JSON-RPC API key")
+	cmd.Flags().Float64(srvflags.JSONRPCIPRate, cosmosevmserverconfig.DefaultIPRate, "Sets the request units refilled every second in the rate limit bucket of a client IP (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCIPBurst, cosmosevmserverconfig.DefaultIPBurst, "Sets the request units held by the rate limit bucket of a client IP")
+}
+
+// addTelemetryFlags adds the flags configuring the export of the OpenTelemetry
+// spans.
+func addTelemetryFlags(cmd *cobra.Command) {
+	cmd.Flags().String(srvflags.EVMTelemetryExporter, "", "the OpenTelemetry span exporter: 'otlp-grpc', 'otlp-http' or 'file' (empty=disabled)")
+	cmd.Flags().String(srvflags.EVMTelemetryEndpoint, cosmosevmserverconfig.DefaultTelemetryEndpoint, "the host:port of the OTLP collector, or the path of the span file")
+	cmd.Flags().Bool(srvflags.EVMTelemetryInsecure, false, "disable the TLS of the connection to the OTLP collector")
+	cmd.Flags().Float64(srvflags.EVMTelemetrySampleRatio, cosmosevmserverconfig.DefaultTelemetrySampleRatio, "the ratio of the traces sampled, between 0 and 1")
+	cmd.Flags().String(srvflags.EVMTelemetryServiceName, cosmosevmserverconfig.DefaultTelemetryServiceName, "the service name of the exported spans")
+}
+
 // startStandAlone starts an ABCI server in stand-alone mode.
 // Parameters:
 // - svrCtx: The context object that holds server configurations, logger, and other stateful information.
@@ -386,6 +431,19 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		return err
 	}
 
+	stopTracing, err := startTracing(ctx, home, config.EVM.Telemetry)
+	if err != nil {
+		logger.Error("failed to start the span export", "error", err.Error())
+		return err
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := stopTracing(shutdownCtx); err != nil {
+			logger.Error("failed to stop the span export", "error", err.Error())
+		}
+	}()
+
 	app = opts.AppCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
 	defer func() {
 		if err := app.Close(); err != nil {
@@ -477,39 +535,10 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxer, err = startEVMIndexer(ctx, svrCtx, clientCtx, g, home)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
-		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
-
-		g.Go(func() error {
-			errCh := make(chan error, 1)
-			go func() {
-				if err := indexerService.Start(); err != nil {
-					errCh <- err
-				}
-			}()
-
-			select {
-			case <-ctx.Done():
-				logger.Info("stopping evm indexer service due to context cancellation")
-				if err := indexerService.Stop(); err != nil {
-					logger.Error("failed to stop evm indexer service", "error", err.Error())
-				}
-				return ctx.Err()
-			case err := <-errCh:
-				if err != nil {
-					logger.Error("evm indexer service failed", "error", err.Error())
-				}
-				return err
-			}
-		})
 	}
 
 	if config.API.Enable || config.JSONRPC.Enable {
@@ -556,6 +585,54 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	return g.Wait()
 }
 
+// startEVMIndexer opens the custom eth indexer db and starts the service
+// indexing the blocks of the CometBFT client, stopped when the context is
+// canceled.
+func startEVMIndexer(
+	ctx context.Context,
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	home string,
+) (servertypes.EVMTxIndexer, error) {
+	logger := svrCtx.Logger
+	idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return nil, err
+	}
+
+	idxLogger := svrCtx.Logger.With("indexer", "evm")
+	idxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+	indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+	indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+
+	g.Go(func() error {
+		errCh := make(chan error, 1)
+		go func() {
+			if err := indexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case <-ctx.Done():
+			logger.Info("stopping evm indexer service due to context cancellation")
+			if err := indexerService.Stop(); err != nil {
+				logger.Error("failed to stop evm indexer service", "error", err.Error())
+			}
+			return ctx.Err()
+		case err := <-errCh:
+			if err != nil {
+				logger.Error("evm indexer service failed", "error", err.Error())
+			}
+			return err
+		}
+	})
+
+	return idxer, nil
+}
+
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	serverconfig "github.com/cosmos/evm/server/config"
+)
+
+func TestStartTracingFileExporter(t *testing.T) {
+	home := t.TempDir()
+	cfg := serverconfig.DefaultTelemetryConfig()
+	cfg.Exporter = serverconfig.TelemetryExporterFile
+	cfg.Endpoint = "spans.json"
+	cfg.ServiceName = "test-evm"
+	require.NoError(t, cfg.Validate())
+
+	provider := otel.GetTracerProvider()
+	propagator := otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+
+	stop, err := startTracing(context.Background(), home, cfg)
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "eth_blockNumber")
+	span.End()
+	require.NoError(t, stop(context.Background()))
+
+	spans, err := os.ReadFile(filepath.Join(home, "spans.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(spans), "eth_blockNumber")
+	require.Contains(t, string(spans), "test-evm")
+}
+
+func TestStartTracingDisabled(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	stop, err := startTracing(context.Background(), t.TempDir(), serverconfig.DefaultTelemetryConfig())
+	require.NoError(t, err)
+	require.Equal(t, provider, otel.GetTracerProvider())
+	require.NoError(t, stop(context.Background()))
+}
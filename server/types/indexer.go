@@ -18,3 +18,43 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// LogPosition is the position of an eth log in the chain: the block height and
+// the index of the log within the block.
+type LogPosition struct {
+	Height int64
+	Index  uint64
+}
+
+// EVMLogIndexer defines the interface of an eth log indexer, which maps the log
+// addresses and topics to the positions of the logs.
+type EVMLogIndexer interface {
+	// FirstIndexedLogBlock returns -1 if the log index is empty
+	FirstIndexedLogBlock() (int64, error)
+	// LastIndexedLogBlock returns -1 if the log index is empty
+	LastIndexedLogBlock() (int64, error)
+
+	// GetLogPositions returns the sorted positions of the logs in the [from, to]
+	// block range that match any of the addresses and, for every topic position,
+	// any of its topics. Empty lists match every log, but at least one address
+	// or topic is required.
+	GetLogPositions(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]LogPosition, error)
+}
+
+// EVMAddressIndexer defines the interface of an eth address indexer, which maps
+// the addresses to the transactions they sent or received and the contracts to
+// the transactions that created them. Only the senders, the recipients and the
+// created contracts of the transactions themselves are indexed, not the ones of
+// their internal calls.
+type EVMAddressIndexer interface {
+	// FirstIndexedAddressBlock returns -1 if the address index is empty
+	FirstIndexedAddressBlock() (int64, error)
+
+	// GetAddressTxs returns the hashes of the txs of the address in the blocks
+	// before (reverse) or after the given height, ordered from the closest one.
+	// Whole blocks are returned until at least limit txs are found, and the
+	// boolean result reports whether other txs remain.
+	GetAddressTxs(address common.Address, height int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	// GetContractCreationTx returns nil if the contract creation is not found.
+	GetContractCreationTx(address common.Address) (*common.Hash, error)
+}
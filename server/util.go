@@ -55,6 +55,9 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+
+		// JSON-RPC server of a remote node
+		NewRPCServerCmd(opts.DefaultNodeHome),
 	)
 }
 
@@ -2,10 +2,12 @@ package indexer
 
 import (
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -14,14 +16,18 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
 	"github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/log/v2"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
@@ -189,4 +195,207 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 			}
 		})
 	}
+	t.Run("log index", func(t *testing.T) {
+		topic := common.HexToHash("0x01")
+		otherTopic := common.HexToHash("0x02")
+		txRsp, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+			Hash: txHash.Hex(),
+			Logs: []*types.Log{
+				{Address: to.Hex(), Topics: []string{topic.Hex()}, Index: 0},
+				{Address: from.Hex(), Topics: []string{otherTopic.Hex(), topic.Hex()}, Index: 1},
+			},
+		})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{txRsp}})
+		require.NoError(t, err)
+
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+		first, err := idxer.FirstIndexedLogBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Data: data,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			},
+		}))
+		// blocks without logs are covered by the log index too
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 3}}, nil))
+
+		first, err = idxer.FirstIndexedLogBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), first)
+		last, err := idxer.LastIndexedLogBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), last)
+
+		testCases := []struct {
+			name      string
+			from, to  int64
+			addresses []common.Address
+			topics    [][]common.Hash
+			exp       []servertypes.LogPosition
+		}{
+			{"by address", 1, 3, []common.Address{to}, nil, []servertypes.LogPosition{{Height: 2, Index: 0}}},
+			{"by any address", 1, 3, []common.Address{to, from}, nil, []servertypes.LogPosition{{Height: 2, Index: 0}, {Height: 2, Index: 1}}},
+			{"by topic position", 1, 3, nil, [][]common.Hash{{topic}}, []servertypes.LogPosition{{Height: 2, Index: 0}}},
+			{"by wildcard topic", 1, 3, nil, [][]common.Hash{{}, {topic}}, []servertypes.LogPosition{{Height: 2, Index: 1}}},
+			{"by address and topic", 1, 3, []common.Address{from}, [][]common.Hash{{topic}}, []servertypes.LogPosition{}},
+			{"out of range", 3, 3, []common.Address{to}, nil, []servertypes.LogPosition{}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				positions, err := idxer.GetLogPositions(tc.from, tc.to, tc.addresses, tc.topics)
+				require.NoError(t, err)
+				require.Equal(t, tc.exp, positions)
+			})
+		}
+
+		_, err = idxer.GetLogPositions(1, 3, nil, [][]common.Hash{{}})
+		require.Error(t, err)
+	})
+	t.Run("log index of txs logging from the same contract", func(t *testing.T) {
+		topic := common.HexToHash("0x01")
+		otherTopic := common.HexToHash("0x02")
+
+		tx1 := types.NewTx(&types.EvmTxArgs{Nonce: 1, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+		tx1.From = from.Bytes()
+		require.NoError(t, tx1.Sign(ethSigner, signer))
+		tx1Hash := tx1.AsTransaction().Hash()
+		wrapperTx1, err := tx1.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+		require.NoError(t, err)
+		tx1Bz, err := clientCtx.TxConfig.TxEncoder()(wrapperTx1)
+		require.NoError(t, err)
+
+		// txResult returns the result of a tx emitting a single log from the contract, at the index 0 of the tx
+		txResult := func(hash common.Hash, index int, topic common.Hash) *abci.ExecTxResult {
+			txRsp, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+				Hash: hash.Hex(),
+				Logs: []*types.Log{{Address: to.Hex(), Topics: []string{topic.Hex()}, Index: 0}},
+			})
+			require.NoError(t, err)
+			data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{txRsp}})
+			require.NoError(t, err)
+			return &abci.ExecTxResult{
+				Code: 0,
+				Data: data,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hash.Hex()},
+						{Key: "txIndex", Value: strconv.Itoa(index)},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			}
+		}
+
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz, tx1Bz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{
+			txResult(txHash, 0, topic),
+			txResult(tx1Hash, 1, otherTopic),
+		}))
+
+		// the logs are positioned in the block, not in their tx
+		positions, err := idxer.GetLogPositions(1, 2, []common.Address{to}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []servertypes.LogPosition{{Height: 2, Index: 0}, {Height: 2, Index: 1}}, positions)
+
+		positions, err = idxer.GetLogPositions(1, 2, []common.Address{to}, [][]common.Hash{{otherTopic}})
+		require.NoError(t, err)
+		require.Equal(t, []servertypes.LogPosition{{Height: 2, Index: 1}}, positions)
+	})
+	t.Run("address index", func(t *testing.T) {
+		// buildTx returns a signed wrapper tx, which is a contract creation if to is nil
+		buildTx := func(nonce uint64, to *common.Address) (cmttypes.Tx, common.Hash) {
+			msg := types.NewTx(&types.EvmTxArgs{Nonce: nonce, To: to, Amount: big.NewInt(1000), GasLimit: 100000})
+			msg.From = from.Bytes()
+			require.NoError(t, msg.Sign(ethSigner, signer))
+			wrapperTx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+			require.NoError(t, err)
+			bz, err := clientCtx.TxConfig.TxEncoder()(wrapperTx)
+			require.NoError(t, err)
+			return bz, msg.AsTransaction().Hash()
+		}
+		txResult := func(hash common.Hash, index int) *abci.ExecTxResult {
+			return &abci.ExecTxResult{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: hash.Hex()},
+						{Key: "txIndex", Value: strconv.Itoa(index)},
+						{Key: "txGasUsed", Value: "21000"},
+					}},
+				},
+			}
+		}
+
+		tx0, hash0 := buildTx(0, &to)
+		tx1, hash1 := buildTx(1, nil)
+		tx2, hash2 := buildTx(2, &to)
+		contract := crypto.CreateAddress(from, 1)
+
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+		first, err := idxer.FirstIndexedAddressBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+
+		require.NoError(t, idxer.IndexBlock(
+			&cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx0, tx1}}},
+			[]*abci.ExecTxResult{txResult(hash0, 0), txResult(hash1, 1)},
+		))
+		require.NoError(t, idxer.IndexBlock(
+			&cmttypes.Block{Header: cmttypes.Header{Height: 4}, Data: cmttypes.Data{Txs: []cmttypes.Tx{tx2}}},
+			[]*abci.ExecTxResult{txResult(hash2, 0)},
+		))
+		first, err = idxer.FirstIndexedAddressBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), first)
+
+		testCases := []struct {
+			name    string
+			address common.Address
+			height  int64
+			reverse bool
+			limit   int
+			exp     []common.Hash
+			expMore bool
+		}{
+			{"latest block", from, 0, true, 1, []common.Hash{hash2}, true},
+			{"whole block before height", from, 4, true, 1, []common.Hash{hash1, hash0}, false},
+			{"whole block from genesis", from, 0, false, 1, []common.Hash{hash0, hash1}, true},
+			{"after height", from, 2, false, 10, []common.Hash{hash2}, false},
+			{"recipient", to, 0, true, 10, []common.Hash{hash2, hash0}, false},
+			{"created contract", contract, 0, true, 10, []common.Hash{hash1}, false},
+			{"unknown address", common.HexToAddress("0x02"), 0, true, 10, nil, false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				hashes, more, err := idxer.GetAddressTxs(tc.address, tc.height, tc.reverse, tc.limit)
+				require.NoError(t, err)
+				require.Equal(t, tc.exp, hashes)
+				require.Equal(t, tc.expMore, more)
+			})
+		}
+
+		creation, err := idxer.GetContractCreationTx(contract)
+		require.NoError(t, err)
+		require.Equal(t, &hash1, creation)
+		creation, err = idxer.GetContractCreationTx(to)
+		require.NoError(t, err)
+		require.Nil(t, creation)
+	})
 }
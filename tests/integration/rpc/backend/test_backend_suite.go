@@ -102,6 +102,7 @@ func (s *TestSuite) SetupTest() {
 	s.backend.Cfg.JSONRPC.AllowInsecureUnlock = true
 	s.backend.Cfg.EVM.EVMChainID = ChainID.EVMChainID
 	s.backend.QueryClient.QueryClient = mocks.NewEVMQueryClient(s.T())
+	RegisterConfig(s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient))
 	s.backend.QueryClient.FeeMarket = mocks.NewFeeMarketQueryClient(s.T())
 
 	// Add codec
@@ -115,13 +116,19 @@ func (s *TestSuite) Ctx() context.Context {
 
 // buildEthereumTx returns an example legacy Ethereum transaction
 func (s *TestSuite) buildEthereumTx() (*evmtypes.MsgEthereumTx, []byte) {
+	return s.buildEthereumTxWithGasPrice(big.NewInt(1))
+}
+
+// buildEthereumTxWithGasPrice returns an example legacy Ethereum transaction
+// paying the given gas price
+func (s *TestSuite) buildEthereumTxWithGasPrice(gasPrice *big.Int) (*evmtypes.MsgEthereumTx, []byte) {
 	ethTxParams := evmtypes.EvmTxArgs{
 		ChainID:  s.backend.EvmChainID,
 		Nonce:    uint64(0),
 		To:       &common.Address{},
 		Amount:   big.NewInt(0),
 		GasLimit: 100000,
-		GasPrice: big.NewInt(1),
+		GasPrice: gasPrice,
 	}
 	msgEthereumTx := evmtypes.NewTx(&ethTxParams)
 
@@ -180,7 +187,7 @@ func (s *TestSuite) buildFormattedBlock(
 		msgs = []*evmtypes.MsgEthereumTx{tx}
 	}
 	ethBlock := s.buildEthBlock(blockRes, resBlock, msgs, validator, baseFee)
-	res, err := rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, s.backend.ChainConfig())
+	res, err := rpctypes.RPCMarshalBlock(ethBlock, resBlock, msgs, true, fullTx, s.backend.ChainConfig(resBlock.Block.Height))
 	s.Require().NoError(err)
 
 	return res
@@ -204,7 +211,7 @@ func (s *TestSuite) buildEthBlock(
 	miner := common.BytesToAddress(validator.Bytes())
 
 	// 3) Build ethereum header
-	ethHeader := rpctypes.MakeHeader(cmtHeader, gasLimit, miner, baseFee)
+	ethHeader := rpctypes.MakeHeader(cmtHeader, gasLimit, miner, baseFee, s.backend.ChainConfig(cmtHeader.Height))
 
 	// 4) Prepare msgs and txs
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -250,7 +257,7 @@ func (s *TestSuite) signAndEncodeEthTx(msgEthereumTx *evmtypes.MsgEthereumTx) []
 	from, priv := utiltx.NewAddrKey()
 	signer := utiltx.NewSigner(priv)
 
-	ethSigner := ethtypes.LatestSigner(s.backend.ChainConfig())
+	ethSigner := ethtypes.LatestSigner(s.backend.ChainConfig(0))
 	msgEthereumTx.From = from.Bytes()
 	err := msgEthereumTx.Sign(ethSigner, signer)
 	s.Require().NoError(err)
@@ -94,9 +94,7 @@ func (s *TestSuite) TestResend() {
 				var header metadata.MD
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterParams(QueryClient, &header, height)
-				RegisterFeeMarketParams(feeMarketClient, 1)
 				RegisterBlock(client, height, nil)
 				RegisterBlockResults(client, 1)
 				RegisterConsensusParams(client, height)
@@ -298,7 +296,7 @@ func (s *TestSuite) TestSendRawTransaction() {
 	emptyEvmChainIDTx := s.buildEthereumTxWithChainID(nil)
 	invalidChainID := big.NewInt(1)
 	// Sign the ethTx
-	ethSigner := ethtypes.LatestSigner(s.backend.ChainConfig())
+	ethSigner := ethtypes.LatestSigner(s.backend.ChainConfig(0))
 	err := ethTx.Sign(ethSigner, s.signer)
 	s.Require().NoError(err)
 
@@ -580,8 +578,6 @@ func (s *TestSuite) TestGasPrice() {
 				var header metadata.MD
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
-				RegisterFeeMarketParams(feeMarketClient, 1)
 				RegisterParams(QueryClient, &header, height)
 				RegisterGlobalMinGasPrice(QueryClient, 1)
 				RegisterBlock(client, height, nil)
@@ -594,14 +590,13 @@ func (s *TestSuite) TestGasPrice() {
 			true,
 		},
 		{
-			"fail - can't get gasFee, FeeMarketParams error",
+			"fail - can't get the global min gas price",
 			func() {
 				var header metadata.MD
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
-				feeMarketClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
-				RegisterFeeMarketParamsError(feeMarketClient, 1)
 				RegisterParams(QueryClient, &header, height)
+				RegisterGlobalMinGasPriceError(QueryClient)
 				RegisterBlock(client, height, nil)
 				RegisterBlockResults(client, 1)
 				RegisterConsensusParams(client, height)
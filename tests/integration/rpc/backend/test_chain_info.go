@@ -250,6 +250,32 @@ func (s *TestSuite) TestGetCoinbase() {
 }
 
 func (s *TestSuite) TestSuggestGasTipCap() {
+	var header metadata.MD
+	height := int64(1)
+	// the block holds an indexed tx of the sender paying a tip of 10 above the base fee
+	registerTipBlock := func(validator sdk.AccAddress) {
+		msgEthereumTx, _ := s.buildEthereumTxWithGasPrice(big.NewInt(11))
+		tx, err := msgEthereumTx.BuildTx(s.backend.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+		s.Require().NoError(err)
+		txBz, err := s.backend.ClientCtx.TxConfig.TxEncoder()(tx)
+		s.Require().NoError(err)
+		client := s.backend.ClientCtx.Client.(*mocks.Client)
+		QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParams(QueryClient, &header, height)
+		resBlock := RegisterBlock(client, height, txBz)
+		blockRes, err := RegisterBlockResultsWithEventLog(client, height)
+		s.Require().NoError(err)
+		blockRes.TxsResults[0].Events = []types.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []types.EventAttribute{
+				{Key: evmtypes.AttributeKeyEthereumTxHash, Value: msgEthereumTx.Hash().Hex()},
+				{Key: evmtypes.AttributeKeyTxIndex, Value: "0"},
+				{Key: evmtypes.AttributeKeyTxGasUsed, Value: "21000"},
+			}},
+		}
+		s.Require().NoError(s.backend.Indexer.IndexBlock(resBlock.Block, blockRes.TxsResults))
+		RegisterBaseFee(QueryClient, sdkmath.NewInt(1))
+		RegisterValidatorAccount(QueryClient, validator)
+	}
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -265,12 +291,37 @@ func (s *TestSuite) TestSuggestGasTipCap() {
 			true,
 		},
 		{
-			"pass - Gets the suggest gas tip cap ",
-			func() {},
-			nil,
+			"pass - Gets the tip paid in the latest blocks",
+			func() {
+				registerTipBlock(sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+			},
+			big.NewInt(1),
+			big.NewInt(10),
+			true,
+		},
+		{
+			"pass - Ignores the txs of the block proposer",
+			func() {
+				registerTipBlock(sdk.AccAddress(s.from.Bytes()))
+			},
+			big.NewInt(1),
 			big.NewInt(0),
 			true,
 		},
+		{
+			"fail - Can't sample the latest blocks",
+			func() {
+				_, txBz := s.buildEthereumTxWithGasPrice(big.NewInt(11))
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(QueryClient, &header, height)
+				RegisterBlock(client, height, txBz)
+				RegisterBlockResultsError(client, height)
+			},
+			big.NewInt(1),
+			nil,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -278,10 +329,11 @@ func (s *TestSuite) TestSuggestGasTipCap() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			maxDelta, err := s.backend.SuggestGasTipCap(s.Ctx(), tc.baseFee)
+			tipCap, err := s.backend.SuggestGasTipCap(s.Ctx(), tc.baseFee)
 
 			if tc.expPass {
-				s.Require().Equal(tc.expGasTipCap, maxDelta)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expGasTipCap, tipCap)
 			} else {
 				s.Require().Error(err)
 			}
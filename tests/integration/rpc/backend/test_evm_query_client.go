@@ -103,6 +103,18 @@ func RegisterTraceCallError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// Config
+
+// RegisterConfig serves the configured chain config to the chain config
+// queries of the backend, if any.
+func RegisterConfig(queryClient *mocks.EVMQueryClient) {
+	queryClient.EXPECT().Config(mock.Anything, &evmtypes.QueryConfigRequest{}, mock.Anything).
+		RunAndReturn(func(context.Context, *evmtypes.QueryConfigRequest, ...grpc.CallOption) (*evmtypes.QueryConfigResponse, error) {
+			return &evmtypes.QueryConfigResponse{Config: evmtypes.GetChainConfig()}, nil
+		}).
+		Maybe()
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.EXPECT().Params(mock.Anything, &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -340,3 +352,8 @@ func RegisterGlobalMinGasPrice(queryClient *mocks.EVMQueryClient, height int64)
 	queryClient.EXPECT().GlobalMinGasPrice(mock.Anything, &evmtypes.QueryGlobalMinGasPriceRequest{}).
 		Return(&evmtypes.QueryGlobalMinGasPriceResponse{MinGasPrice: math.OneInt()}, nil)
 }
+
+func RegisterGlobalMinGasPriceError(queryClient *mocks.EVMQueryClient) {
+	queryClient.EXPECT().GlobalMinGasPrice(mock.Anything, &evmtypes.QueryGlobalMinGasPriceRequest{}).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
@@ -6,6 +6,7 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	rpcbackend "github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	ethrpc "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -118,3 +119,40 @@ func (s *TestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (s *TestSuite) TestPendingLogs() {
+	testCases := []struct {
+		name     string
+		txsCap   int
+		expLogs  []*ethtypes.Log
+		expError error
+	}{
+		{
+			"fail - pending logs disabled",
+			0,
+			nil,
+			rpcbackend.ErrPendingLogsDisabled,
+		},
+		{
+			"pass - no pending logs without the mempool",
+			10,
+			[]*ethtypes.Log{},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			s.backend.Cfg.JSONRPC.PendingLogsTxsCap = tc.txsCap
+			logs, err := s.backend.PendingLogs(s.Ctx())
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expLogs, logs)
+		})
+	}
+}
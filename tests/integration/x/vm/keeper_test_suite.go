@@ -119,4 +119,10 @@ func (s *KeeperTestSuite) SetupTest() {
 		}).
 		Configure()
 	s.Require().NoError(err)
+
+	if !s.EnableLondonHF {
+		// the keeper reads the chain config from the store
+		err = s.Network.App.GetEVMKeeper().SetChainConfig(s.Network.GetContext(), *chainConfig)
+		s.Require().NoError(err)
+	}
 }
@@ -1,8 +1,19 @@
 package vm
 
 import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/x/vm/tracers"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
@@ -24,3 +35,106 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	s.Require().Equal(1, len(postEventManager.Events()))
 	s.Require().Equal(evmtypes.EventTypeBlockBloom, postEventManager.Events()[0].Type)
 }
+
+func (s *KeeperTestSuite) TestLiveTracer() {
+	s.SetupTest()
+	var (
+		blocks, blockEnds int
+		txs               []*ethtypes.Transaction
+		receipts          []*ethtypes.Receipt
+		transfers         []*big.Int
+	)
+	sender := s.Keyring.GetKey(0)
+	receiver := s.Keyring.GetAddr(1)
+	s.Network.App.GetEVMKeeper().WithLiveTracer(&tracing.Hooks{
+		OnBlockStart: func(ev tracing.BlockEvent) {
+			blocks++
+			s.Require().NotNil(ev.Block.BaseFee())
+		},
+		OnBlockEnd: func(error) { blockEnds++ },
+		OnTxStart: func(_ *tracing.VMContext, tx *ethtypes.Transaction, from common.Address) {
+			s.Require().Equal(sender.Addr, from)
+			txs = append(txs, tx)
+		},
+		OnTxEnd: func(receipt *ethtypes.Receipt, err error) {
+			s.Require().NoError(err)
+			receipts = append(receipts, receipt)
+		},
+		OnEnter: func(depth int, _ byte, _, to common.Address, _ []byte, _ uint64, value *big.Int) {
+			if depth == 0 && to == receiver {
+				transfers = append(transfers, value)
+			}
+		},
+	})
+	defer s.Network.App.GetEVMKeeper().WithLiveTracer(nil)
+
+	res, err := s.Factory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{To: &receiver, Amount: big.NewInt(1000)})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK())
+	s.Require().NoError(s.Network.NextBlock())
+
+	// queries and simulations are not traced
+	_, err = s.Factory.EstimateGasLimit(&sender.Addr, &evmtypes.EvmTxArgs{To: &receiver, Amount: big.NewInt(1000)})
+	s.Require().NoError(err)
+
+	s.Require().Len(txs, 1)
+	s.Require().Equal(&receiver, txs[0].To())
+	s.Require().Len(receipts, 1)
+	s.Require().Equal(txs[0].Hash(), receipts[0].TxHash)
+	s.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipts[0].Status)
+	s.Require().Equal([]*big.Int{big.NewInt(1000)}, transfers)
+	s.Require().Positive(blocks)
+	s.Require().Equal(blocks, blockEnds)
+}
+
+func (s *KeeperTestSuite) TestLiveJSONLTracer() {
+	s.SetupTest()
+	var events []tracers.JSONLEvent
+	tracers.RegisterLiveConsumer("test_block_events", func(record json.RawMessage) {
+		var event tracers.JSONLEvent
+		s.Require().NoError(json.Unmarshal(record, &event))
+		events = append(events, event)
+	})
+	hooks, err := gethtracers.LiveDirectory.New(tracers.LiveJSONLTracerName, json.RawMessage(`{"consumer": "test_block_events"}`))
+	s.Require().NoError(err)
+	s.Network.App.GetEVMKeeper().WithLiveTracer(hooks)
+	defer s.Network.App.GetEVMKeeper().WithLiveTracer(nil)
+
+	// contract creation transferring value to the created contract
+	sender := s.Keyring.GetKey(0)
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender.Addr)
+	contract := crypto.CreateAddress(sender.Addr, nonce)
+	res, err := s.Factory.ExecuteEthTx(sender.Priv, evmtypes.EvmTxArgs{Input: []byte{byte(vm.STOP)}, Amount: big.NewInt(1000)})
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK())
+	s.Require().NoError(s.Network.NextBlock())
+
+	// the block of the transaction is reported with the hash of the CometBFT block
+	var blockHash *common.Hash
+	balanceChanges := make(map[common.Address]*big.Int)
+	var nonceChanges []tracers.JSONLEvent
+	for _, event := range events {
+		switch event.Event {
+		case "blockStart":
+			blockHash = event.BlockHash
+		case "balanceChange":
+			s.Require().NotNil(event.TxHash)
+			balanceChanges[*event.Address] = new(big.Int).Sub(event.New.ToInt(), event.Prev.ToInt())
+		case "nonceChange":
+			nonceChanges = append(nonceChanges, event)
+		case "txEnd":
+			s.Require().Equal(uint64(s.Network.GetContext().BlockHeight()), event.BlockNumber)
+			s.Require().Equal(common.BytesToHash(s.Network.GetContext().HeaderHash()), *blockHash)
+		}
+	}
+	s.Require().Equal(big.NewInt(1000), balanceChanges[contract])
+	s.Require().Equal(big.NewInt(-1000), balanceChanges[sender.Addr])
+
+	// the nonces of the sender and of the created contract are set by the EVM
+	nonces := make(map[common.Address]uint64)
+	for _, event := range nonceChanges {
+		s.Require().NotNil(event.TxHash)
+		nonces[*event.Address] = event.New.ToInt().Uint64()
+	}
+	s.Require().Equal(map[common.Address]uint64{sender.Addr: nonce + 1, contract: 1}, nonces)
+}
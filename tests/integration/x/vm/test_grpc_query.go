@@ -18,6 +18,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -28,11 +29,13 @@ import (
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm/keeper/testdata"
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtracers "github.com/cosmos/evm/x/vm/tracers"
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -321,6 +324,95 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	addr := s.Keyring.GetAddr(s.Keyring.AddKey())
+	keys := []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")}
+	for i, key := range keys {
+		s.Network.App.GetEVMKeeper().SetState(ctx, addr, key, common.BigToHash(big.NewInt(int64(i+1))).Bytes())
+	}
+
+	_, err := s.Network.App.GetEVMKeeper().StorageRange(ctx, &types.QueryStorageRangeRequest{Address: invalidAddress})
+	s.Require().Error(err)
+
+	res, err := s.Network.App.GetEVMKeeper().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.State{
+		types.NewState(keys[0], common.BigToHash(big.NewInt(1))),
+		types.NewState(keys[1], common.BigToHash(big.NewInt(2))),
+	}, res.Storage)
+	s.Require().Equal(keys[2].Bytes(), res.Pagination.NextKey)
+
+	res, err = s.Network.App.GetEVMKeeper().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.State{types.NewState(keys[2], common.BigToHash(big.NewInt(3)))}, res.Storage)
+	s.Require().Empty(res.Pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestQueryAccounts() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	code := []byte("code")
+	contract := s.Keyring.GetAddr(0)
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(contract, code)
+	s.Require().NoError(stateDB.Commit())
+
+	_, err := s.Network.App.GetEVMKeeper().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Offset: 1},
+	})
+	s.Require().Error(err)
+
+	// walk all the accounts one page at a time
+	var (
+		accounts []types.EthAccount
+		nextKey  []byte
+	)
+	for {
+		res, err := s.Network.App.GetEVMKeeper().Accounts(ctx, &types.QueryAccountsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Accounts), 2)
+		accounts = append(accounts, res.Accounts...)
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	s.Require().NotEmpty(accounts)
+	found := false
+	for i, acc := range accounts {
+		if i > 0 {
+			s.Require().Negative(strings.Compare(strings.ToLower(accounts[i-1].Address), strings.ToLower(acc.Address)))
+		}
+		if acc.Address == contract.Hex() {
+			found = true
+			s.Require().Equal(code, acc.Code)
+			s.Require().Equal(crypto.Keccak256Hash(code).Hex(), acc.CodeHash)
+		}
+	}
+	s.Require().True(found)
+
+	// the code is omitted when skipped
+	res, err := s.Network.App.GetEVMKeeper().Accounts(ctx, &types.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: contract.Bytes(), Limit: 1},
+		SkipCode:   true,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 1)
+	s.Require().Equal(contract.Hex(), res.Accounts[0].Address)
+	s.Require().Empty(res.Accounts[0].Code)
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -457,6 +549,90 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	s.Require().Equal(expParams, res.Params)
 }
 
+func (s *KeeperTestSuite) TestQueryContractAccessControl() {
+	ctx := s.Network.GetContext()
+	contract := s.Keyring.GetAddr(1)
+	contractAccessControl := types.ContractAccessControl{
+		Address: contract.String(),
+		Call:    types.AccessControlType{AccessType: types.AccessTypeRestricted},
+		Functions: []types.FunctionAccessControl{{
+			Selector: "0x40c10f19",
+			Call: types.AccessControlType{
+				AccessType:        types.AccessTypePermissioned,
+				AccessControlList: []string{s.Keyring.GetAddr(0).String()},
+			},
+		}},
+	}
+	params := s.Network.App.GetEVMKeeper().GetParams(ctx)
+	params.AccessControl.Contracts = []types.ContractAccessControl{contractAccessControl}
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetParams(ctx, params))
+
+	_, err := s.Network.App.GetEVMKeeper().ContractAccessControl(ctx, &types.QueryContractAccessControlRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	res, err := s.Network.App.GetEVMKeeper().ContractAccessControl(ctx, &types.QueryContractAccessControlRequest{Address: contract.Hex()})
+	s.Require().NoError(err)
+	s.Require().Equal(&contractAccessControl, res.ContractAccessControl)
+
+	res, err = s.Network.App.GetEVMKeeper().ContractAccessControl(ctx, &types.QueryContractAccessControlRequest{Address: s.Keyring.GetAddr(0).Hex()})
+	s.Require().NoError(err)
+	s.Require().Nil(res.ContractAccessControl)
+}
+
+func (s *KeeperTestSuite) TestQueryConfig() {
+	ctx := s.Network.GetContext()
+	res, err := s.Network.App.GetEVMKeeper().Config(ctx, &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(ethparams.MaxCodeSize), res.MaxCodeSize)
+	s.Require().Equal(uint64(ethparams.MaxInitCodeSize), res.MaxInitCodeSize)
+	s.Require().Equal(ethparams.RefundQuotientEIP3529, res.RefundQuotient)
+
+	evmParams := s.Network.App.GetEVMKeeper().GetParams(ctx)
+	evmParams.MaxCodeSize = 16384
+	evmParams.MaxInitCodeSize = 32768
+	evmParams.RefundQuotient = 10
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetParams(ctx, evmParams))
+
+	res, err = s.Network.App.GetEVMKeeper().Config(ctx, &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(16384), res.MaxCodeSize)
+	s.Require().Equal(uint64(32768), res.MaxInitCodeSize)
+	s.Require().Equal(uint64(10), res.RefundQuotient)
+	s.Require().Equal(types.GetChainConfig().ChainId, res.Config.ChainId)
+}
+
+func (s *KeeperTestSuite) TestQueryOpcodeGasSchedule() {
+	ctx := s.Network.GetContext()
+	res, err := s.Network.App.GetEVMKeeper().OpcodeGasSchedule(ctx, &types.QueryOpcodeGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Opcodes)
+
+	params := s.Network.App.GetEVMKeeper().GetParams(ctx)
+	params.OpcodeGasOverrides = []types.OpcodeGasOverride{
+		{Opcode: "SLOAD", ConstantGas: 3000},
+		{Opcode: "ADD", ConstantGas: 5},
+	}
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetParams(ctx, params))
+
+	res, err = s.Network.App.GetEVMKeeper().OpcodeGasSchedule(ctx, &types.QueryOpcodeGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.OpcodeGas{
+		{Opcode: "SLOAD", ConstantGas: 3000, ForkConstantGas: 0},
+		{Opcode: "ADD", ConstantGas: 5, ForkConstantGas: 3},
+	}, res.Opcodes)
+
+	// the gas of the opcodes without the overrides includes the extra EIPs
+	params.ExtraEIPs = []int64{1884}
+	params.OpcodeGasOverrides = []types.OpcodeGasOverride{{Opcode: "BALANCE", ConstantGas: 200}}
+	s.Require().NoError(s.Network.App.GetEVMKeeper().SetParams(ctx, params))
+
+	res, err = s.Network.App.GetEVMKeeper().OpcodeGasSchedule(ctx, &types.QueryOpcodeGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.OpcodeGas{
+		{Opcode: "BALANCE", ConstantGas: 200, ForkConstantGas: 700},
+	}, res.Opcodes)
+}
+
 func (s *KeeperTestSuite) TestQueryValidatorAccount() {
 	testCases := []struct {
 		msg           string
@@ -1225,6 +1401,21 @@ func (s *KeeperTestSuite) TestTraceTx() {
 			expPass:       true,
 			expectedTrace: "[]",
 		},
+		{
+			msg: "vm trace tracer",
+			getRequest: func() *types.QueryTraceTxRequest {
+				defaultRequest := getDefaultTraceTxRequest(s.Network)
+				defaultRequest.TraceConfig = &types.TraceConfig{
+					Tracer: evmtracers.VMTraceTracerName,
+				}
+				return defaultRequest
+			},
+			getPredecessors: func() []*types.MsgEthereumTx {
+				return nil
+			},
+			expPass:       true,
+			expectedTrace: "{\"code\":\"0x608060405234801561001057600080fd5b506004361061009e5760003560e01c80636618846311610066578063661884631461022957806370a082311461028f578063a9059",
+		},
 		{
 			msg: "default tracer with predecessors",
 			getRequest: func() *types.QueryTraceTxRequest {
@@ -2041,6 +2232,8 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 					WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]).
 					Configure()
 				s.Require().NoError(err)
+				err = s.Network.App.GetEVMKeeper().SetChainConfig(s.Network.GetContext(), *chainConfig)
+				s.Require().NoError(err)
 			},
 			true,
 		},
@@ -2211,6 +2404,403 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	deployData := erc20Contract.Bin
+	deployData = append(deployData, ctorArgs...)
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contractAddr := crypto.CreateAddress(sender, nonce)
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(100))
+	s.Require().NoError(err)
+
+	value := (*hexutil.Big)(big.NewInt(1000))
+	height := uint64(s.Network.GetContext().BlockHeight()) //nolint:gosec // G115
+
+	testCases := []struct {
+		name     string
+		opts     rpctypes.SimOpts
+		expPass  bool
+		validate func([]rpctypes.SimBlockResult)
+	}{
+		{
+			"fail - no blocks",
+			rpctypes.SimOpts{},
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers not in order",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height))}},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - traced value transfer",
+			rpctypes.SimOpts{
+				TraceTransfers: true,
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+				},
+			},
+			true,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 1)
+				s.Require().Equal(height+1, uint64(blocks[0].Number))
+				s.Require().Len(blocks[0].Calls, 1)
+				call := blocks[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(1), call.Status)
+				s.Require().Len(call.Logs, 1)
+				s.Require().Equal(rpctypes.SimulateTransferAddress, call.Logs[0].Address)
+				s.Require().Equal(rpctypes.SimulateTransferTopic, call.Logs[0].Topics[0])
+			},
+		},
+		{
+			"pass - state is carried over blocks and gaps are filled",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, Input: (*hexutil.Bytes)(&deployData)}}},
+					{
+						BlockOverrides: &rpctypes.BlockOverrides{Number: (*hexutil.Big)(new(big.Int).SetUint64(height + 3))},
+						Calls:          []types.TransactionArgs{{From: &sender, To: &contractAddr, Input: (*hexutil.Bytes)(&transferData)}},
+					},
+				},
+			},
+			true,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 3)
+				s.Require().Empty(blocks[1].Calls)
+				s.Require().Len(blocks[2].Calls, 1)
+				call := blocks[2].Calls[0]
+				s.Require().Equal(hexutil.Uint64(1), call.Status, call.Error)
+				s.Require().Len(call.Logs, 1)
+				s.Require().Equal(contractAddr, call.Logs[0].Address)
+			},
+		},
+		{
+			"pass - reverted call",
+			rpctypes.SimOpts{
+				BlockStateCalls: []rpctypes.SimBlock{
+					{
+						Calls: []types.TransactionArgs{
+							{From: &sender, Input: (*hexutil.Bytes)(&deployData)},
+							{From: &recipient, To: &contractAddr, Input: (*hexutil.Bytes)(&transferData)},
+						},
+					},
+				},
+			},
+			true,
+			func(blocks []rpctypes.SimBlockResult) {
+				s.Require().Len(blocks, 1)
+				s.Require().Len(blocks[0].Calls, 2)
+				call := blocks[0].Calls[1]
+				s.Require().Equal(hexutil.Uint64(0), call.Status)
+				s.Require().NotNil(call.Error)
+				s.Require().Empty(call.Logs)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:   opts,
+				GasCap: config.DefaultGasCap,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var blocks []rpctypes.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &blocks))
+			tc.validate(blocks)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateV1Limits() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	value := (*hexutil.Big)(big.NewInt(1000))
+	transfer := types.TransactionArgs{From: &sender, To: &recipient, Value: value}
+	// contract creation looping until it runs out of gas
+	loop := hexutil.Bytes{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	loopGas := hexutil.Uint64(config.DefaultGasCap)
+
+	testCases := []struct {
+		name    string
+		blocks  []rpctypes.SimBlock
+		gasCap  uint64
+		timeout string
+		expPass bool
+	}{
+		{
+			"pass - calls within the gas cap",
+			[]rpctypes.SimBlock{{Calls: []types.TransactionArgs{transfer, transfer}}},
+			2 * ethparams.TxGas,
+			"",
+			true,
+		},
+		{
+			"fail - gas cap spent by the previous calls",
+			[]rpctypes.SimBlock{{Calls: []types.TransactionArgs{transfer, transfer}}, {Calls: []types.TransactionArgs{transfer}}},
+			2 * ethparams.TxGas,
+			"",
+			false,
+		},
+		{
+			"fail - call stopped on the deadline",
+			[]rpctypes.SimBlock{{Calls: []types.TransactionArgs{{From: &sender, Input: &loop, Gas: &loopGas}}}},
+			config.DefaultGasCap,
+			"5ms",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(rpctypes.SimOpts{BlockStateCalls: tc.blocks})
+			s.Require().NoError(err)
+
+			_, err = s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:    opts,
+				GasCap:  tc.gasCap,
+				Timeout: tc.timeout,
+			})
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCallMany() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	deployData := erc20Contract.Bin
+	deployData = append(deployData, ctorArgs...)
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender)
+	contractAddr := crypto.CreateAddress(sender, nonce)
+	transferData, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(100))
+	s.Require().NoError(err)
+	balanceData, err := erc20Contract.ABI.Pack("balanceOf", recipient)
+	s.Require().NoError(err)
+
+	value := (*hexutil.Big)(big.NewInt(1000))
+	wrongNonce := hexutil.Uint64(nonce + 5)
+	transferGas := hexutil.Uint64(ethparams.TxGas)
+
+	testCases := []struct {
+		name     string
+		bundles  []rpctypes.Bundle
+		validate bool
+		timeout  string
+		expPass  bool
+		check    func([][]rpctypes.BundleCallResult)
+	}{
+		{
+			"fail - no bundles",
+			nil,
+			false,
+			"",
+			false,
+			nil,
+		},
+		{
+			"fail - invalid nonce with validation",
+			[]rpctypes.Bundle{
+				{Transactions: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value, Nonce: &wrongNonce}}},
+			},
+			true,
+			"",
+			false,
+			nil,
+		},
+		{
+			"fail - timeout",
+			[]rpctypes.Bundle{
+				{Transactions: []types.TransactionArgs{{From: &sender, To: &recipient, Value: value}}},
+			},
+			false,
+			"1ns",
+			false,
+			nil,
+		},
+		{
+			"pass - state is carried over calls and bundles",
+			[]rpctypes.Bundle{
+				{Transactions: []types.TransactionArgs{{From: &sender, Input: (*hexutil.Bytes)(&deployData)}}},
+				{
+					Transactions: []types.TransactionArgs{
+						{From: &sender, To: &contractAddr, Input: (*hexutil.Bytes)(&transferData)},
+						{From: &sender, To: &contractAddr, Input: (*hexutil.Bytes)(&balanceData)},
+					},
+				},
+			},
+			false,
+			"",
+			true,
+			func(results [][]rpctypes.BundleCallResult) {
+				s.Require().Len(results, 2)
+				s.Require().Len(results[1], 2)
+				s.Require().Empty(results[1][0].VMError)
+				s.Require().Equal(common.BigToHash(big.NewInt(100)).Bytes(), []byte(results[1][1].ReturnValue))
+			},
+		},
+		{
+			"pass - value sent to the coinbase",
+			[]rpctypes.Bundle{
+				{
+					BlockOverride: &rpctypes.BundleBlockOverrides{Coinbase: &recipient},
+					Transactions:  []types.TransactionArgs{{From: &sender, To: &recipient, Value: value, Gas: &transferGas}},
+				},
+			},
+			true,
+			"",
+			true,
+			func(results [][]rpctypes.BundleCallResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0], 1)
+				s.Require().Equal(value.ToInt(), results[0][0].EthSentToCoinbase.ToInt())
+				s.Require().Equal(hexutil.Uint64(ethparams.TxGas), results[0][0].GasUsed)
+			},
+		},
+		{
+			"pass - reverted call",
+			[]rpctypes.Bundle{
+				{
+					Transactions: []types.TransactionArgs{
+						{From: &sender, Input: (*hexutil.Bytes)(&deployData)},
+						{From: &recipient, To: &contractAddr, Input: (*hexutil.Bytes)(&transferData)},
+					},
+				},
+			},
+			false,
+			"",
+			true,
+			func(results [][]rpctypes.BundleCallResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0], 2)
+				s.Require().Equal(vm.ErrExecutionReverted.Error(), results[0][1].VMError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bundles, err := json.Marshal(tc.bundles)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().CallMany(s.Network.GetContext(), &types.CallManyRequest{
+				Bundles:  bundles,
+				GasCap:   config.DefaultGasCap,
+				Validate: tc.validate,
+				Timeout:  tc.timeout,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results [][]rpctypes.BundleCallResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.check(results)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCallManyLimits() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := tx.GenerateAddress()
+	value := (*hexutil.Big)(big.NewInt(1000))
+	transfer := types.TransactionArgs{From: &sender, To: &recipient, Value: value}
+	// contract creation looping until it runs out of gas
+	loop := hexutil.Bytes{byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.JUMP)}
+	loopGas := hexutil.Uint64(config.DefaultGasCap)
+
+	testCases := []struct {
+		name    string
+		bundles []rpctypes.Bundle
+		gasCap  uint64
+		timeout string
+		expPass bool
+	}{
+		{
+			"pass - calls within the gas cap",
+			[]rpctypes.Bundle{{Transactions: []types.TransactionArgs{transfer, transfer}}},
+			2 * ethparams.TxGas,
+			"",
+			true,
+		},
+		{
+			"fail - gas cap spent by the previous calls",
+			[]rpctypes.Bundle{{Transactions: []types.TransactionArgs{transfer, transfer}}, {Transactions: []types.TransactionArgs{transfer}}},
+			2 * ethparams.TxGas,
+			"",
+			false,
+		},
+		{
+			"fail - call stopped on the deadline",
+			[]rpctypes.Bundle{{Transactions: []types.TransactionArgs{{From: &sender, Input: &loop, Gas: &loopGas}}}},
+			config.DefaultGasCap,
+			"5ms",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bundles, err := json.Marshal(tc.bundles)
+			s.Require().NoError(err)
+
+			_, err = s.Network.GetEvmClient().CallMany(s.Network.GetContext(), &types.CallManyRequest{
+				Bundles: bundles,
+				GasCap:  tc.gasCap,
+				Timeout: tc.timeout,
+			})
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -2406,6 +2996,18 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"CallMany method",
+			func() (interface{}, error) {
+				return k.CallMany(s.Network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -1,18 +1,24 @@
 package vm
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -34,6 +40,67 @@ func (dh *FailureHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.
 	return errors.New("post tx processing failed")
 }
 
+// ScreeningHook rejects the messages to a blocked recipient, emits an event for the other
+// messages and records the gas used by the executed messages
+type ScreeningHook struct {
+	Blocked common.Address
+	GasUsed []uint64
+}
+
+func (dh *ScreeningHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	if msg.To != nil && *msg.To == dh.Blocked {
+		return errorsmod.Wrapf(types.ErrRejectedByHook, "recipient %s is blocked", dh.Blocked)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent("screened", sdk.NewAttribute("sender", msg.From.Hex())))
+	return nil
+}
+
+func (dh *ScreeningHook) PostCallProcessing(_ sdk.Context, _ core.Message, res *types.MsgEthereumTxResponse) error {
+	dh.GasUsed = append(dh.GasUsed, res.GasUsed)
+	return nil
+}
+
+func (dh *ScreeningHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
+// FeeHook takes a fee from the sender of the executed messages
+type FeeHook struct {
+	BankKeeper interface {
+		SendCoins(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+	}
+	Collector sdk.AccAddress
+	Fee       sdk.Coins
+}
+
+func (dh *FeeHook) PostCallProcessing(ctx sdk.Context, msg core.Message, _ *types.MsgEthereumTxResponse) error {
+	return dh.BankKeeper.SendCoins(ctx, msg.From.Bytes(), dh.Collector, dh.Fee)
+}
+
+func (dh *FeeHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
+// BalanceCapHook rejects the messages leaving the balance of the capped account above the cap
+type BalanceCapHook struct {
+	BankKeeper interface {
+		GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	}
+	Capped sdk.AccAddress
+	Cap    sdk.Coin
+}
+
+func (dh *BalanceCapHook) PostCallProcessing(ctx sdk.Context, _ core.Message, _ *types.MsgEthereumTxResponse) error {
+	if balance := dh.BankKeeper.GetBalance(ctx, dh.Capped, dh.Cap.Denom); balance.IsGT(dh.Cap) {
+		return errorsmod.Wrapf(types.ErrRejectedByHook, "balance %s above the cap", balance)
+	}
+	return nil
+}
+
+func (dh *BalanceCapHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	return nil
+}
+
 func (s *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -132,3 +199,143 @@ func (s *KeeperTestSuite) TestPostTxProcessingFailureLogReversion() {
 	// Critical test: Verify logs are completely cleared
 	s.Require().Nil(res.Logs, "res.Logs should be nil after PostTxProcessing failure")
 }
+
+func (s *KeeperTestSuite) TestPreTxAndPostCallHooks() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	hook := &ScreeningHook{Blocked: utiltx.GenerateAddress()}
+	s.Network.App.GetEVMKeeper().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	// the transactions are screened before their execution
+	tx, err := s.Factory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(100),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1000000000),
+	})
+	s.Require().NoError(err)
+	res, err := k.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().Empty(res.VmError)
+	s.Require().Equal([]uint64{21000}, hook.GasUsed)
+
+	var screened bool
+	for _, event := range ctx.EventManager().Events() {
+		screened = screened || event.Type == "screened"
+	}
+	s.Require().True(screened, "expected the event emitted by the hook")
+
+	tx, err = s.Factory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:       &hook.Blocked,
+		Amount:   big.NewInt(100),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1000000000),
+	})
+	s.Require().NoError(err)
+	// the rejected transactions fail with their intrinsic gas, without being executed
+	res, err = k.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().Contains(res.VmError, types.ErrRejectedByHook.Error())
+	s.Require().Equal(uint64(21000), res.GasUsed)
+	s.Require().Len(hook.GasUsed, 1)
+
+	// the simulated calls are screened as the transactions
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	from := sender.Addr
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &recipient})
+	s.Require().NoError(err)
+	req := &types.EthCallRequest{Args: args, GasCap: 25_000_000}
+	_, err = k.EthCall(ctx, req)
+	s.Require().NoError(err)
+	_, err = k.EstimateGas(ctx, req)
+	s.Require().NoError(err)
+
+	args, err = json.Marshal(&types.TransactionArgs{From: &from, To: &hook.Blocked})
+	s.Require().NoError(err)
+	req = &types.EthCallRequest{Args: args, GasCap: 25_000_000}
+	res, err = k.EthCall(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Contains(res.VmError, types.ErrRejectedByHook.Error())
+	_, err = k.EstimateGas(ctx, req)
+	s.Require().ErrorContains(err, types.ErrRejectedByHook.Error())
+}
+
+func (s *KeeperTestSuite) TestPostCallHooksStateChanges() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	denom := types.GetEVMCoinDenom()
+	hook := &FeeHook{
+		BankKeeper: s.Network.App.GetBankKeeper(),
+		Collector:  utiltx.GenerateAddress().Bytes(),
+		Fee:        sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000))),
+	}
+	s.Network.App.GetEVMKeeper().SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	k := s.Network.App.GetEVMKeeper()
+	bankKeeper := s.Network.App.GetBankKeeper()
+	ctx := s.Network.GetContext()
+	balance := bankKeeper.GetBalance(ctx, sender.AccAddr, denom)
+
+	tx, err := s.Factory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(100),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1000000000),
+	})
+	s.Require().NoError(err)
+	res, err := k.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().Empty(res.VmError)
+
+	// the fee taken by the hook from the sender isn't overwritten by the state changes of the message
+	s.Require().Equal(hook.Fee[0], bankKeeper.GetBalance(ctx, hook.Collector, denom))
+	expBalance := balance.Amount.SubRaw(100).Sub(hook.Fee[0].Amount)
+	s.Require().Equal(expBalance.String(), bankKeeper.GetBalance(ctx, sender.AccAddr, denom).Amount.String())
+}
+
+func (s *KeeperTestSuite) TestPostCallHooksRejection() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	denom := types.GetEVMCoinDenom()
+	k := s.Network.App.GetEVMKeeper()
+	bankKeeper := s.Network.App.GetBankKeeper()
+	ctx := s.Network.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+	balance := bankKeeper.GetBalance(ctx, recipient.Bytes(), denom)
+
+	// the transfers are rejected once the balance of the recipient exceeds its current one
+	hook := &BalanceCapHook{BankKeeper: bankKeeper, Capped: recipient.Bytes(), Cap: balance}
+	k.SetHooks(keeper.NewMultiEvmHooks(hook))
+
+	// the simulated calls see the state changes of the message, as the transactions
+	from := sender.Addr
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &recipient, Value: (*hexutil.Big)(big.NewInt(100))})
+	s.Require().NoError(err)
+	res, err := k.EthCall(ctx, &types.EthCallRequest{Args: args, GasCap: 25_000_000})
+	s.Require().NoError(err)
+	s.Require().Contains(res.VmError, types.ErrRejectedByHook.Error())
+
+	tx, err := s.Factory.GenerateSignedEthTx(sender.Priv, types.EvmTxArgs{
+		To:       &recipient,
+		Amount:   big.NewInt(100),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1000000000),
+	})
+	s.Require().NoError(err)
+	res, err = k.EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().Contains(res.VmError, types.ErrRejectedByHook.Error())
+	s.Require().Nil(res.Logs)
+	s.Require().Equal(uint64(21000), res.GasUsed)
+
+	// the state changes of the rejected message are discarded
+	s.Require().Equal(balance, bankKeeper.GetBalance(ctx, recipient.Bytes(), denom))
+}
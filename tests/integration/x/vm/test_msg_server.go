@@ -260,3 +260,92 @@ func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 		s.Require().NoError(err)
 	}
 }
+
+func (s *KeeperTestSuite) TestScheduleHardFork() {
+	s.SetupTest()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	blockTime := uint64(s.Network.GetContext().BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	osakaTime := blockTime + 3600
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgScheduleHardFork
+		expectedErr string
+	}{
+		{
+			name:        "fail - invalid authority",
+			msg:         &types.MsgScheduleHardFork{Authority: "foobar", Name: "osaka", Activation: osakaTime},
+			expectedErr: govtypes.ErrInvalidSigner.Error(),
+		},
+		{
+			name:        "fail - unknown hard fork",
+			msg:         &types.MsgScheduleHardFork{Authority: authority, Name: "amsterdam", Activation: osakaTime},
+			expectedErr: "unknown hard fork",
+		},
+		{
+			name:        "fail - already active hard fork",
+			msg:         &types.MsgScheduleHardFork{Authority: authority, Name: "prague", Activation: osakaTime},
+			expectedErr: "hard fork prague is already active",
+		},
+		{
+			name:        "fail - activation in the past",
+			msg:         &types.MsgScheduleHardFork{Authority: authority, Name: "osaka", Activation: blockTime},
+			expectedErr: "must be scheduled in the future",
+		},
+		{
+			name:        "pass - future activation",
+			msg:         &types.MsgScheduleHardFork{Authority: authority, Name: "osaka", Activation: osakaTime},
+			expectedErr: "",
+		},
+	}
+
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := k.ScheduleHardFork(ctx, tc.msg)
+			if tc.expectedErr != "" {
+				s.Require().ErrorContains(err, tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+	// the keeper reads the stored chain config, cached at the beginning of the
+	// blocks, while the global one is left unchanged
+	s.Require().NotNil(k.GetEthChainConfig(ctx).OsakaTime)
+	s.Require().Equal(osakaTime, *k.GetEthChainConfig(ctx).OsakaTime)
+	s.Require().NoError(k.BeginBlock(ctx))
+	s.Require().Equal(osakaTime, *k.GetEthChainConfig(ctx).OsakaTime)
+	s.Require().Nil(types.GetEthChainConfig().OsakaTime)
+	configRes, err := k.Config(ctx, &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(osakaTime, configRes.Config.OsakaTime.Uint64())
+
+	res, err := k.HardForks(ctx, &types.QueryHardForksRequest{})
+	s.Require().NoError(err)
+	last := res.HardForks[len(res.HardForks)-1]
+	s.Require().Equal(types.HardFork{Name: "osaka", Activation: osakaTime, TimeBased: true, Active: false}, last)
+
+	// the scheduled hard fork can be changed until it is active
+	cfg := *k.GetChainConfig(ctx)
+	cfg.OsakaTime = nil
+	_, err = k.UpdateChainConfig(ctx, &types.MsgUpdateChainConfig{Authority: authority, Config: cfg})
+	s.Require().NoError(err)
+
+	cfg.ChainId++
+	_, err = k.UpdateChainConfig(ctx, &types.MsgUpdateChainConfig{Authority: authority, Config: cfg})
+	s.Require().ErrorContains(err, "chain id cannot be changed")
+
+	s.Require().Nil(k.GetEthChainConfig(ctx).OsakaTime)
+	configRes, err = k.Config(ctx, &types.QueryConfigRequest{})
+	s.Require().NoError(err)
+	s.Require().Nil(configRes.Config.OsakaTime)
+
+	// the cached chain config is discarded with the state of a failed update
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = k.ScheduleHardFork(cacheCtx, &types.MsgScheduleHardFork{Authority: authority, Name: "osaka", Activation: osakaTime})
+	s.Require().NoError(err)
+	s.Require().Equal(osakaTime, *k.GetEthChainConfig(cacheCtx).OsakaTime)
+	s.Require().Nil(k.GetEthChainConfig(ctx).OsakaTime)
+}
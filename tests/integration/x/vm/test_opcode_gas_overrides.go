@@ -0,0 +1,74 @@
+package vm
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestOpcodeGasOverrides() {
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext().WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// contract creation executing ADDRESS, POP and STOP
+	from := s.Keyring.GetAddr(0)
+	code := hexutil.Bytes{byte(vm.ADDRESS), byte(vm.POP), byte(vm.STOP)}
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, Input: &code})
+	s.Require().NoError(err)
+	req := &types.EthCallRequest{Args: args, GasCap: 25_000_000}
+
+	// gasUsed returns the gas estimated for the call and the cost of the ADDRESS opcode in its trace
+	gasUsed := func() (uint64, uint64) {
+		estimate, err := k.EstimateGas(ctx, req)
+		s.Require().NoError(err)
+		call, err := k.EthCall(ctx, req)
+		s.Require().NoError(err)
+		s.Require().Empty(call.VmError)
+
+		traceRes, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:            args,
+			GasCap:          25_000_000,
+			BlockNumber:     ctx.BlockHeight(),
+			BlockTime:       ctx.BlockTime(),
+			BlockHash:       common.BytesToHash(ctx.HeaderHash()).Hex(),
+			ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+			ChainId:         s.Network.GetEIP155ChainID().Int64(),
+		})
+		s.Require().NoError(err)
+		var trace struct {
+			StructLogs []struct {
+				Op      string `json:"op"`
+				GasCost uint64 `json:"gasCost"`
+			} `json:"structLogs"`
+		}
+		s.Require().NoError(json.Unmarshal(traceRes.Data, &trace))
+		s.Require().NotEmpty(trace.StructLogs)
+		s.Require().Equal(vm.ADDRESS.String(), trace.StructLogs[0].Op)
+		return estimate.Gas, trace.StructLogs[0].GasCost
+	}
+
+	defaultGas, addressCost := gasUsed()
+	s.Require().Equal(uint64(2), addressCost)
+
+	params := k.GetParams(ctx)
+	params.OpcodeGasOverrides = []types.OpcodeGasOverride{{Opcode: "ADDRESS", ConstantGas: 1002}}
+	s.Require().NoError(k.SetParams(ctx, params))
+
+	gas, addressCost := gasUsed()
+	s.Require().Equal(uint64(1002), addressCost)
+	s.Require().Equal(defaultGas+1000, gas)
+
+	// the overrides are removed with the params
+	params.OpcodeGasOverrides = nil
+	s.Require().NoError(k.SetParams(ctx, params))
+	gas, _ = gasUsed()
+	s.Require().Equal(defaultGas, gas)
+}
@@ -6,10 +6,13 @@ import (
 	"math"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
@@ -886,6 +889,7 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 		overrides          *rpctypes.StateOverride
 		expErr             bool
 		expVMErr           bool
+		expRevertReason    string
 		expectedGasUsed    uint64
 		postCheck          func()
 	}{
@@ -1078,6 +1082,40 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 			expectedGasUsed:    0,
 			postCheck:          nil,
 		},
+		{
+			name: "call contract function with restricted function access control",
+			getMessage: func() core.Message {
+				sender := s.Keyring.GetKey(0)
+				recipient := s.Keyring.GetAddr(1)
+				msg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+					To:     &recipient,
+					Amount: big.NewInt(100),
+					Input:  []byte("contract_data"),
+				})
+				s.Require().NoError(err)
+				return *msg
+			},
+			getEVMParams: func() types.Params {
+				defaultParams := types.DefaultParams()
+				defaultParams.AccessControl.Contracts = []types.ContractAccessControl{{
+					Address: s.Keyring.GetAddr(1).String(),
+					Functions: []types.FunctionAccessControl{{
+						Selector: hexutil.Encode([]byte("cont")),
+						Call: types.AccessControlType{
+							AccessType: types.AccessTypeRestricted,
+						},
+					}},
+				}}
+				return defaultParams
+			},
+			getFeeMarketParams: feemarkettypes.DefaultParams,
+			overrides:          nil,
+			expErr:             false,
+			expVMErr:           true,
+			expRevertReason:    fmt.Sprintf("calls to function 0x636f6e74 of contract %s are restricted", s.Keyring.GetAddr(1)),
+			expectedGasUsed:    0,
+			postCheck:          nil,
+		},
 		{
 			name: "create contract tx with config param EnableCreate = false",
 			getMessage: func() core.Message {
@@ -1131,6 +1169,94 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 			expectedGasUsed: 0,
 			postCheck:       nil,
 		},
+		{
+			name: "fail - init code exceeds the max init code size of the params",
+			getMessage: func() core.Message {
+				sender := s.Keyring.GetKey(0)
+				msg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+					Input:    make([]byte, 65),
+					GasLimit: 100_000,
+				})
+				s.Require().NoError(err)
+				return *msg
+			},
+			getEVMParams: func() types.Params {
+				defaultParams := types.DefaultParams()
+				defaultParams.MaxInitCodeSize = 64
+				return defaultParams
+			},
+			getFeeMarketParams: feemarkettypes.DefaultParams,
+			overrides:          nil,
+			expErr:             true,
+			expVMErr:           false,
+			expectedGasUsed:    0,
+			postCheck:          nil,
+		},
+		{
+			name: "fail - deployed code exceeds the max code size of the params",
+			getMessage: func() core.Message {
+				sender := s.Keyring.GetKey(0)
+				// returns 32 bytes of code: PUSH1 0x20 PUSH1 0x00 RETURN
+				msg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+					Input:    []byte{0x60, 0x20, 0x60, 0x00, 0xf3},
+					GasLimit: 100_000,
+				})
+				s.Require().NoError(err)
+				return *msg
+			},
+			getEVMParams: func() types.Params {
+				defaultParams := types.DefaultParams()
+				defaultParams.MaxCodeSize = 16
+				return defaultParams
+			},
+			getFeeMarketParams: feemarkettypes.DefaultParams,
+			overrides:          nil,
+			expErr:             false,
+			expVMErr:           true,
+			expectedGasUsed:    0,
+			postCheck:          nil,
+		},
+		{
+			name: "success - refund capped by the refund quotient of the params",
+			getMessage: func() core.Message {
+				authority := s.Keyring.GetKey(0)
+				target := s.Keyring.GetAddr(1)
+
+				accResp, err := s.Handler.GetEvmAccount(authority.Addr)
+				s.Require().NoError(err)
+
+				auth := gethtypes.SetCodeAuthorization{
+					ChainID: *uint256.NewInt(types.GetChainConfig().GetChainId()),
+					Address: target,
+					Nonce:   accResp.GetNonce(),
+				}
+
+				signedAuth := s.SignSetCodeAuthorization(authority, auth)
+
+				msg, err := s.Factory.GenerateGethCoreMsg(authority.Priv, types.EvmTxArgs{
+					To:                &common.Address{},
+					AuthorizationList: []gethtypes.SetCodeAuthorization{signedAuth},
+				})
+				s.Require().NoError(err)
+				return *msg
+			},
+			getEVMParams: func() types.Params {
+				defaultParams := types.DefaultParams()
+				defaultParams.RefundQuotient = 10
+				return defaultParams
+			},
+			getFeeMarketParams: feemarkettypes.DefaultParams,
+			overrides:          nil,
+			expErr:             false,
+			expVMErr:           false,
+			expectedGasUsed: params.TxGas + params.CallNewAccountGas -
+				keeper.GasToRefund(
+					params.CallNewAccountGas-params.TxAuthTupleGas,
+					params.TxGas+params.CallNewAccountGas,
+					10,
+				),
+			postCheck: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1187,6 +1313,11 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 			if tc.expVMErr {
 				s.Require().NoError(err)
 				s.Require().NotEmpty(res.VmError)
+				if tc.expRevertReason != "" {
+					reason, err := abi.UnpackRevert(res.Revert())
+					s.Require().NoError(err)
+					s.Require().Equal(tc.expRevertReason, reason)
+				}
 				return
 			}
 
@@ -1195,6 +1326,133 @@ func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCodeSizeLimitsOfFactoryCreations() {
+	s.SetupTest()
+
+	// factoryCode returns the init code of a factory creating a contract with the given size of
+	// init code, which deploys 32 bytes of code, and storing its address in the slot 0
+	factoryCode := func(initCodeSize byte) []byte {
+		return []byte{
+			byte(vm.PUSH5), byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+			byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+			byte(vm.PUSH1), initCodeSize, byte(vm.PUSH1), 0x1b, byte(vm.PUSH1), 0x00, byte(vm.CREATE),
+			byte(vm.PUSH1), 0x00, byte(vm.SSTORE),
+			byte(vm.STOP),
+		}
+	}
+
+	// the limits of the params bound the creation transactions only, the creations of the
+	// contracts being bound by the Ethereum ones
+	testCases := []struct {
+		name            string
+		initCodeSize    byte
+		maxCodeSize     uint64
+		maxInitCodeSize uint64
+	}{
+		{"contract created with the default limits", 5, 0, 0},
+		{"deployed code exceeds the max code size of the params", 5, 16, 0},
+		{"init code exceeds the max init code size of the params", 40, 0, 32},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			k := s.Network.App.GetEVMKeeper()
+			ctx := s.Network.GetContext()
+
+			evmParams := types.DefaultParams()
+			evmParams.MaxCodeSize = tc.maxCodeSize
+			evmParams.MaxInitCodeSize = tc.maxInitCodeSize
+			s.Require().NoError(k.SetParams(ctx, evmParams))
+
+			sender := s.Keyring.GetKey(0)
+			msg, err := s.Factory.GenerateGethCoreMsg(sender.Priv, types.EvmTxArgs{
+				Input:    factoryCode(tc.initCodeSize),
+				GasLimit: 500_000,
+			})
+			s.Require().NoError(err)
+			factoryAddr := crypto.CreateAddress(sender.Addr, msg.Nonce)
+			createdAddr := crypto.CreateAddress(factoryAddr, 1)
+
+			config, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+			s.Require().NoError(err)
+			res, err := k.ApplyMessageWithConfig(ctx, *msg, nil, true, config, k.TxConfig(ctx, common.Hash{}), false, nil)
+			s.Require().NoError(err)
+			s.Require().False(res.Failed())
+
+			s.Require().Equal(common.BytesToHash(createdAddr.Bytes()), k.GetState(ctx, factoryAddr, common.Hash{}))
+			s.Require().Len(k.GetCode(ctx, k.GetCodeHash(ctx, createdAddr)), 32)
+			s.Require().Equal(uint64(2), k.GetNonce(ctx, factoryAddr))
+
+			s.Require().NoError(s.Network.NextBlock())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRestrictedCallRevertReason() {
+	s.SetupTest()
+	k := s.Network.App.GetEVMKeeper()
+	ctx := s.Network.GetContext()
+
+	target := s.Keyring.GetAddr(1)
+	evmParams := types.DefaultParams()
+	evmParams.AccessControl.Contracts = []types.ContractAccessControl{{
+		Address: target.String(),
+		Functions: []types.FunctionAccessControl{{
+			Selector: hexutil.Encode([]byte("cont")),
+			Call:     types.AccessControlType{AccessType: types.AccessTypeRestricted},
+		}},
+	}}
+	s.Require().NoError(k.SetParams(ctx, evmParams))
+
+	// proxy forwarding its input to the target and returning the success of the call, followed
+	// by the size of its return data
+	proxyCode := []byte{
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00,
+		byte(vm.PUSH20),
+	}
+	proxyCode = append(proxyCode, target.Bytes()...)
+	proxyCode = append(proxyCode,
+		byte(vm.GAS), byte(vm.CALL), byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0x20, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0x40, byte(vm.PUSH1), 0x00, byte(vm.RETURN),
+	)
+	proxy := utiltx.GenerateAddress()
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(proxy, proxyCode)
+	s.Require().NoError(stateDB.Commit())
+
+	applyMessage := func(to common.Address, input []byte) *types.MsgEthereumTxResponse {
+		msg, err := s.Factory.GenerateGethCoreMsg(s.Keyring.GetKey(0).Priv, types.EvmTxArgs{
+			To:       &to,
+			Input:    input,
+			GasLimit: 200_000,
+		})
+		s.Require().NoError(err)
+		config, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+		s.Require().NoError(err)
+		res, err := k.ApplyMessageWithConfig(ctx, *msg, nil, true, config, k.TxConfig(ctx, common.Hash{}), false, nil)
+		s.Require().NoError(err)
+		return res
+	}
+
+	// the reason of the denied message call is returned
+	res := applyMessage(target, []byte("contract_data"))
+	s.Require().True(res.Failed())
+	reason, err := abi.UnpackRevert(res.Ret)
+	s.Require().NoError(err)
+	s.Require().Equal(fmt.Sprintf("calls to function 0x636f6e74 of contract %s are restricted", target), reason)
+
+	// the nested calls are checked with their input, the denied ones failing without return data
+	res = applyMessage(proxy, []byte("contract_data"))
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Equal(make([]byte, 64), res.Ret)
+
+	res = applyMessage(proxy, []byte("other_data"))
+	s.Require().False(res.Failed(), res.VmError)
+	s.Require().Equal(append(common.LeftPadBytes([]byte{1}, 32), make([]byte, 32)...), res.Ret)
+}
+
 func (s *KeeperTestSuite) TestGetProposerAddress() {
 	s.SetupTest()
 	address := sdk.ConsAddress(s.Keyring.GetAddr(0).Bytes())
@@ -35,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetHardForksCmd(),
+		GetContractAccessControlCmd(),
+		GetOpcodeGasScheduleCmd(),
 		HexToBech32Cmd(),
 		Bech32ToHexCmd(),
 		GetBankBalanceCmd(),
@@ -213,6 +216,67 @@ func GetConfigCmd() *cobra.Command {
 	return cmd
 }
 
+// GetHardForksCmd queries the hard forks of the evm configuration
+func GetHardForksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hard-forks",
+		Short: "Get the evm hard forks",
+		Long:  "Get the hard forks of the evm configuration, with their activation height or timestamp and whether they are active.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HardForks(cmd.Context(), &types.QueryHardForksRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetContractAccessControlCmd queries the permission policies of a contract
+func GetContractAccessControlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-access-control ADDRESS",
+		Short: "Get the permission policies for calling a contract",
+		Long:  "Get the permission policies for calling a contract and its functions.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractAccessControl(cmd.Context(), &types.QueryContractAccessControlRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetConfigCmd queries the evm configuration
 func HexToBech32Cmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -342,3 +406,31 @@ func GetERC20BalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetOpcodeGasScheduleCmd queries the opcode gas overrides of the evm params
+func GetOpcodeGasScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opcode-gas-schedule",
+		Short: "Get the evm opcode gas overrides",
+		Long:  "Get the opcodes with a constant gas overridden by the evm params, with their constant gas in the active hard fork.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OpcodeGasSchedule(cmd.Context(), &types.QueryOpcodeGasScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
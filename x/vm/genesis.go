@@ -73,6 +73,12 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	if data.ChainConfig != nil {
+		if err := k.SetChainConfig(ctx, *data.ChainConfig); err != nil {
+			panic(fmt.Errorf("error setting chain config: %s", err))
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -92,8 +98,11 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	chainConfig, _ := k.GetStoredChainConfig(ctx)
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:    ethGenAccounts,
+		Params:      k.GetParams(ctx),
+		ChainConfig: chainConfig,
 	}
 }
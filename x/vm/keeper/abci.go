@@ -10,12 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// BeginBlock caches the chain config of the block and emits a base fee event
+// which will be adjusted to the evm decimals
 func (k *Keeper) BeginBlock(ctx sdk.Context) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "BeginBlock", trace.WithAttributes(attribute.Int64("block_num", ctx.BlockHeight())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	logger := ctx.Logger().With("begin_block", "evm")
 
+	k.CacheChainConfig(ctx)
+
 	// Base fee is already set on FeeMarket BeginBlock
 	// that runs before this one
 	// We emit this event on the EVM and FeeMarket modules
@@ -35,6 +38,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) (err error) {
 	}
 
 	k.SetHeaderHash(ctx)
+	k.liveTracerBlockStart(ctx)
 	return nil
 }
 
@@ -50,6 +54,7 @@ func (k *Keeper) EndBlock(ctx sdk.Context) (err error) {
 
 	k.CollectTxBloom(ctx)
 	k.ResetTransientGasUsed(ctx)
+	k.liveTracerBlockEnd(ctx)
 
 	return nil
 }
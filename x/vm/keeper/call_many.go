@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// callBundles applies the predecessors, the state overrides and then the calls
+// of every bundle one after another. As for the simulated blocks, all of them
+// share a single branch of the state, so that every call sees the changes of
+// the previous ones. The EVM is stopped once the timeout is reached.
+func (k *Keeper) callBundles(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	bundles []rpctypes.Bundle,
+	predecessors []*types.MsgEthereumTx,
+	overrides *rpctypes.StateOverride,
+	gasCap uint64,
+	validate bool,
+	timeout time.Duration,
+) (_ [][]*rpctypes.BundleCallResult, err error) {
+	ctx, span := ctx.StartSpan(tracer, "callBundles", trace.WithAttributes(
+		attribute.Int("bundle_count", len(bundles)),
+		attribute.Int("predecessor_count", len(predecessors)),
+		attribute.Bool("validate", validate),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(bundles) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bundles")
+	}
+
+	if timeout > 0 {
+		deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+		defer cancel()
+		ctx = withEVMCancellation(ctx.WithContext(deadlineCtx))
+	}
+	timedOut := func() bool {
+		return timeout > 0 && ctx.Context().Err() != nil
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	for i, tx := range predecessors {
+		ethTx := tx.AsTransaction()
+		msg, err := core.TransactionToMessage(ethTx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		if err := k.incrementSimNonce(ctx, msg); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txConfig := statedb.NewTxConfig(ethTx.Hash(), uint(i)) //nolint:gosec // G115 // won't exceed uint64
+		// the invalid predecessors are ignored, as done by TraceTx
+		_, _ = k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, nil, true, cfg, txConfig, false, nil)
+	}
+
+	if overrides != nil {
+		if err := k.applySimStateOverrides(ctx, overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// the gas cap is the budget of all the calls, with no limit if zero
+	var gasBudget *uint64
+	if gasCap > 0 {
+		gasBudget = &gasCap
+	}
+
+	txIndex := uint(len(predecessors))
+	results := make([][]*rpctypes.BundleCallResult, 0, len(bundles))
+	for _, bundle := range bundles {
+		bundleCfg := *cfg
+		bundleCtx, gasLimit, err := applyBlockOverrides(ctx, &bundleCfg, bundle.BlockOverride.ToBlockOverrides())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var gasUsed uint64
+		bundleResults := make([]*rpctypes.BundleCallResult, 0, len(bundle.Transactions))
+		for _, args := range bundle.Transactions {
+			if timedOut() {
+				return nil, status.Errorf(codes.DeadlineExceeded, "execution aborted (timeout = %s)", timeout)
+			}
+
+			result, err := k.applyBundleCall(bundleCtx, &bundleCfg, args, txIndex, gasLimit-gasUsed, gasBudget, validate)
+			if err != nil {
+				return nil, err
+			}
+			// the call may have been stopped by the deadline
+			if timedOut() {
+				return nil, status.Errorf(codes.DeadlineExceeded, "execution aborted (timeout = %s)", timeout)
+			}
+			gasUsed += uint64(result.GasUsed)
+			txIndex++
+			bundleResults = append(bundleResults, result)
+		}
+		results = append(results, bundleResults)
+	}
+	return results, nil
+}
+
+// applyBundleCall fills in the defaults of the call arguments and applies the
+// call, reporting the value sent to the coinbase. When validating, the call is
+// checked and charged as a transaction of the block. The gas used is taken from
+// the gas budget, unless it is nil.
+func (k *Keeper) applyBundleCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.TransactionArgs,
+	txIndex uint,
+	remainingGas uint64,
+	gasBudget *uint64,
+	validate bool,
+) (*rpctypes.BundleCallResult, error) {
+	var gasCap uint64
+	if gasBudget != nil {
+		if *gasBudget == 0 {
+			return nil, status.Error(codes.InvalidArgument, "gas cap reached")
+		}
+		gasCap = *gasBudget
+	}
+
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	if args.Gas == nil {
+		gas := remainingGas
+		if gasCap > 0 {
+			gas = min(gas, gasCap)
+		}
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if uint64(*args.Gas) > remainingGas {
+		return nil, status.Errorf(codes.InvalidArgument, "block gas limit reached: %d > %d", *args.Gas, remainingGas)
+	}
+
+	if err := args.CallDefaults(gasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	msg := args.ToMessage(cfg.BaseFee, !validate, !validate)
+	if validate {
+		if err := k.validateSimMessage(ctx, msg, nonce, cfg.BaseFee); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+	txConfig := statedb.NewTxConfig(tx.Hash(), txIndex)
+
+	if err := k.incrementSimNonce(ctx, msg); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	coinbaseBalance := k.GetBalance(ctx, cfg.CoinBase).ToBig()
+	// pass true to commit the StateDB into the branch shared by the bundles
+	res, err := k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, nil, true, cfg, txConfig, false, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sentToCoinbase := new(big.Int).Sub(k.GetBalance(ctx, cfg.CoinBase).ToBig(), coinbaseBalance)
+
+	if validate {
+		if err := k.chargeSimGas(ctx, msg, res.GasUsed); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if gasBudget != nil {
+		*gasBudget -= min(*gasBudget, res.GasUsed)
+	}
+
+	return &rpctypes.BundleCallResult{
+		TxHash:            tx.Hash(),
+		From:              msg.From,
+		To:                msg.To,
+		ReturnValue:       res.Ret,
+		GasUsed:           hexutil.Uint64(res.GasUsed),
+		GasPrice:          (*hexutil.Big)(msg.GasPrice),
+		EthSentToCoinbase: (*hexutil.Big)(sentToCoinbase),
+		VMError:           res.VmError,
+	}, nil
+}
+
+// evmCancellationKey marks the contexts whose EVMs are stopped once their go
+// context is done.
+type evmCancellationKey struct{}
+
+// withEVMCancellation returns the context with the EVMs of its messages being
+// stopped once its go context is done, as for a deadline.
+func withEVMCancellation(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(evmCancellationKey{}, true)
+}
+
+// isEVMCancellable returns whether the EVMs of the context messages are stopped
+// once its go context is done.
+func isEVMCancellable(ctx sdk.Context) bool {
+	cancellable, _ := ctx.Value(evmCancellationKey{}).(bool)
+	return cancellable
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	gethparams "github.com/ethereum/go-ethereum/params"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// chainConfigEntry is the chain config cached in the object store for a block,
+// along with its geth counterpart.
+type chainConfigEntry struct {
+	height int64
+	cfg    *types.ChainConfig
+	ethCfg *gethparams.ChainConfig
+}
+
+// GetChainConfig returns the chain config stored by governance, or the chain
+// config of the application if none is stored.
+func (k Keeper) GetChainConfig(ctx sdk.Context) *types.ChainConfig {
+	return k.getChainConfigEntry(ctx).cfg
+}
+
+// GetEthChainConfig returns the chain config used in the EVM (geth type), read
+// from the store so that it follows the updates of governance.
+func (k Keeper) GetEthChainConfig(ctx sdk.Context) *gethparams.ChainConfig {
+	return k.getChainConfigEntry(ctx).ethCfg
+}
+
+// CacheChainConfig loads the chain config and caches it in the object store for
+// the current block. It is called in BeginBlock.
+func (k Keeper) CacheChainConfig(ctx sdk.Context) {
+	k.setChainConfigEntry(ctx, k.loadChainConfigEntry(ctx))
+}
+
+// getChainConfigEntry returns the chain config cached for the current block, or
+// loads it from the store when it is not cached (e.g. in CheckTx and queries).
+// The reads don't consume gas, so that the gas used doesn't depend on the cache.
+func (k Keeper) getChainConfigEntry(ctx sdk.Context) chainConfigEntry {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if v := ctx.ObjectStore(k.objectKey).Get(types.KeyPrefixObjectChainConfig); v != nil {
+		if entry := v.(chainConfigEntry); entry.height == ctx.BlockHeight() {
+			return entry
+		}
+	}
+	return k.loadChainConfigEntry(ctx)
+}
+
+// setChainConfigEntry caches the chain config in the object store. As the object
+// store is branched with the context, the entry is discarded if the context is.
+func (k Keeper) setChainConfigEntry(ctx sdk.Context, entry chainConfigEntry) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.ObjectStore(k.objectKey).Set(types.KeyPrefixObjectChainConfig, entry)
+}
+
+func (k Keeper) loadChainConfigEntry(ctx sdk.Context) chainConfigEntry {
+	cfg, found := k.GetStoredChainConfig(ctx)
+	if !found {
+		cfg = k.defaultChainConfig
+	}
+	return newChainConfigEntry(ctx, cfg)
+}
+
+func newChainConfigEntry(ctx sdk.Context, cfg *types.ChainConfig) chainConfigEntry {
+	return chainConfigEntry{height: ctx.BlockHeight(), cfg: cfg, ethCfg: cfg.EthereumConfig(nil)}
+}
+
+// GetStoredChainConfig returns the chain config stored by governance.
+func (k Keeper) GetStoredChainConfig(ctx sdk.Context) (*types.ChainConfig, bool) {
+	ctx, span := ctx.StartSpan(tracer, "GetStoredChainConfig")
+	defer span.End()
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixChainConfig)
+	if bz == nil {
+		return nil, false
+	}
+	var cfg types.ChainConfig
+	k.cdc.MustUnmarshal(bz, &cfg)
+	return &cfg, true
+}
+
+// SetChainConfig stores the chain config, which is used in the EVM by the
+// following state transitions, including the ones of the current block.
+func (k Keeper) SetChainConfig(ctx sdk.Context, cfg types.ChainConfig) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "SetChainConfig")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixChainConfig, bz)
+	k.setChainConfigEntry(ctx, newChainConfigEntry(ctx, &cfg))
+	return nil
+}
+
+// updateChainConfig validates the update of the chain config at the current
+// block and stores the updated chain config.
+func (k Keeper) updateChainConfig(ctx sdk.Context, updated types.ChainConfig) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "updateChainConfig", trace.WithAttributes(
+		attribute.Int64("block_height", ctx.BlockHeight()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	current := *k.GetChainConfig(ctx)
+	// the denom and decimals of the chain config are replaced by the ones of the
+	// EVM coin info when queried
+	updated.Denom, updated.Decimals = current.Denom, current.Decimals
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	if err := types.ValidateChainConfigUpdate(current, updated, ctx.BlockHeight(), blockTime); err != nil {
+		return err
+	}
+	return k.SetChainConfig(ctx, updated)
+}
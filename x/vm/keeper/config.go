@@ -48,8 +48,9 @@ func (k *Keeper) TxConfig(ctx sdk.Context, txHash common.Hash) statedb.TxConfig
 	)
 }
 
-// VMConfig creates an EVM configuration from the debug setting and the extra EIPs enabled on the
-// module parameters. The config generated uses the default JumpTable from the EVM.
+// VMConfig creates an EVM configuration from the debug setting, the extra EIPs enabled on the
+// module parameters and the opcode gas overrides, which are applied after them to the default
+// JumpTable from the EVM.
 func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig, tracingHooks *tracing.Hooks) vm.Config {
 	ctx, span := ctx.StartSpan(tracer, "VMConfig", trace.WithAttributes(
 		attribute.Bool("enable_preimage_recording", cfg.EnablePreimageRecording),
@@ -57,7 +58,7 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 	))
 	defer span.End()
 	noBaseFee := true
-	if types.IsLondon(types.GetEthChainConfig(), ctx.BlockHeight()) {
+	if types.IsLondon(k.GetEthChainConfig(ctx), ctx.BlockHeight()) {
 		noBaseFee = cfg.FeeMarketParams.NoBaseFee
 	}
 
@@ -65,6 +66,6 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		EnablePreimageRecording: cfg.EnablePreimageRecording,
 		Tracer:                  tracingHooks,
 		NoBaseFee:               noBaseFee,
-		ExtraEips:               cfg.Params.EIPs(),
+		ExtraEips:               append(cfg.Params.EIPs(), types.OpcodeGasEIPs(cfg.Params.OpcodeGasOverrides)...),
 	}
 }
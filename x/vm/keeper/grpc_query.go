@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -30,10 +31,14 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -237,6 +242,132 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (_ *types.Q
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method. The storage
+// entries of the account are returned ordered by key, the pagination key being
+// the storage key to start from.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (_ *types.QueryStorageRangeResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "StorageRange", trace.WithAttributes(
+		attribute.String("address", req.Address),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage types.Storage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Accounts implements the Query/Accounts gRPC method. The accounts of the
+// account keeper are returned ordered by address, the pagination key being the
+// address to start from. Only the accounts with an Ethereum address are
+// returned.
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (_ *types.QueryAccountsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "Accounts")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var (
+		start []byte
+		limit = uint64(query.DefaultLimit)
+	)
+	if req.Pagination != nil {
+		if req.Pagination.Offset > 0 || req.Pagination.CountTotal || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "only key based forward pagination is supported")
+		}
+		start = req.Pagination.Key
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	accounts := make([]types.EthAccount, 0)
+	pageRes := &query.PageResponse{}
+	err = k.iterateAccountsFrom(ctx, start, func(addr sdk.AccAddress) bool {
+		if len(addr) != common.AddressLength {
+			return false
+		}
+		if uint64(len(accounts)) == limit {
+			pageRes.NextKey = addr
+			return true
+		}
+
+		address := common.BytesToAddress(addr)
+		acct := k.GetAccountOrEmpty(ctx, address)
+		ethAccount := types.EthAccount{
+			Address:  address.Hex(),
+			Balance:  acct.Balance.String(),
+			Nonce:    acct.Nonce,
+			CodeHash: common.BytesToHash(acct.CodeHash).Hex(),
+		}
+		if !req.SkipCode && acct.HasCodeHash() {
+			ethAccount.Code = k.GetCode(ctx, common.BytesToHash(acct.CodeHash))
+		}
+		accounts = append(accounts, ethAccount)
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// iterateAccountsFrom iterates the addresses of the accounts in ascending
+// order, starting at the start address, until cb returns true. The accounts of
+// the SDK account keeper are iterated over a range of its collection, while the
+// other account keepers are iterated from their first account.
+func (k Keeper) iterateAccountsFrom(ctx sdk.Context, start []byte, cb func(addr sdk.AccAddress) (stop bool)) error {
+	if ak, ok := k.accountKeeper.(authkeeper.AccountKeeper); ok {
+		var ranger collections.Ranger[sdk.AccAddress]
+		if len(start) > 0 {
+			ranger = new(collections.Range[sdk.AccAddress]).StartInclusive(start)
+		}
+		return ak.Accounts.Walk(ctx, ranger, func(addr sdk.AccAddress, _ sdk.AccountI) (bool, error) {
+			return cb(addr), nil
+		})
+	}
+
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		addr := account.GetAddress()
+		if bytes.Compare(addr, start) < 0 {
+			return false
+		}
+		return cb(addr)
+	})
+	return nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (_ *types.QueryParamsResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -283,7 +414,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (_ *types.
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -376,7 +507,7 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	if args.Gas == nil {
 		args.Gas = new(hexutil.Uint64)
 	}
-	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -517,6 +648,115 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// SimulateV1 implements eth_simulateV1 rpc api. The blocks are simulated
+// sequentially on top of the state of the requested block, each call seeing
+// the changes of the previous ones. The response is the JSON encoded list of
+// simulated blocks.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (_ *types.SimulateV1Response, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "SimulateV1", trace.WithAttributes(attribute.String("proposer", req.ProposerAddress.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid simulation options format: %s", err.Error()))
+	}
+
+	var timeout time.Duration
+	if req.Timeout != "" {
+		if timeout, err = time.ParseDuration(req.Timeout); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
+		}
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blocks, err := k.simulateBlocks(ctx, cfg, opts, req.GasCap, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: data}, nil
+}
+
+// CallMany implements eth_callMany and eth_callBundle rpc apis. The calls of
+// the bundles are applied one after another on top of the state of the
+// requested block, after its predecessors when they are given. The response is
+// the JSON encoded list of call results of every bundle.
+func (k Keeper) CallMany(c context.Context, req *types.CallManyRequest) (_ *types.CallManyResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "CallMany", trace.WithAttributes(
+		attribute.Int64("block_number", req.BlockNumber),
+		attribute.Int("predecessor_count", len(req.Predecessors)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var bundles []rpctypes.Bundle
+	if err := json.Unmarshal(req.Bundles, &bundles); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid bundles format: %s", err.Error()))
+	}
+
+	var overrides *rpctypes.StateOverride
+	if len(req.Overrides) > 0 {
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid state overrides format: %s", err.Error()))
+		}
+	}
+
+	var timeout time.Duration
+	if req.Timeout != "" {
+		if timeout, err = time.ParseDuration(req.Timeout); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "timeout value: %s", err.Error())
+		}
+	}
+
+	if len(req.Predecessors) > maxTracePredecessors {
+		return nil, status.Errorf(codes.InvalidArgument, "too many predecessors, got %d: limit %d", len(req.Predecessors), maxTracePredecessors)
+	}
+
+	// the caller sets the `ctx.BlockHeight()` to be `BlockNumber - 1` to apply
+	// the predecessors from the beginning of the block
+	if req.BlockNumber > 0 {
+		if req.BlockNumber > ctx.BlockHeight()+1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", req.BlockNumber, ctx.BlockHeight())
+		}
+		ctx = ctx.WithBlockHeight(req.BlockNumber).WithBlockTime(req.BlockTime)
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results, err := k.callBundles(ctx, cfg, bundles, req.Predecessors, overrides, req.GasCap, req.Validate, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.CallManyResponse{Data: data}, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer-dependent.
@@ -578,7 +818,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (_ *t
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txConfig := statedb.NewEmptyTxConfig()
 
 	// gas used at this point corresponds to GetProposerAddress & CalculateBaseFee
@@ -676,7 +916,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		cfg.BaseFee = baseFee
 	}
 
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txsLength := len(req.Txs)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
@@ -765,7 +1005,7 @@ func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 	// Fill in default values for missing transaction fields (gas, gasPrice, value, etc.)
-	if err := args.CallDefaults(req.GasCap, baseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(req.GasCap, baseFee, k.GetEthChainConfig(ctx).ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	msg := args.ToMessage(baseFee, true, true)
@@ -842,7 +1082,7 @@ func (k *Keeper) traceTxWithMsg(
 	}
 
 	if traceConfig.Overrides != nil {
-		overrides = traceConfig.Overrides.EthereumConfig(types.GetEthChainConfig().ChainID)
+		overrides = traceConfig.Overrides.EthereumConfig(k.GetEthChainConfig(ctx).ChainID)
 	}
 
 	logConfig := logger.Config{
@@ -862,9 +1102,10 @@ func (k *Keeper) traceTxWithMsg(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: common.BytesToHash(ctx.HeaderHash()),
-		TxIndex:   int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
-		TxHash:    txConfig.TxHash,
+		BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex), //#nosec G115 -- int overflow is not a concern here
+		TxHash:      txConfig.TxHash,
 	}
 
 	if traceConfig.Tracer != "" {
@@ -873,7 +1114,7 @@ func (k *Keeper) traceTxWithMsg(
 			cfg = json.RawMessage(traceConfig.TracerJsonConfig)
 		}
 		if tracer, err = tracers.DefaultDirectory.New(traceConfig.Tracer, tCtx, cfg,
-			types.GetEthChainConfig()); err != nil {
+			k.GetEthChainConfig(ctx)); err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -939,14 +1180,92 @@ func (k Keeper) GlobalMinGasPrice(c context.Context, _ *types.QueryGlobalMinGasP
 }
 
 // Config implements the Query/Config gRPC method
-func (k Keeper) Config(ctx context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
-	_, span := tracer.Start(ctx, "Config")
+func (k Keeper) Config(c context.Context, _ *types.QueryConfigRequest) (*types.QueryConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "Config")
 	defer span.End()
-	config := types.GetChainConfig()
+	config := *k.GetChainConfig(ctx)
 	config.Denom = types.GetEVMCoinDenom()
 	config.Decimals = uint64(types.GetEVMCoinDecimals())
 
-	return &types.QueryConfigResponse{Config: config}, nil
+	params := k.GetParams(ctx)
+	isLondon := types.IsLondon(k.GetEthChainConfig(ctx), ctx.BlockHeight())
+
+	return &types.QueryConfigResponse{
+		Config:          &config,
+		MaxCodeSize:     params.CodeSizeLimit(),
+		MaxInitCodeSize: params.InitCodeSizeLimit(),
+		RefundQuotient:  params.GasRefundQuotient(isLondon),
+	}, nil
+}
+
+// HardForks implements the Query/HardForks gRPC method
+func (k Keeper) HardForks(c context.Context, _ *types.QueryHardForksRequest) (*types.QueryHardForksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "HardForks")
+	defer span.End()
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+
+	return &types.QueryHardForksResponse{
+		HardForks: k.GetChainConfig(ctx).HardForks(ctx.BlockHeight(), blockTime),
+	}, nil
+}
+
+// OpcodeGasSchedule implements the Query/OpcodeGasSchedule gRPC method
+func (k Keeper) OpcodeGasSchedule(c context.Context, _ *types.QueryOpcodeGasScheduleRequest) (_ *types.QueryOpcodeGasScheduleResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "OpcodeGasSchedule")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockTime := uint64(ctx.BlockTime().Unix()) //#nosec G115 -- int overflow is not a concern here
+	rules := k.GetEthChainConfig(ctx).Rules(big.NewInt(ctx.BlockHeight()), true, blockTime)
+	jumpTable, err := vm.LookupInstructionSet(rules)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the gas of the opcodes without the overrides includes the extra EIPs, as in the EVM
+	params := k.GetParams(ctx)
+	for _, eip := range params.EIPs() {
+		if err := vm.EnableEIP(eip, &jumpTable); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	overrides := params.OpcodeGasOverrides
+	opcodes := make([]types.OpcodeGas, 0, len(overrides))
+	for _, override := range overrides {
+		opcodes = append(opcodes, types.OpcodeGas{
+			Opcode:          override.Opcode,
+			ConstantGas:     override.ConstantGas,
+			ForkConstantGas: jumpTable[vm.StringToOp(override.Opcode)].GetConstantGas(),
+		})
+	}
+	return &types.QueryOpcodeGasScheduleResponse{Opcodes: opcodes}, nil
+}
+
+// ContractAccessControl implements the Query/ContractAccessControl gRPC method
+func (k Keeper) ContractAccessControl(c context.Context, req *types.QueryContractAccessControlRequest) (_ *types.QueryContractAccessControlResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "ContractAccessControl", trace.WithAttributes(
+		attribute.String("address", req.Address),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accessControl := k.GetParams(ctx).AccessControl
+	contract, found := accessControl.GetContractAccessControl(common.HexToAddress(req.Address))
+	if !found {
+		return &types.QueryContractAccessControlResponse{}, nil
+	}
+	return &types.QueryContractAccessControlResponse{ContractAccessControl: &contract}, nil
 }
 
 // buildTraceCtx builds a context for simulating or tracing transactions by:
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"errors"
+	"slices"
+	"sort"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -18,16 +22,78 @@ import (
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks      = MultiEvmHooks{}
+	_ types.PreTxHooks    = MultiEvmHooks{}
+	_ types.PostCallHooks = MultiEvmHooks{}
+)
 
-// MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
+// MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence.
+// A hook returning types.ErrSkipEvmHooks stops the sequence without error.
 type MultiEvmHooks []types.EvmHooks
 
-// NewMultiEvmHooks combine multiple evm hooks
+// NewMultiEvmHooks combine multiple evm hooks, ordered by priority for the ones implementing
+// types.PrioritizedEvmHooks, and otherwise in the given order.
 func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
+	hooks = slices.Clone(hooks)
+	sort.SliceStable(hooks, func(i, j int) bool {
+		return hookPriority(hooks[i]) < hookPriority(hooks[j])
+	})
 	return hooks
 }
 
+// hookPriority returns the priority of the hooks, 0 if they don't have one.
+func hookPriority(hooks types.EvmHooks) int64 {
+	if prioritized, ok := hooks.(types.PrioritizedEvmHooks); ok {
+		return prioritized.Priority()
+	}
+	return 0
+}
+
+// PreTxProcessing delegate the call to underlying hooks implementing types.PreTxHooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "MultiEVMHooks.PreTxProcessing", trace.WithAttributes(
+		attribute.String("sender", msg.From.Hex()),
+		attribute.Int("hooks_count", len(mh)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	for i := range mh {
+		hooks, ok := mh[i].(types.PreTxHooks)
+		if !ok {
+			continue
+		}
+		if err := hooks.PreTxProcessing(ctx, msg); err != nil {
+			if errors.Is(err, types.ErrSkipEvmHooks) {
+				return nil
+			}
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// PostCallProcessing delegate the call to underlying hooks implementing types.PostCallHooks
+func (mh MultiEvmHooks) PostCallProcessing(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "MultiEVMHooks.PostCallProcessing", trace.WithAttributes(
+		attribute.String("sender", msg.From.Hex()),
+		attribute.Int("hooks_count", len(mh)),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	for i := range mh {
+		hooks, ok := mh[i].(types.PostCallHooks)
+		if !ok {
+			continue
+		}
+		if err := hooks.PostCallProcessing(ctx, msg, res); err != nil {
+			if errors.Is(err, types.ErrSkipEvmHooks) {
+				return nil
+			}
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
 // PostTxProcessing delegate the call to underlying hooks
 func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message, receipt *ethtypes.Receipt) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "MultiEVMHooks.PostTxProcessing", trace.WithAttributes(
@@ -38,6 +104,9 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, sender common.Address,
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	for i := range mh {
 		if err := mh[i].PostTxProcessing(ctx, sender, msg, receipt); err != nil {
+			if errors.Is(err, types.ErrSkipEvmHooks) {
+				return nil
+			}
 			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
 		}
 	}
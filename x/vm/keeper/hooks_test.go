@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/keeper"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordHooks records its name when called and returns the given error.
+type recordHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h recordHooks) PreTxProcessing(_ sdk.Context, _ core.Message) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func (h recordHooks) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+// prioritizedHooks are recordHooks with a priority.
+type prioritizedHooks struct {
+	recordHooks
+	priority int64
+}
+
+func (h prioritizedHooks) Priority() int64 {
+	return h.priority
+}
+
+func TestMultiEvmHooks(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	receipt := &ethtypes.Receipt{}
+
+	testCases := []struct {
+		name     string
+		errs     map[string]error
+		expCalls []string
+		expErr   error
+	}{
+		{
+			name:     "run hooks ordered by priority",
+			expCalls: []string{"first", "default", "other", "last"},
+		},
+		{
+			name:     "skip the remaining hooks",
+			errs:     map[string]error{"default": vmtypes.ErrSkipEvmHooks},
+			expCalls: []string{"first", "default"},
+		},
+		{
+			name:     "reject the message",
+			errs:     map[string]error{"other": vmtypes.ErrRejectedByHook},
+			expCalls: []string{"first", "default", "other"},
+			expErr:   vmtypes.ErrRejectedByHook,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			hooks := func(name string) recordHooks {
+				return recordHooks{name: name, calls: &calls, err: tc.errs[name]}
+			}
+			mh := keeper.NewMultiEvmHooks(
+				prioritizedHooks{hooks("last"), 10},
+				hooks("default"),
+				prioritizedHooks{hooks("first"), -10},
+				hooks("other"),
+			)
+
+			err := mh.PreTxProcessing(ctx, core.Message{})
+			require.Equal(t, tc.expCalls, calls)
+			calls = nil
+			postErr := mh.PostTxProcessing(ctx, common.Address{}, core.Message{}, receipt)
+			require.Equal(t, tc.expCalls, calls)
+			// the hooks not implementing PostCallHooks are not called
+			calls = nil
+			require.NoError(t, mh.PostCallProcessing(ctx, core.Message{}, &vmtypes.MsgEthereumTxResponse{}))
+			require.Empty(t, calls)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.NoError(t, postErr)
+				return
+			}
+			require.True(t, errors.Is(err, tc.expErr))
+			require.True(t, errors.Is(postErr, tc.expErr))
+		})
+	}
+}
+
+func TestNewMultiEvmHooksKeepsGivenHooks(t *testing.T) {
+	var calls []string
+	hooks := []vmtypes.EvmHooks{
+		prioritizedHooks{recordHooks{name: "last", calls: &calls}, 10},
+		recordHooks{name: "first", calls: &calls},
+	}
+	given := slices.Clone(hooks)
+
+	mh := keeper.NewMultiEvmHooks(hooks...)
+	require.Equal(t, given, hooks)
+	require.Equal(t, vmtypes.EvmHooks(recordHooks{name: "first", calls: &calls}), mh[0])
+}
@@ -76,6 +76,10 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
+	// liveTracer holds the hooks of the live tracer streaming the execution of
+	// the blocks, if any
+	liveTracer *tracing.Hooks
+
 	hooks types.EvmHooks
 	// EVM Hooks for tx post-processing
 
@@ -95,6 +99,10 @@ type Keeper struct {
 	// defaultEvmCoinInfo is the default EVM coin info used when evmCoinInfo is not initialized in the state,
 	// mainly for historical queries.
 	defaultEvmCoinInfo types.EvmCoinInfo
+
+	// defaultChainConfig is the chain config of the application, used when no
+	// chain config is stored by governance.
+	defaultChainConfig *types.ChainConfig
 }
 
 // NewKeeper generates new evm module keeper
@@ -137,18 +145,19 @@ func NewKeeper(
 
 	// NOTE: we pass in the parameter space to the CommitStateDB in order to use custom denominations for the EVM operations
 	return &Keeper{
-		cdc:              cdc,
-		authority:        authority,
-		accountKeeper:    ak,
-		bankWrapper:      bankWrapper,
-		stakingKeeper:    sk,
-		feeMarketWrapper: feeMarketWrapper,
-		storeKey:         storeKey,
-		objectKey:        objectKey,
-		tracer:           tracer,
-		consensusKeeper:  consensusKeeper,
-		erc20Keeper:      erc20Keeper,
-		storeKeys:        storeKeys,
+		cdc:                cdc,
+		authority:          authority,
+		accountKeeper:      ak,
+		bankWrapper:        bankWrapper,
+		stakingKeeper:      sk,
+		feeMarketWrapper:   feeMarketWrapper,
+		storeKey:           storeKey,
+		objectKey:          objectKey,
+		tracer:             tracer,
+		consensusKeeper:    consensusKeeper,
+		erc20Keeper:        erc20Keeper,
+		storeKeys:          storeKeys,
+		defaultChainConfig: ethCfg,
 	}
 }
 
@@ -256,11 +265,45 @@ func (k *Keeper) PostTxProcessing(
 	return k.hooks.PostTxProcessing(ctx, sender, msg, receipt)
 }
 
+// PreTxProcessing delegates the call to the hooks implementing types.PreTxHooks.
+// If no such hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message) (err error) {
+	hooks, ok := k.hooks.(types.PreTxHooks)
+	if !ok {
+		return nil
+	}
+	ctx, span := ctx.StartSpan(tracer, "PreTxProcessing", trace.WithAttributes(
+		attribute.String("sender", msg.From.Hex()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return hooks.PreTxProcessing(ctx, msg)
+}
+
+// PostCallProcessing delegates the call to the hooks implementing types.PostCallHooks.
+// If no such hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PostCallProcessing(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) (err error) {
+	hooks, ok := k.hooks.(types.PostCallHooks)
+	if !ok {
+		return nil
+	}
+	ctx, span := ctx.StartSpan(tracer, "PostCallProcessing", trace.WithAttributes(
+		attribute.String("sender", msg.From.Hex()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return hooks.PostCallProcessing(ctx, msg, res)
+}
+
 // HasHooks returns true if hooks are set
 func (k *Keeper) HasHooks() bool {
 	return k.hooks != nil
 }
 
+// hasPostCallHooks returns true if the hooks set implement types.PostCallHooks
+func (k *Keeper) hasPostCallHooks() bool {
+	_, ok := k.hooks.(types.PostCallHooks)
+	return ok
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
@@ -377,7 +420,7 @@ func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *uint256.Int {
 func (k Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
 	ctx, span := ctx.StartSpan(tracer, "GetBaseFee", trace.WithAttributes(attribute.Int64("block_height", ctx.BlockHeight())))
 	defer span.End()
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	if !types.IsLondon(ethCfg, ctx.BlockHeight()) {
 		return nil
 	}
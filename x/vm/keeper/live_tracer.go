@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	"github.com/cosmos/evm/x/vm/tracers"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithLiveTracer sets the live tracer, whose hooks are called for every block
+// and Ethereum transaction executed by the chain during FinalizeBlock.
+//
+// NOTE: the hooks only observe the EVM execution. Balance and nonce changes
+// performed by the Cosmos SDK modules, such as the fee deduction and the nonce
+// increment of the ante handler, are not reported.
+func (k *Keeper) WithLiveTracer(hooks *tracing.Hooks) *Keeper {
+	k.liveTracer = hooks
+	if hooks != nil && hooks.OnBlockchainInit != nil {
+		hooks.OnBlockchainInit(k.defaultChainConfig.EthereumConfig(nil))
+	}
+	return k
+}
+
+// CloseLiveTracer releases the resources held by the live tracer, if any.
+func (k *Keeper) CloseLiveTracer() {
+	if k.liveTracer != nil && k.liveTracer.OnClose != nil {
+		k.liveTracer.OnClose()
+	}
+}
+
+// isLiveTracing returns true if the live tracer has to be called for the
+// given context, which is only the case for the execution of the blocks.
+func (k *Keeper) isLiveTracing(ctx sdk.Context) bool {
+	return k.liveTracer != nil && ctx.ExecMode() == sdk.ExecModeFinalize
+}
+
+// liveTracerBlockStart calls the OnBlockStart hook of the live tracer with the
+// Ethereum header of the current block, after reporting the hash of the
+// CometBFT block to its block hash hook, if any. The gas used by the block is
+// not known before its execution and is left empty.
+func (k *Keeper) liveTracerBlockStart(ctx sdk.Context) {
+	if !k.isLiveTracing(ctx) || k.liveTracer.OnBlockStart == nil {
+		return
+	}
+	if onBlockHash := tracers.GetBlockHashHook(k.liveTracer); onBlockHash != nil {
+		onBlockHash(common.BytesToHash(ctx.HeaderHash()))
+	}
+
+	coinbase, err := k.GetCoinbaseAddress(ctx, ctx.BlockHeader().ProposerAddress)
+	if err != nil {
+		k.Logger(ctx).Error("failed to obtain coinbase address for the live tracer", "error", err)
+	}
+	header := &ethtypes.Header{
+		ParentHash: common.BytesToHash(ctx.BlockHeader().LastBlockId.Hash),
+		Coinbase:   coinbase,
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(ctx.BlockHeight()),
+		GasLimit:   antetypes.BlockGasLimit(ctx),
+		Time:       uint64(ctx.BlockHeader().Time.Unix()), //#nosec G115 -- int overflow is not a concern here
+		BaseFee:    k.GetBaseFee(ctx),
+	}
+	k.liveTracer.OnBlockStart(tracing.BlockEvent{Block: ethtypes.NewBlockWithHeader(header)})
+}
+
+// liveTracerBlockEnd calls the OnBlockEnd hook of the live tracer.
+func (k *Keeper) liveTracerBlockEnd(ctx sdk.Context) {
+	if k.isLiveTracing(ctx) && k.liveTracer.OnBlockEnd != nil {
+		k.liveTracer.OnBlockEnd(nil)
+	}
+}
+
+// liveTracerTxHooks returns the live tracer hooks used to execute the given
+// transaction. ApplyMessageWithConfig only reports the message it executes, so
+// OnTxStart is wrapped to report the transaction itself, and OnTxEnd is left to
+// ApplyTransaction, which reports it with the complete receipt.
+func (k *Keeper) liveTracerTxHooks(tx *ethtypes.Transaction) *tracing.Hooks {
+	hooks := *k.liveTracer
+	if onTxStart := k.liveTracer.OnTxStart; onTxStart != nil {
+		hooks.OnTxStart = func(vm *tracing.VMContext, _ *ethtypes.Transaction, from common.Address) {
+			onTxStart(vm, tx, from)
+		}
+	}
+	hooks.OnTxEnd = nil
+	return &hooks
+}
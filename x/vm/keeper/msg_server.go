@@ -164,3 +164,57 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// ScheduleHardFork implements the gRPC MsgServer interface. When a
+// ScheduleHardFork proposal passes, it sets the activation of the hard fork in
+// the stored chain config, which must be in the future. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) ScheduleHardFork(goCtx context.Context, req *types.MsgScheduleHardFork) (
+	_ *types.MsgScheduleHardForkResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "ScheduleHardFork", trace.WithAttributes(
+		attribute.String("authority", req.Authority),
+		attribute.String("name", req.Name),
+		attribute.Int64("activation", int64(req.Activation)), //nolint:gosec // G115 // only used for tracing
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	updated, err := k.GetChainConfig(ctx).ScheduleHardFork(req.Name, req.Activation)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.updateChainConfig(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleHardForkResponse{}, nil
+}
+
+// UpdateChainConfig implements the gRPC MsgServer interface. When an
+// UpdateChainConfig proposal passes, it replaces the stored chain config, only
+// changing the hard forks that are not active yet. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateChainConfig(goCtx context.Context, req *types.MsgUpdateChainConfig) (
+	_ *types.MsgUpdateChainConfigResponse, err error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx, span := ctx.StartSpan(tracer, "UpdateChainConfig", trace.WithAttributes(
+		attribute.String("authority", req.Authority),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	if err := k.updateChainConfig(ctx, req.Config); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateChainConfigResponse{}, nil
+}
@@ -0,0 +1,502 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulateVMErrorCode is the JSON-RPC error code returned for simulated calls
+// that failed with an EVM error other than a revert.
+const simulateVMErrorCode = -32015
+
+// simulateBlocks runs the calls of every simulated block sequentially. All the
+// blocks share a single branch of the state: every call commits its StateDB so
+// that the following calls and blocks see its changes, the same way TraceTx
+// replays the predecessors of the traced transaction. The EVM is stopped once
+// the timeout is reached.
+func (k *Keeper) simulateBlocks(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	opts rpctypes.SimOpts,
+	gasCap uint64,
+	timeout time.Duration,
+) (_ []*rpctypes.SimBlockResult, err error) {
+	ctx, span := ctx.StartSpan(tracer, "simulateBlocks", trace.WithAttributes(
+		attribute.Int("block_count", len(opts.BlockStateCalls)),
+		attribute.Bool("validation", opts.Validation),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blocks, err := sanitizeSimBlocks(opts.BlockStateCalls, uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if timeout > 0 {
+		deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+		defer cancel()
+		ctx = withEVMCancellation(ctx.WithContext(deadlineCtx))
+	}
+
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	// the gas cap is the budget of all the calls, with no limit if zero
+	var gasBudget *uint64
+	if gasCap > 0 {
+		gasBudget = &gasCap
+	}
+
+	results := make([]*rpctypes.SimBlockResult, 0, len(blocks))
+	for _, block := range blocks {
+		result, err := k.simulateBlock(ctx, cfg, block, opts, gasBudget)
+		if err != nil {
+			return nil, err
+		}
+		// the calls may have been stopped by the deadline
+		if timeout > 0 && ctx.Context().Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "execution aborted (timeout = %s)", timeout)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// simulateBlock applies the state overrides and executes the calls of a single
+// simulated block with its header overrides. The gas used is taken from the gas
+// budget, unless it is nil.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	baseCfg *statedb.EVMConfig,
+	block rpctypes.SimBlock,
+	opts rpctypes.SimOpts,
+	gasBudget *uint64,
+) (*rpctypes.SimBlockResult, error) {
+	overrides := block.BlockOverrides
+	number := overrides.Number.ToInt()
+	blockTime := uint64(*overrides.Time)
+
+	cfg := *baseCfg
+	if !opts.Validation && cfg.BaseFee != nil {
+		// without validation the calls are free of charge, as in go-ethereum
+		cfg.BaseFee = new(big.Int)
+	}
+	ctx, gasLimit, err := applyBlockOverrides(ctx, &cfg, overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if block.StateOverrides != nil {
+		if err := k.applySimStateOverrides(ctx, block.StateOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	result := &rpctypes.SimBlockResult{
+		Number:       hexutil.Uint64(number.Uint64()),
+		Time:         hexutil.Uint64(blockTime),
+		GasLimit:     hexutil.Uint64(gasLimit),
+		BaseFee:      (*hexutil.Big)(cfg.BaseFee),
+		Coinbase:     cfg.CoinBase,
+		Transactions: make([]hexutil.Bytes, 0, len(block.Calls)),
+		Senders:      make([]common.Address, 0, len(block.Calls)),
+		Calls:        make([]rpctypes.SimCallResult, 0, len(block.Calls)),
+	}
+
+	logTracer := newSimTracer(opts.TraceTransfers, number.Uint64())
+	chainID := k.GetEthChainConfig(ctx).ChainID
+
+	var gasUsed uint64
+	for i, args := range block.Calls {
+		var gasCap uint64
+		if gasBudget != nil {
+			if *gasBudget == 0 {
+				return nil, status.Error(codes.InvalidArgument, "gas cap reached")
+			}
+			gasCap = *gasBudget
+		}
+
+		nonce := k.GetNonce(ctx, args.GetFrom())
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		}
+
+		remaining := gasLimit - gasUsed
+		if args.Gas == nil {
+			gas := remaining
+			if gasCap > 0 {
+				gas = min(gas, gasCap)
+			}
+			args.Gas = (*hexutil.Uint64)(&gas)
+		}
+		if uint64(*args.Gas) > remaining {
+			return nil, status.Errorf(codes.InvalidArgument, "block gas limit reached: %d >= %d", gasUsed, gasLimit)
+		}
+
+		if err := args.CallDefaults(gasCap, cfg.BaseFee, chainID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		msg := args.ToMessage(cfg.BaseFee, !opts.Validation, !opts.Validation)
+		if opts.Validation {
+			if err := k.validateSimMessage(ctx, msg, nonce, cfg.BaseFee); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		tx := args.ToTransaction(ethtypes.DynamicFeeTxType)
+		txBz, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txConfig := statedb.NewTxConfig(tx.Hash(), uint(i)) //nolint:gosec // G115 // won't exceed uint64
+		logTracer.setTx(tx.Hash(), uint(i))                 //nolint:gosec // G115 // won't exceed uint64
+
+		if err := k.incrementSimNonce(ctx, msg); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// pass true to commit the StateDB into the simulation branch
+		res, err := k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, logTracer.Hooks(), true, &cfg, txConfig, false, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if opts.Validation {
+			if err := k.chargeSimGas(ctx, msg, res.GasUsed); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		gasUsed += res.GasUsed
+		if gasBudget != nil {
+			*gasBudget -= min(*gasBudget, res.GasUsed)
+		}
+
+		result.Transactions = append(result.Transactions, txBz)
+		result.Senders = append(result.Senders, msg.From)
+		result.Calls = append(result.Calls, newSimCallResult(res, logTracer.Logs()))
+	}
+	result.GasUsed = hexutil.Uint64(gasUsed)
+
+	return result, nil
+}
+
+// applyBlockOverrides applies the header overrides of a simulated block to the
+// context and the EVM configuration. It returns the gas limit of the block.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *rpctypes.BlockOverrides) (sdk.Context, uint64, error) {
+	if overrides.Number != nil {
+		number := overrides.Number.ToInt()
+		if !number.IsInt64() {
+			return ctx, 0, fmt.Errorf("invalid block number %s", number)
+		}
+		ctx = ctx.WithBlockHeight(number.Int64())
+	}
+	if overrides.Time != nil {
+		blockTime := uint64(*overrides.Time)
+		if blockTime > math.MaxInt64 {
+			return ctx, 0, fmt.Errorf("invalid block timestamp %d", blockTime)
+		}
+		ctx = ctx.WithBlockTime(time.Unix(int64(blockTime), 0).UTC()) //#nosec G115 -- checked above
+	}
+	if overrides.BaseFeePerGas != nil {
+		cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+	}
+	if overrides.FeeRecipient != nil {
+		cfg.CoinBase = *overrides.FeeRecipient
+	}
+
+	gasLimit := antetypes.BlockGasLimit(ctx)
+	if overrides.GasLimit != nil {
+		gasLimit = uint64(*overrides.GasLimit)
+		cp := ctx.ConsensusParams()
+		blockParams := tmproto.BlockParams{}
+		if cp.Block != nil {
+			blockParams = *cp.Block
+		}
+		blockParams.MaxGas = int64(min(gasLimit, math.MaxInt64)) //#nosec G115 -- capped above
+		cp.Block = &blockParams
+		ctx = ctx.WithConsensusParams(cp)
+	}
+	return ctx, gasLimit, nil
+}
+
+// incrementSimNonce increases the sequence of the sender of a simulated call,
+// as done by the ante handler. Contract creations take care of it while
+// applying the message.
+func (k *Keeper) incrementSimNonce(ctx sdk.Context, msg *core.Message) error {
+	if msg.To == nil {
+		return nil
+	}
+	acct := k.GetAccountOrEmpty(ctx, msg.From)
+	acct.Nonce = msg.Nonce + 1
+	return k.SetAccount(ctx, msg.From, acct)
+}
+
+// applySimStateOverrides applies the state overrides of a simulated block and
+// commits them into the simulation branch. Precompiles can't be moved, since
+// the move would only be valid for a single EVM instance.
+func (k *Keeper) applySimStateOverrides(ctx sdk.Context, overrides *rpctypes.StateOverride) error {
+	for addr, account := range *overrides {
+		if account.MovePrecompileTo != nil {
+			return fmt.Errorf("account %s: moving precompiles is not supported in simulations", addr.Hex())
+		}
+	}
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	if err := overrides.Apply(stateDB, vm.PrecompiledContracts{}); err != nil {
+		return err
+	}
+	return stateDB.Commit()
+}
+
+// validateSimMessage performs the checks done by the ante handler on a simulated
+// message when validation is requested.
+func (k *Keeper) validateSimMessage(ctx sdk.Context, msg *core.Message, nonce uint64, baseFee *big.Int) error {
+	switch {
+	case msg.Nonce < nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, nonce)
+	case msg.Nonce > nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, nonce)
+	}
+
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	cost.Add(cost, msg.Value)
+	if balance := k.GetBalance(ctx, msg.From); balance.ToBig().Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From.Hex(), balance, cost)
+	}
+	return nil
+}
+
+// chargeSimGas deducts the fees of a validated simulated call from its sender.
+func (k *Keeper) chargeSimGas(ctx sdk.Context, msg *core.Message, gasUsed uint64) error {
+	fees, overflow := uint256.FromBig(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), msg.GasPrice))
+	if overflow {
+		return errors.New("fees overflow")
+	}
+	balance := k.GetBalance(ctx, msg.From)
+	if balance.Lt(fees) {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From.Hex(), balance, fees)
+	}
+	return k.SetBalance(ctx, msg.From, new(uint256.Int).Sub(balance, fees))
+}
+
+// sanitizeSimBlocks fills in the missing numbers and timestamps of the simulated
+// blocks, checking that both are strictly increasing. Gaps between block numbers
+// are filled with empty blocks.
+func sanitizeSimBlocks(blocks []rpctypes.SimBlock, baseNumber, baseTime uint64) ([]rpctypes.SimBlock, error) {
+	if len(blocks) == 0 {
+		return nil, errors.New("empty input")
+	}
+
+	res := make([]rpctypes.SimBlock, 0, len(blocks))
+	prevNumber, prevTime := baseNumber, baseTime
+	for _, block := range blocks {
+		overrides := rpctypes.BlockOverrides{}
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		number := overrides.Number.ToInt()
+		if !number.IsUint64() || number.Uint64() <= prevNumber {
+			return nil, fmt.Errorf("block numbers must be in order: %s <= %d", number, prevNumber)
+		}
+		if number.Uint64()-prevNumber > uint64(rpctypes.MaxSimulateBlocks-len(res)) {
+			return nil, fmt.Errorf("too many blocks: the limit is %d", rpctypes.MaxSimulateBlocks)
+		}
+
+		for n := prevNumber + 1; n < number.Uint64(); n++ {
+			prevTime += rpctypes.SimulateTimestampIncrement
+			gapTime := hexutil.Uint64(prevTime)
+			res = append(res, rpctypes.SimBlock{
+				BlockOverrides: &rpctypes.BlockOverrides{
+					Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+					Time:   &gapTime,
+				},
+			})
+		}
+
+		if overrides.Time == nil {
+			blockTime := hexutil.Uint64(prevTime + rpctypes.SimulateTimestampIncrement)
+			overrides.Time = &blockTime
+		} else if uint64(*overrides.Time) <= prevTime {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", *overrides.Time, prevTime)
+		}
+
+		prevNumber, prevTime = number.Uint64(), uint64(*overrides.Time)
+		block.BlockOverrides = &overrides
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// newSimCallResult converts the response of a simulated call to its RPC
+// representation.
+func newSimCallResult(res *types.MsgEthereumTxResponse, logs []*ethtypes.Log) rpctypes.SimCallResult {
+	callRes := rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return callRes
+	}
+
+	callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := types.NewExecErrorWithReason(res.Ret)
+		callRes.Error = &rpctypes.SimCallError{
+			Code:    revertErr.ErrorCode(),
+			Message: revertErr.Error(),
+			Data:    revertErr.ErrorData().(string),
+		}
+	} else {
+		callRes.Error = &rpctypes.SimCallError{
+			Code:    simulateVMErrorCode,
+			Message: res.VmError,
+		}
+	}
+	return callRes
+}
+
+// simTracer collects the logs emitted by the simulated calls, discarding the
+// ones of the reverted call frames. When traceTransfers is set, the native value
+// transfers are recorded as ERC-20 Transfer logs emitted by the ERC-7528 address.
+//
+// This tracer is adapted from go-ethereum v1.16.2 - internal/ethapi/logtracer.go
+// (https://github.com/ethereum/go-ethereum/blob/v1.16.2/internal/ethapi/logtracer.go)
+type simTracer struct {
+	// logs keeps the logs of every call frame
+	logs           [][]*ethtypes.Log
+	count          int
+	traceTransfers bool
+	blockNumber    uint64
+	txHash         common.Hash
+	txIdx          uint
+}
+
+func newSimTracer(traceTransfers bool, blockNumber uint64) *simTracer {
+	return &simTracer{
+		traceTransfers: traceTransfers,
+		blockNumber:    blockNumber,
+	}
+}
+
+// Hooks returns the tracing hooks of the tracer.
+func (t *simTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+		OnLog:     t.onLog,
+	}
+}
+
+// setTx sets the transaction the following logs belong to.
+func (t *simTracer) setTx(txHash common.Hash, txIdx uint) {
+	t.txHash = txHash
+	t.txIdx = txIdx
+}
+
+// Logs returns the logs of the last traced transaction.
+func (t *simTracer) Logs() []*ethtypes.Log {
+	if len(t.logs) == 0 || t.logs[0] == nil {
+		return []*ethtypes.Log{}
+	}
+	return t.logs[0]
+}
+
+func (t *simTracer) onTxStart(*tracing.VMContext, *ethtypes.Transaction, common.Address) {
+	t.logs = nil
+}
+
+func (t *simTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.logs = append(t.logs, make([]*ethtypes.Log, 0))
+	if vm.OpCode(typ) != vm.DELEGATECALL && value != nil && value.Sign() > 0 {
+		t.captureTransfer(from, to, value)
+	}
+}
+
+func (t *simTracer) onExit(depth int, _ []byte, _ uint64, _ error, reverted bool) {
+	if depth == 0 {
+		if reverted && len(t.logs) > 0 {
+			t.logs[0] = nil
+		}
+		return
+	}
+	size := len(t.logs)
+	if size <= 1 {
+		return
+	}
+	// pop the call frame and merge its logs into the parent one unless reverted
+	call := t.logs[size-1]
+	t.logs = t.logs[:size-1]
+	if !reverted {
+		t.logs[size-2] = append(t.logs[size-2], call...)
+	}
+}
+
+func (t *simTracer) onLog(log *ethtypes.Log) {
+	t.captureLog(log.Address, log.Topics, log.Data)
+}
+
+func (t *simTracer) captureLog(address common.Address, topics []common.Hash, data []byte) {
+	if len(t.logs) == 0 {
+		return
+	}
+	t.logs[len(t.logs)-1] = append(t.logs[len(t.logs)-1], &ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: t.blockNumber,
+		TxHash:      t.txHash,
+		TxIndex:     t.txIdx,
+		Index:       uint(t.count), //nolint:gosec // G115 // won't exceed uint64
+	})
+	t.count++
+}
+
+func (t *simTracer) captureTransfer(from, to common.Address, value *big.Int) {
+	if !t.traceTransfers {
+		return
+	}
+	topics := []common.Hash{
+		rpctypes.SimulateTransferTopic,
+		common.BytesToHash(from.Bytes()),
+		common.BytesToHash(to.Bytes()),
+	}
+	t.captureLog(rpctypes.SimulateTransferAddress, topics, common.BigToHash(value).Bytes())
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
@@ -63,15 +64,17 @@ func (k *Keeper) NewEVMWithOverridePrecompiles(
 		Random:      &common.MaxHash, // need to be different than nil to signal it is after the merge and pick up the right opcodes
 	}
 
-	ethCfg := types.GetEthChainConfig()
+	ethCfg := k.GetEthChainConfig(ctx)
 	txCtx := core.NewEVMTxContext(&msg)
 	if tracingHooks == nil {
 		tracingHooks = k.Tracer(ctx, msg, ethCfg)
 	}
-	vmConfig := k.VMConfig(ctx, msg, cfg, tracingHooks)
-
 	signer := msg.From
 	accessControl := types.NewRestrictedPermissionPolicy(&cfg.Params.AccessControl, signer)
+	if policy, ok := accessControl.(types.CallInputPolicy); ok {
+		tracingHooks = policy.TracingHooks(tracingHooks)
+	}
+	vmConfig := k.VMConfig(ctx, msg, cfg, tracingHooks)
 
 	// Set hooks for the EVM opcodes
 	evmHooks := types.NewDefaultOpCodesHooks()
@@ -220,7 +223,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 	txConfig := k.TxConfig(ctx, tx.Hash())
 
 	// get the signer according to the chain rules from the config and block height
-	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	signer := ethtypes.MakeSigner(k.GetEthChainConfig(ctx), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
@@ -231,8 +234,26 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commitFn := ctx.CacheContext()
 
+	// stream the execution to the live tracer, if any
+	var (
+		tracingHooks *tracing.Hooks
+		receipt      *ethtypes.Receipt
+	)
+	if k.isLiveTracing(ctx) {
+		tracingHooks = k.liveTracerTxHooks(tx)
+		if onTxEnd := k.liveTracer.OnTxEnd; onTxEnd != nil {
+			defer func() {
+				if err != nil {
+					onTxEnd(nil, err)
+					return
+				}
+				onTxEnd(receipt, nil)
+			}()
+		}
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false, nil)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, tracingHooks, true, cfg, txConfig, false, nil)
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -251,7 +272,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 		contractAddr = crypto.CreateAddress(msg.From, msg.Nonce)
 	}
 
-	receipt := &ethtypes.Receipt{
+	receipt = &ethtypes.Receipt{
 		Type:              tx.Type(),
 		PostState:         nil,
 		CumulativeGasUsed: calculateCumulativeGasFromEthResponse(ctx.GasMeter(), res),
@@ -412,9 +433,22 @@ func (k *Keeper) ApplyMessageWithConfig(
 	))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	stateDB := statedb.New(ctx, k, txConfig)
-	ethCfg := types.GetEthChainConfig()
+	// with post call hooks, the state changes are committed to a branch of the context, for the
+	// hooks to run on top of them and to be discarded with them. The simulated calls commit them to
+	// a branch never written, so that the hooks see the same state as for the transactions.
+	postCall := k.hasPostCallHooks()
+	stateCtx, writeState := ctx, func() {}
+	if postCall {
+		stateCtx, writeState = ctx.CacheContext()
+	}
+	stateDB := statedb.New(stateCtx, k, txConfig)
+	ethCfg := k.GetEthChainConfig(ctx)
 	evm := k.NewEVMWithOverridePrecompiles(ctx, msg, cfg, tracingHooks, stateDB, overrides == nil)
+	// stop the EVM once the context is done, for the queries run under a deadline
+	if isEVMCancellable(ctx) {
+		stop := context.AfterFunc(ctx.Context(), evm.Cancel)
+		defer stop()
+	}
 	// Gas limit suffices for the floor data cost (EIP-7623)
 	rules := ethCfg.Rules(evm.Context.BlockNumber, true, evm.Context.Time)
 	if overrides != nil {
@@ -424,17 +458,21 @@ func (k *Keeper) ApplyMessageWithConfig(
 		}
 		evm.WithPrecompiles(precompiles)
 	}
+	// the state overrides are applied before the tracing, as in geth
+	stateDB.SetTracingHooks(evm.Config.Tracer)
 
 	leftoverGas := msg.GasLimit
 
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
 	vmCfg := evm.Config
 	if vmCfg.Tracer != nil {
-		vmCfg.Tracer.OnTxStart(
-			evm.GetVMContext(),
-			ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
-			msg.From,
-		)
+		if vmCfg.Tracer.OnTxStart != nil {
+			vmCfg.Tracer.OnTxStart(
+				evm.GetVMContext(),
+				ethtypes.NewTx(&ethtypes.LegacyTx{To: msg.To, Data: msg.Data, Value: msg.Value, Gas: msg.GasLimit}),
+				msg.From,
+			)
+		}
 		defer func() {
 			if vmCfg.Tracer.OnTxEnd != nil {
 				vmCfg.Tracer.OnTxEnd(&ethtypes.Receipt{GasUsed: msg.GasLimit - leftoverGas}, vmErr)
@@ -466,6 +504,10 @@ func (k *Keeper) ApplyMessageWithConfig(
 			return nil, fmt.Errorf("%w: have %d, want %d", core.ErrFloorDataGas, msg.GasLimit, floorDataGas)
 		}
 	}
+	// Check whether the init code size has been exceeded.
+	if rules.IsShanghai && contractCreation && uint64(len(msg.Data)) > cfg.Params.InitCodeSizeLimit() {
+		return nil, fmt.Errorf("%w: code size %v limit %v", core.ErrMaxInitCodeSizeExceeded, len(msg.Data), cfg.Params.InitCodeSizeLimit())
+	}
 	leftoverGas -= intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
@@ -477,13 +519,25 @@ func (k *Keeper) ApplyMessageWithConfig(
 		return nil, err
 	}
 
-	if contractCreation {
+	// the hooks can reject the message, which then fails without being executed
+	preTxErr := k.PreTxProcessing(ctx, msg)
+	if preTxErr != nil {
+		vmErr = errorsmod.Wrap(preTxErr, "failed to execute pre transaction processing")
+		k.Logger(ctx).Debug("message rejected by pre transaction processing", "error", preTxErr)
+	} else if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce, tracing.NonceChangeEoACall)
 		var contractAddr common.Address
+		snapshot := stateDB.Snapshot()
 		ret, contractAddr, leftoverGas, vmErr = evm.Create(sender.Address(), msg.Data, leftoverGas, convertedValue)
+		// the EVM only enforces the Ethereum limit on the deployed code, so the lower limit of the
+		// params fails the creation the same way
+		if vmErr == nil && rules.IsEIP158 && uint64(len(ret)) > cfg.Params.CodeSizeLimit() {
+			stateDB.RevertToSnapshot(snapshot)
+			leftoverGas, vmErr = 0, vm.ErrMaxCodeSizeExceeded
+		}
 		// Only increment nonce if it wasn't already incremented during evm.Create()
 		// (e.g., by nested contract creations through EIP-7702 delegation)
 		if stateDB.GetNonce(sender.Address()) == msg.Nonce {
@@ -514,13 +568,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 		ret, leftoverGas, vmErr = evm.Call(sender.Address(), *msg.To, msg.Data, leftoverGas, convertedValue)
 	}
 
-	refundQuotient := params.RefundQuotient
-
-	// After EIP-3529: refunds are capped to gasUsed / 5
-	if isLondon {
-		refundQuotient = params.RefundQuotientEIP3529
-	}
-
+	refundQuotient := cfg.Params.GasRefundQuotient(isLondon)
 	if internal {
 		refundQuotient = 1 // full refund on internal calls
 	}
@@ -544,13 +592,6 @@ func (k *Keeper) ApplyMessageWithConfig(
 		span.AddEvent("vm_error", trace.WithAttributes(attribute.String("vm_err", vmError)))
 	}
 
-	// The dirty states in `StateDB` is either committed or discarded after return
-	if commit {
-		if err := stateDB.Commit(); err != nil {
-			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
-		}
-	}
-
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
 	// is considerably higher than GasUsed to stay more aligned with CometBFT gas mechanics
 	// for more info https://github.com/evmos/ethermint/issues/1085
@@ -581,7 +622,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 	if vmError == vm.ErrExecutionReverted.Error() {
 		ret = evm.Interpreter().ReturnData()
 	}
-	return &types.MsgEthereumTxResponse{
+	res := &types.MsgEthereumTxResponse{
 		GasUsed:        gasUsed.TruncateInt().Uint64(),
 		MaxUsedGas:     maxUsedGas,
 		VmError:        vmError,
@@ -590,7 +631,28 @@ func (k *Keeper) ApplyMessageWithConfig(
 		Hash:           txConfig.TxHash.Hex(),
 		BlockHash:      ctx.HeaderHash(),
 		BlockTimestamp: evm.Context.Time,
-	}, nil
+	}
+	// The dirty states in `StateDB` is either committed or discarded after return
+	if commit || postCall {
+		if err := stateDB.Commit(); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+		}
+	}
+
+	// the hooks can reject the message, discarding its state changes with theirs, as the post
+	// transaction processing does
+	if postCall && preTxErr == nil {
+		if err := k.PostCallProcessing(stateCtx, msg, res); err != nil {
+			res.VmError = errorsmod.Wrap(err, "failed to execute post call processing").Error()
+			res.Logs = nil
+			k.Logger(ctx).Debug("message rejected by post call processing", "error", err)
+			return res, nil
+		}
+	}
+	if commit {
+		writeState()
+	}
+	return res, nil
 }
 
 // SetConsensusParamsInCtx will return the original context if consensus params already exist in it, otherwise, it will
@@ -80,6 +80,11 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// tracingHooks are notified of the state events that are not reported by
+	// the EVM interpreter itself, i.e. the emitted logs and the changes of the
+	// balances and nonces.
+	tracingHooks *tracing.Hooks
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -157,6 +162,12 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// SetTracingHooks sets the tracing hooks notified when a log is emitted or a
+// balance or nonce is changed.
+func (s *StateDB) SetTracingHooks(hooks *tracing.Hooks) {
+	s.tracingHooks = hooks
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -222,6 +233,10 @@ func (s *StateDB) AddLog(log *ethtypes.Log) {
 	log.TxIndex = s.txConfig.TxIndex
 	log.Index = uint(len(s.logs))
 	s.logs = append(s.logs, log)
+
+	if s.tracingHooks != nil && s.tracingHooks.OnLog != nil {
+		s.tracingHooks.OnLog(log)
+	}
 }
 
 // Logs returns the logs of current transaction.
@@ -451,7 +466,11 @@ func (s *StateDB) AddBalance(addr common.Address, amount *uint256.Int, reason tr
 	if stateObject == nil {
 		return uint256.Int{}
 	}
-	return stateObject.AddBalance(amount)
+	prev := stateObject.AddBalance(amount)
+	if !amount.IsZero() {
+		s.onBalanceChange(addr, &prev, new(uint256.Int).Add(&prev, amount), reason)
+	}
+	return prev
 }
 
 // SubBalance subtracts amount from the account associated with addr.
@@ -463,14 +482,18 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	if amount.IsZero() {
 		return *(stateObject.Balance())
 	}
-	return stateObject.SubBalance(amount)
+	prev := stateObject.SubBalance(amount)
+	s.onBalanceChange(addr, &prev, new(uint256.Int).Sub(&prev, amount), reason)
+	return prev
 }
 
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
 	if stateObject != nil {
+		prev := stateObject.Nonce()
 		stateObject.SetNonce(nonce)
+		s.onNonceChange(addr, prev, nonce, reason)
 	}
 }
 
@@ -497,7 +520,8 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int, reason tracing.BalanceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
 	if stateObject != nil {
-		stateObject.SetBalance(amount)
+		prev := stateObject.SetBalance(amount)
+		s.onBalanceChange(addr, &prev, amount, reason)
 	}
 }
 
@@ -528,9 +552,33 @@ func (s *StateDB) SelfDestruct(addr common.Address) uint256.Int {
 	})
 	stateObject.markSelfDestructed()
 	stateObject.account.Balance = new(uint256.Int)
+	if !prevBalance.IsZero() {
+		s.onBalanceChange(addr, &prevBalance, stateObject.account.Balance, tracing.BalanceDecreaseSelfdestruct)
+	}
 	return prevBalance
 }
 
+// onBalanceChange notifies the tracing hooks of the change of the balance of
+// the account, as the geth StateDB does.
+func (s *StateDB) onBalanceChange(addr common.Address, prev, balance *uint256.Int, reason tracing.BalanceChangeReason) {
+	if s.tracingHooks != nil && s.tracingHooks.OnBalanceChange != nil {
+		s.tracingHooks.OnBalanceChange(addr, prev.ToBig(), balance.ToBig(), reason)
+	}
+}
+
+// onNonceChange notifies the tracing hooks of the change of the nonce of the
+// account, as the geth StateDB does.
+func (s *StateDB) onNonceChange(addr common.Address, prev, nonce uint64, reason tracing.NonceChangeReason) {
+	if s.tracingHooks == nil {
+		return
+	}
+	if s.tracingHooks.OnNonceChangeV2 != nil {
+		s.tracingHooks.OnNonceChangeV2(addr, prev, nonce, reason)
+	} else if s.tracingHooks.OnNonceChange != nil {
+		s.tracingHooks.OnNonceChange(addr, prev, nonce)
+	}
+}
+
 func (s *StateDB) SelfDestruct6780(addr common.Address) (uint256.Int, bool) {
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
@@ -0,0 +1,131 @@
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// LiveConsumer is an in-process consumer of the JSON records produced by the
+// live tracers.
+type LiveConsumer func(record json.RawMessage)
+
+var (
+	liveConsumersMu sync.RWMutex
+	liveConsumers   = make(map[string]LiveConsumer)
+)
+
+// RegisterLiveConsumer registers an in-process consumer under the given name.
+// The live tracers deliver their records to it when their configuration sets
+// the `consumer` field to that name. Consumers must be registered before the
+// live tracer is created and are called synchronously during block execution.
+func RegisterLiveConsumer(name string, consumer LiveConsumer) {
+	liveConsumersMu.Lock()
+	defer liveConsumersMu.Unlock()
+	liveConsumers[name] = consumer
+}
+
+// BlockHashHook is called with the hash of the CometBFT block before the
+// OnBlockStart hook of a live tracer, the hash of the Ethereum block of the
+// event not being the one of the chain.
+type BlockHashHook func(hash common.Hash)
+
+// blockHashHooks holds the block hash hooks of the live tracers, keyed by
+// their hooks.
+var blockHashHooks sync.Map
+
+// RegisterBlockHashHook registers the block hash hook of the live tracer with
+// the given hooks.
+func RegisterBlockHashHook(hooks *tracing.Hooks, hook BlockHashHook) {
+	blockHashHooks.Store(hooks, hook)
+}
+
+// GetBlockHashHook returns the block hash hook of the live tracer with the
+// given hooks, if any.
+func GetBlockHashHook(hooks *tracing.Hooks) BlockHashHook {
+	hook, ok := blockHashHooks.Load(hooks)
+	if !ok {
+		return nil
+	}
+	return hook.(BlockHashHook)
+}
+
+// liveOutputConfig is the output configuration shared by the live tracers.
+type liveOutputConfig struct {
+	Path     string `json:"path"`     // Path to the directory where the trace files will be stored
+	MaxSize  int    `json:"maxSize"`  // MaxSize is the maximum size in megabytes of a trace file before it gets rotated. It defaults to 100 megabytes.
+	Consumer string `json:"consumer"` // Consumer is the name of the registered in-process consumer of the records
+}
+
+// liveOutput writes the records of a live tracer either as JSON lines to a
+// rotating file or to an in-process consumer.
+type liveOutput struct {
+	file     *lumberjack.Logger
+	consumer LiveConsumer
+}
+
+// newLiveOutput creates the output configured in cfg. The records are written
+// to fileName within the configured path.
+func newLiveOutput(cfg json.RawMessage, fileName string) (*liveOutput, error) {
+	var config liveOutputConfig
+	if err := json.Unmarshal(cfg, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	switch {
+	case config.Path != "" && config.Consumer != "":
+		return nil, errors.New("only one of the live tracer output path and consumer can be set")
+	case config.Consumer != "":
+		liveConsumersMu.RLock()
+		consumer, ok := liveConsumers[config.Consumer]
+		liveConsumersMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("live tracer consumer %q is not registered", config.Consumer)
+		}
+		return &liveOutput{consumer: consumer}, nil
+	case config.Path != "":
+		// Store traces in a rotating file
+		file := &lumberjack.Logger{
+			Filename: filepath.Join(config.Path, fileName),
+		}
+		if config.MaxSize > 0 {
+			file.MaxSize = config.MaxSize
+		}
+		return &liveOutput{file: file}, nil
+	default:
+		return nil, errors.New("live tracer output path or consumer is required")
+	}
+}
+
+// write encodes the record and delivers it. Failures are logged rather than
+// returned, as they must not interrupt the block execution.
+func (o *liveOutput) write(record any) {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		log.Warn("Failed to marshal live trace record", "err", err)
+		return
+	}
+	if o.consumer != nil {
+		o.consumer(bz)
+		return
+	}
+	if _, err := o.file.Write(append(bz, '\n')); err != nil {
+		log.Warn("Failed to write live trace record", "err", err)
+	}
+}
+
+// close closes the trace file, if any.
+func (o *liveOutput) close() {
+	if o.file != nil {
+		if err := o.file.Close(); err != nil {
+			log.Warn("Failed to close live trace file", "err", err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package tracers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	// Force-load the native tracers to register the call tracer
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+)
+
+// LiveCallTracerName is the name under which the live call tracer is
+// registered in the go-ethereum live tracers directory.
+const LiveCallTracerName = "callTracer"
+
+// callTracerName is the name of the native go-ethereum call tracer.
+const callTracerName = "callTracer"
+
+func init() {
+	tracers.LiveDirectory.Register(LiveCallTracerName, newLiveCallTracer)
+}
+
+// liveCallTracerConfig is the configuration of the live call tracer.
+type liveCallTracerConfig struct {
+	// TracerConfig is the configuration of the native call tracer, e.g.
+	// {"onlyTopCall": true, "withLog": true}
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+}
+
+// CallTraceRecord is a record of the live call tracer, holding the call frames
+// of an executed transaction.
+type CallTraceRecord struct {
+	BlockNumber uint64          `json:"blockNumber"`
+	TxHash      common.Hash     `json:"txHash"`
+	Result      json.RawMessage `json:"result"`
+}
+
+// liveCallTracer runs the native call tracer for every executed transaction
+// and writes its result.
+type liveCallTracer struct {
+	output       *liveOutput
+	tracerConfig json.RawMessage
+	chainConfig  *params.ChainConfig
+	blockNumber  *big.Int
+	blockHash    common.Hash
+
+	// cometBlockHash is the hash of the CometBFT block starting next, if reported
+	cometBlockHash common.Hash
+
+	// tracer and txHash are set during the execution of a transaction
+	tracer *tracers.Tracer
+	txHash common.Hash
+}
+
+func newLiveCallTracer(cfg json.RawMessage) (*tracing.Hooks, error) {
+	var config liveCallTracerConfig
+	if err := json.Unmarshal(cfg, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	output, err := newLiveOutput(cfg, "calls.jsonl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &liveCallTracer{
+		output:       output,
+		tracerConfig: config.TracerConfig,
+		blockNumber:  new(big.Int),
+	}
+	hooks := &tracing.Hooks{
+		OnBlockchainInit: t.OnBlockchainInit,
+		OnBlockStart:     t.OnBlockStart,
+		OnTxStart:        t.OnTxStart,
+		OnTxEnd:          t.OnTxEnd,
+		OnEnter:          t.OnEnter,
+		OnExit:           t.OnExit,
+		OnLog:            t.OnLog,
+		OnClose:          t.output.close,
+	}
+	RegisterBlockHashHook(hooks, t.OnBlockHash)
+	return hooks, nil
+}
+
+func (t *liveCallTracer) OnBlockchainInit(chainConfig *params.ChainConfig) {
+	t.chainConfig = chainConfig
+}
+
+// OnBlockHash records the hash of the CometBFT block reported by the next
+// block start.
+func (t *liveCallTracer) OnBlockHash(hash common.Hash) {
+	t.cometBlockHash = hash
+}
+
+func (t *liveCallTracer) OnBlockStart(ev tracing.BlockEvent) {
+	t.blockNumber = ev.Block.Number()
+	t.blockHash = ev.Block.Hash()
+	if t.cometBlockHash != (common.Hash{}) {
+		t.blockHash, t.cometBlockHash = t.cometBlockHash, common.Hash{}
+	}
+}
+
+// OnTxStart creates the call tracer of the transaction.
+func (t *liveCallTracer) OnTxStart(env *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.txHash = tx.Hash()
+	tracer, err := tracers.DefaultDirectory.New(callTracerName, &tracers.Context{
+		BlockHash:   t.blockHash,
+		BlockNumber: t.blockNumber,
+		TxHash:      t.txHash,
+	}, t.tracerConfig, t.chainConfig)
+	if err != nil {
+		log.Warn("Failed to create the live call tracer", "err", err)
+		return
+	}
+	t.tracer = tracer
+	if t.tracer.OnTxStart != nil {
+		t.tracer.OnTxStart(env, tx, from)
+	}
+}
+
+// OnTxEnd writes the call frames of the transaction.
+func (t *liveCallTracer) OnTxEnd(receipt *types.Receipt, err error) {
+	if t.tracer == nil {
+		return
+	}
+	tracer := t.tracer
+	t.tracer = nil
+
+	if tracer.OnTxEnd != nil {
+		tracer.OnTxEnd(receipt, err)
+	}
+	// the transaction wasn't executed
+	if err != nil {
+		return
+	}
+	result, err := tracer.GetResult()
+	if err != nil {
+		log.Warn("Failed to get the live call trace", "tx", t.txHash, "err", err)
+		return
+	}
+	t.output.write(CallTraceRecord{
+		BlockNumber: t.blockNumber.Uint64(),
+		TxHash:      t.txHash,
+		Result:      result,
+	})
+}
+
+func (t *liveCallTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.tracer != nil && t.tracer.OnEnter != nil {
+		t.tracer.OnEnter(depth, typ, from, to, input, gas, value)
+	}
+}
+
+func (t *liveCallTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if t.tracer != nil && t.tracer.OnExit != nil {
+		t.tracer.OnExit(depth, output, gasUsed, err, reverted)
+	}
+}
+
+func (t *liveCallTracer) OnLog(log *types.Log) {
+	if t.tracer != nil && t.tracer.OnLog != nil {
+		t.tracer.OnLog(log)
+	}
+}
@@ -0,0 +1,189 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// LiveJSONLTracerName is the name under which the JSONL live tracer is
+// registered in the go-ethereum live tracers directory.
+const LiveJSONLTracerName = "jsonl"
+
+func init() {
+	tracers.LiveDirectory.Register(LiveJSONLTracerName, newJSONLTracer)
+}
+
+// JSONLEvent is a record of the JSONL live tracer. Only the fields relevant to
+// the event are set.
+type JSONLEvent struct {
+	Event       string          `json:"event"`
+	BlockNumber uint64          `json:"blockNumber"`
+	BlockHash   *common.Hash    `json:"blockHash,omitempty"`
+	TxHash      *common.Hash    `json:"txHash,omitempty"`
+	Address     *common.Address `json:"address,omitempty"`
+	Depth       *int            `json:"depth,omitempty"`
+	Type        string          `json:"type,omitempty"`
+	From        *common.Address `json:"from,omitempty"`
+	To          *common.Address `json:"to,omitempty"`
+	Input       hexutil.Bytes   `json:"input,omitempty"`
+	Output      hexutil.Bytes   `json:"output,omitempty"`
+	Gas         *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed     *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Value       *hexutil.Big    `json:"value,omitempty"`
+	Prev        *hexutil.Big    `json:"prev,omitempty"`
+	New         *hexutil.Big    `json:"new,omitempty"`
+	Reason      string          `json:"reason,omitempty"`
+	Status      *hexutil.Uint64 `json:"status,omitempty"`
+	Reverted    bool            `json:"reverted,omitempty"`
+	Error       string          `json:"error,omitempty"`
+	Log         *types.Log      `json:"log,omitempty"`
+}
+
+// jsonlTracer writes one JSON record for every block, transaction, call frame,
+// balance change, nonce change and log of the chain execution.
+type jsonlTracer struct {
+	output      *liveOutput
+	blockNumber uint64
+	txHash      *common.Hash
+
+	// cometBlockHash is the hash of the CometBFT block starting next, if reported
+	cometBlockHash common.Hash
+}
+
+func newJSONLTracer(cfg json.RawMessage) (*tracing.Hooks, error) {
+	output, err := newLiveOutput(cfg, "trace.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	t := &jsonlTracer{output: output}
+	hooks := &tracing.Hooks{
+		OnBlockStart:    t.OnBlockStart,
+		OnBlockEnd:      t.OnBlockEnd,
+		OnTxStart:       t.OnTxStart,
+		OnTxEnd:         t.OnTxEnd,
+		OnEnter:         t.OnEnter,
+		OnExit:          t.OnExit,
+		OnBalanceChange: t.OnBalanceChange,
+		OnNonceChangeV2: t.OnNonceChange,
+		OnLog:           t.OnLog,
+		OnClose:         t.output.close,
+	}
+	RegisterBlockHashHook(hooks, t.OnBlockHash)
+	return hooks, nil
+}
+
+func (t *jsonlTracer) write(event JSONLEvent) {
+	event.BlockNumber = t.blockNumber
+	event.TxHash = t.txHash
+	t.output.write(event)
+}
+
+// OnBlockHash records the hash of the CometBFT block reported by the next
+// block start.
+func (t *jsonlTracer) OnBlockHash(hash common.Hash) {
+	t.cometBlockHash = hash
+}
+
+func (t *jsonlTracer) OnBlockStart(ev tracing.BlockEvent) {
+	t.blockNumber = ev.Block.NumberU64()
+	t.txHash = nil
+	blockHash := ev.Block.Hash()
+	if t.cometBlockHash != (common.Hash{}) {
+		blockHash, t.cometBlockHash = t.cometBlockHash, common.Hash{}
+	}
+	coinbase := ev.Block.Coinbase()
+	t.write(JSONLEvent{Event: "blockStart", BlockHash: &blockHash, Address: &coinbase})
+}
+
+func (t *jsonlTracer) OnBlockEnd(err error) {
+	t.txHash = nil
+	t.write(JSONLEvent{Event: "blockEnd", Error: errString(err)})
+}
+
+func (t *jsonlTracer) OnTxStart(_ *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	hash := tx.Hash()
+	t.txHash = &hash
+	gas := hexutil.Uint64(tx.Gas())
+	t.write(JSONLEvent{
+		Event: "txStart",
+		From:  &from,
+		To:    tx.To(),
+		Input: tx.Data(),
+		Gas:   &gas,
+		Value: (*hexutil.Big)(tx.Value()),
+	})
+}
+
+func (t *jsonlTracer) OnTxEnd(receipt *types.Receipt, err error) {
+	event := JSONLEvent{Event: "txEnd", Error: errString(err)}
+	if receipt != nil {
+		gasUsed, status := hexutil.Uint64(receipt.GasUsed), hexutil.Uint64(receipt.Status)
+		event.GasUsed, event.Status = &gasUsed, &status
+	}
+	t.write(event)
+	t.txHash = nil
+}
+
+func (t *jsonlTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	g := hexutil.Uint64(gas)
+	t.write(JSONLEvent{
+		Event: "enter",
+		Depth: &depth,
+		Type:  vm.OpCode(typ).String(),
+		From:  &from,
+		To:    &to,
+		Input: input,
+		Gas:   &g,
+		Value: (*hexutil.Big)(value),
+	})
+}
+
+func (t *jsonlTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	used := hexutil.Uint64(gasUsed)
+	t.write(JSONLEvent{
+		Event:    "exit",
+		Depth:    &depth,
+		Output:   output,
+		GasUsed:  &used,
+		Reverted: reverted,
+		Error:    errString(err),
+	})
+}
+
+func (t *jsonlTracer) OnBalanceChange(addr common.Address, prev, newBalance *big.Int, reason tracing.BalanceChangeReason) {
+	t.write(JSONLEvent{
+		Event:   "balanceChange",
+		Address: &addr,
+		Prev:    (*hexutil.Big)(prev),
+		New:     (*hexutil.Big)(newBalance),
+		Reason:  reason.String(),
+	})
+}
+
+func (t *jsonlTracer) OnNonceChange(addr common.Address, prev, nonce uint64, reason tracing.NonceChangeReason) {
+	t.write(JSONLEvent{
+		Event:   "nonceChange",
+		Address: &addr,
+		Prev:    (*hexutil.Big)(new(big.Int).SetUint64(prev)),
+		New:     (*hexutil.Big)(new(big.Int).SetUint64(nonce)),
+		Reason:  reason.String(),
+	})
+}
+
+func (t *jsonlTracer) OnLog(log *types.Log) {
+	t.write(JSONLEvent{Event: "log", Log: log})
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
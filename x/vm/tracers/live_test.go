@@ -0,0 +1,129 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// traceTransfer calls the hooks for a block with a single value transfer.
+func traceTransfer(hooks *tracing.Hooks, from, to common.Address) *types.Transaction {
+	tx := types.NewTx(&types.LegacyTx{To: &to, Value: big.NewInt(10), Gas: 21000})
+	if hooks.OnBlockchainInit != nil {
+		hooks.OnBlockchainInit(params.MergedTestChainConfig)
+	}
+	hooks.OnBlockStart(tracing.BlockEvent{Block: types.NewBlockWithHeader(&types.Header{Number: big.NewInt(7)})})
+	hooks.OnTxStart(&tracing.VMContext{BlockNumber: big.NewInt(7)}, tx, from)
+	hooks.OnEnter(0, byte(vm.CALL), from, to, nil, 21000, big.NewInt(10))
+	hooks.OnExit(0, nil, 0, nil, false)
+	hooks.OnTxEnd(&types.Receipt{TxHash: tx.Hash(), GasUsed: 21000, Status: types.ReceiptStatusSuccessful}, nil)
+	if hooks.OnBlockEnd != nil {
+		hooks.OnBlockEnd(nil)
+	}
+	return tx
+}
+
+func TestLiveOutput(t *testing.T) {
+	RegisterLiveConsumer("test_output", func(json.RawMessage) {})
+
+	testCases := []struct {
+		name    string
+		config  string
+		expPass bool
+	}{
+		{"no output", `{}`, false},
+		{"invalid config", `[]`, false},
+		{"unknown consumer", `{"consumer": "unknown"}`, false},
+		{"path and consumer", `{"path": "/tmp", "consumer": "test_output"}`, false},
+		{"consumer", `{"consumer": "test_output"}`, true},
+		{"path", `{"path": "/tmp", "maxSize": 1}`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tracers.LiveDirectory.New(LiveJSONLTracerName, json.RawMessage(tc.config))
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONLTracer(t *testing.T) {
+	from, to := common.HexToAddress("0x1000"), common.HexToAddress("0x2000")
+	dir := t.TempDir()
+	hooks, err := tracers.LiveDirectory.New(LiveJSONLTracerName, json.RawMessage(`{"path": "`+dir+`"}`))
+	require.NoError(t, err)
+
+	blockHash := common.HexToHash("0xb10c")
+	GetBlockHashHook(hooks)(blockHash)
+	tx := traceTransfer(hooks, from, to)
+	hooks.OnClose()
+
+	bz, err := os.ReadFile(filepath.Join(dir, "trace.jsonl"))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	events := make([]JSONLEvent, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &events[i]))
+		require.Equal(t, uint64(7), events[i].BlockNumber)
+	}
+
+	expEvents := []string{"blockStart", "txStart", "enter", "exit", "txEnd", "blockEnd"}
+	require.Len(t, events, len(expEvents))
+	for i, event := range events {
+		require.Equal(t, expEvents[i], event.Event)
+		// only the transaction events hold the transaction hash
+		if i == 0 || i == len(events)-1 {
+			require.Nil(t, event.TxHash)
+		} else {
+			require.Equal(t, tx.Hash(), *event.TxHash)
+		}
+	}
+	require.Equal(t, &blockHash, events[0].BlockHash)
+	require.Equal(t, "CALL", events[2].Type)
+	require.Equal(t, uint64(types.ReceiptStatusSuccessful), uint64(*events[4].Status))
+}
+
+func TestLiveCallTracer(t *testing.T) {
+	from, to := common.HexToAddress("0x1000"), common.HexToAddress("0x2000")
+	var records []CallTraceRecord
+	RegisterLiveConsumer("test_calls", func(record json.RawMessage) {
+		var callRecord CallTraceRecord
+		require.NoError(t, json.Unmarshal(record, &callRecord))
+		records = append(records, callRecord)
+	})
+
+	hooks, err := tracers.LiveDirectory.New(LiveCallTracerName, json.RawMessage(`{"consumer": "test_calls"}`))
+	require.NoError(t, err)
+	tx := traceTransfer(hooks, from, to)
+
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(7), records[0].BlockNumber)
+	require.Equal(t, tx.Hash(), records[0].TxHash)
+
+	var frame struct {
+		Type  string         `json:"type"`
+		From  common.Address `json:"from"`
+		To    common.Address `json:"to"`
+		Value string         `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(records[0].Result, &frame))
+	require.Equal(t, "CALL", frame.Type)
+	require.Equal(t, from, frame.From)
+	require.Equal(t, to, frame.To)
+	require.Equal(t, "0xa", frame.Value)
+}
@@ -0,0 +1,269 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// VMTraceTracerName is the name under which the VM trace tracer is registered
+// in the go-ethereum tracers directory.
+const VMTraceTracerName = "vmTraceTracer"
+
+// maxMemoryDiffSize is the maximum size of the memory written by a single
+// operation that is reported in the trace.
+const maxMemoryDiffSize = 1024 * 1024
+
+func init() {
+	tracers.DefaultDirectory.Register(VMTraceTracerName, newVMTraceTracer, false)
+}
+
+// VMTrace is the Parity/OpenEthereum `vmTrace` of a call frame.
+type VMTrace struct {
+	Code hexutil.Bytes  `json:"code"`
+	Ops  []*VMOperation `json:"ops"`
+}
+
+// VMOperation is a single executed opcode of a VMTrace. Sub holds the trace of
+// the call frame created by the operation, if any.
+type VMOperation struct {
+	Cost uint64               `json:"cost"`
+	Ex   *VMExecutedOperation `json:"ex"`
+	PC   uint64               `json:"pc"`
+	Sub  *VMTrace             `json:"sub"`
+}
+
+// VMExecutedOperation holds the effects of an executed opcode.
+type VMExecutedOperation struct {
+	Used  uint64       `json:"used"`
+	Push  []string     `json:"push"`
+	Mem   *MemoryDiff  `json:"mem"`
+	Store *StorageDiff `json:"store"`
+}
+
+// MemoryDiff is a memory region written by an operation.
+type MemoryDiff struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StorageDiff is a storage slot written by an operation.
+type StorageDiff struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// vmTraceFrame is the state of the call frame being traced.
+type vmTraceFrame struct {
+	trace    *VMTrace
+	startGas uint64
+	// pending is the last operation, whose effects are only known once the
+	// next operation of the frame starts
+	pending   *VMOperation
+	pendingOp vm.OpCode
+	memOff    uint64
+	memSize   uint64
+	hasCode   bool
+}
+
+// vmTraceTracer builds the Parity/OpenEthereum `vmTrace` of a transaction.
+type vmTraceTracer struct {
+	root      *VMTrace
+	frames    []*vmTraceFrame
+	interrupt atomic.Bool
+	reason    error
+}
+
+func newVMTraceTracer(_ *tracers.Context, _ json.RawMessage, _ *params.ChainConfig) (*tracers.Tracer, error) {
+	t := &vmTraceTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnEnter:  t.OnEnter,
+			OnExit:   t.OnExit,
+			OnOpcode: t.OnOpcode,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+// OnEnter starts the trace of a new call frame and links it to the operation
+// of the parent frame that created it.
+func (t *vmTraceTracer) OnEnter(depth int, typ byte, _ common.Address, _ common.Address, input []byte, gas uint64, _ *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := &vmTraceFrame{
+		trace:    &VMTrace{Code: hexutil.Bytes{}, Ops: []*VMOperation{}},
+		startGas: gas,
+	}
+	if op := vm.OpCode(typ); op == vm.CREATE || op == vm.CREATE2 {
+		frame.trace.Code = common.CopyBytes(input)
+		frame.hasCode = true
+	}
+
+	if depth == 0 || len(t.frames) == 0 {
+		t.root = frame.trace
+	} else if parent := t.frames[len(t.frames)-1]; parent.pending != nil {
+		parent.pending.Sub = frame.trace
+	}
+	t.frames = append(t.frames, frame)
+}
+
+// OnExit completes the last operation of the frame and pops it.
+func (t *vmTraceTracer) OnExit(_ int, _ []byte, gasUsed uint64, _ error, _ bool) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.pending != nil {
+		frame.pending.Ex.Used = frame.startGas - min(gasUsed, frame.startGas)
+	}
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+// OnOpcode completes the previous operation of the frame, now that its effects
+// on the stack and memory are visible, and records the current one.
+func (t *vmTraceTracer) OnOpcode(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, _ []byte, _ int, _ error) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if !frame.hasCode {
+		frame.trace.Code = common.CopyBytes(scope.ContractCode())
+		frame.hasCode = true
+	}
+
+	stack := scope.StackData()
+	if frame.pending != nil {
+		frame.pending.Ex.Used = gas
+		frame.pending.Ex.Push = stackPush(frame.pendingOp, stack)
+		if frame.memSize > 0 && frame.memSize <= maxMemoryDiffSize {
+			memory := scope.MemoryData()
+			if end := frame.memOff + frame.memSize; end >= frame.memOff && end <= uint64(len(memory)) {
+				frame.pending.Ex.Mem = &MemoryDiff{
+					Off:  frame.memOff,
+					Data: common.CopyBytes(memory[frame.memOff:end]),
+				}
+			}
+		}
+	}
+
+	opcode := vm.OpCode(op)
+	operation := &VMOperation{
+		Cost: cost,
+		PC:   pc,
+		Ex:   &VMExecutedOperation{Push: []string{}},
+	}
+	if opcode == vm.SSTORE && len(stack) >= 2 {
+		operation.Ex.Store = &StorageDiff{
+			Key: stack[len(stack)-1].Hex(),
+			Val: stack[len(stack)-2].Hex(),
+		}
+	}
+	frame.memOff, frame.memSize = memoryWrite(opcode, stack)
+	frame.pending = operation
+	frame.pendingOp = opcode
+	frame.trace.Ops = append(frame.trace.Ops, operation)
+}
+
+// GetResult returns the json-encoded VM trace of the transaction.
+func (t *vmTraceTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return json.RawMessage("null"), t.reason
+	}
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTraceTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
+// stackPush returns the items pushed to the stack by the given operation,
+// ordered from the bottom to the top of the stack.
+func stackPush(op vm.OpCode, stack []uint256.Int) []string {
+	var n int
+	switch {
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		n = int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		n = int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		n = 0
+	default:
+		switch op {
+		case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE,
+			vm.JUMP, vm.JUMPI, vm.JUMPDEST, vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID,
+			vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.EXTCODECOPY, vm.MCOPY:
+			n = 0
+		default:
+			n = 1
+		}
+	}
+	n = min(n, len(stack))
+
+	push := make([]string, 0, n)
+	for _, item := range stack[len(stack)-n:] {
+		push = append(push, item.Hex())
+	}
+	return push
+}
+
+// memoryWrite returns the memory region written by the given operation, read
+// from the stack before its execution.
+func memoryWrite(op vm.OpCode, stack []uint256.Int) (offset, size uint64) {
+	peek := func(n int) (uint64, bool) {
+		if len(stack) <= n {
+			return 0, false
+		}
+		v := stack[len(stack)-1-n]
+		return v.Uint64(), v.IsUint64()
+	}
+	region := func(offN, sizeN int) (uint64, uint64) {
+		off, ok1 := peek(offN)
+		sz, ok2 := peek(sizeN)
+		if !ok1 || !ok2 {
+			return 0, 0
+		}
+		return off, sz
+	}
+
+	switch op {
+	case vm.MSTORE:
+		off, ok := peek(0)
+		if !ok {
+			return 0, 0
+		}
+		return off, 32
+	case vm.MSTORE8:
+		off, ok := peek(0)
+		if !ok {
+			return 0, 0
+		}
+		return off, 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return region(0, 2)
+	case vm.EXTCODECOPY:
+		return region(1, 3)
+	case vm.CALL, vm.CALLCODE:
+		return region(5, 6)
+	case vm.DELEGATECALL, vm.STATICCALL:
+		return region(4, 5)
+	default:
+		return 0, 0
+	}
+}
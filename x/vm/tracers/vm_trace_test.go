@@ -0,0 +1,93 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVMTraceTracer(t *testing.T) {
+	var (
+		caller = common.HexToAddress("0x1000")
+		callee = common.HexToAddress("0x2000")
+		target = common.HexToAddress("0x3000")
+	)
+
+	// PUSH1 0x2a PUSH1 0x01 SSTORE PUSH1 0x07 PUSH1 0x00 MSTORE
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH2 0x3000 GAS CALL STOP
+	code := []byte{
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x01, byte(vm.SSTORE),
+		byte(vm.PUSH1), 0x07, byte(vm.PUSH1), 0x00, byte(vm.MSTORE),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH2), 0x30, 0x00, byte(vm.GAS), byte(vm.CALL), byte(vm.STOP),
+	}
+	// PUSH1 0x01 STOP
+	targetCode := []byte{byte(vm.PUSH1), 0x01, byte(vm.STOP)}
+
+	db, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	db.SetCode(callee, code)
+	db.SetCode(target, targetCode)
+	db.SetBalance(caller, uint256.NewInt(1e18), tracing.BalanceChangeUnspecified)
+
+	tracer, err := tracers.DefaultDirectory.New(VMTraceTracerName, &tracers.Context{}, nil, params.MergedTestChainConfig)
+	require.NoError(t, err)
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+		Time:        1,
+		Difficulty:  big.NewInt(0),
+		GasLimit:    10_000_000,
+		BaseFee:     big.NewInt(0),
+		Random:      &common.Hash{},
+	}
+	evm := vm.NewEVM(blockCtx, db, params.MergedTestChainConfig, vm.Config{Tracer: tracer.Hooks})
+	_, _, err = evm.Call(caller, callee, nil, 1_000_000, uint256.NewInt(0))
+	require.NoError(t, err)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var trace VMTrace
+	require.NoError(t, json.Unmarshal(res, &trace))
+	require.Equal(t, code, []byte(trace.Code))
+	require.Len(t, trace.Ops, 15)
+
+	// PUSH1 0x2a
+	require.Equal(t, []string{"0x2a"}, trace.Ops[0].Ex.Push)
+	require.Equal(t, uint64(3), trace.Ops[0].Cost)
+	// SSTORE
+	sstore := trace.Ops[2]
+	require.Equal(t, uint64(4), sstore.PC)
+	require.Empty(t, sstore.Ex.Push)
+	require.Equal(t, &StorageDiff{Key: "0x1", Val: "0x2a"}, sstore.Ex.Store)
+	// MSTORE
+	mstore := trace.Ops[5]
+	require.NotNil(t, mstore.Ex.Mem)
+	require.Equal(t, uint64(0), mstore.Ex.Mem.Off)
+	require.Equal(t, common.BigToHash(big.NewInt(7)).Bytes(), []byte(mstore.Ex.Mem.Data))
+	// GAS used decreases along the execution
+	require.Less(t, trace.Ops[1].Ex.Used, trace.Ops[0].Ex.Used)
+
+	// CALL
+	call := trace.Ops[13]
+	require.Equal(t, []string{"0x1"}, call.Ex.Push)
+	require.NotNil(t, call.Sub)
+	require.Equal(t, targetCode, []byte(call.Sub.Code))
+	require.Len(t, call.Sub.Ops, 2)
+	require.Equal(t, []string{"0x1"}, call.Sub.Ops[0].Ex.Push)
+	require.Nil(t, trace.Ops[14].Sub)
+}
@@ -6,6 +6,17 @@ import (
 	"github.com/cosmos/evm/eips"
 )
 
+const (
+	// opcodeGasEIPBase is the first of the EIP numbers reserved for the activators of the opcode
+	// gas overrides, far above the numbers of the Ethereum EIPs.
+	opcodeGasEIPBase = 1 << 24
+	// opcodeGasBits is the number of bits of the constant gas set by the opcode gas overrides.
+	opcodeGasBits = 32
+)
+
+// MaxOpcodeConstantGas is the maximum constant gas of an opcode gas override.
+const MaxOpcodeConstantGas = 1<<opcodeGasBits - 1
+
 // DefaultCosmosEVMActivators defines a map of opcode modifiers associated
 // with a key defining the corresponding EIP.
 var DefaultCosmosEVMActivators = map[int]func(*vm.JumpTable){
@@ -13,3 +24,61 @@ var DefaultCosmosEVMActivators = map[int]func(*vm.JumpTable){
 	0o001: eips.Enable0001,
 	0o002: eips.Enable0002,
 }
+
+// opcodeGasEIP returns the reserved EIP number of an activator of the opcode. The first one
+// clears the constant gas of the opcode, while the following ones add each bit of it.
+func opcodeGasEIP(op vm.OpCode, index int) int {
+	return opcodeGasEIPBase + int(op)*(opcodeGasBits+1) + index
+}
+
+// isOpcodeGasEIP returns true if the EIP number is reserved for the opcode gas overrides.
+func isOpcodeGasEIP(eip int64) bool {
+	return eip >= opcodeGasEIPBase && eip < int64(opcodeGasEIP(vm.OpCode(255), opcodeGasBits+1))
+}
+
+// opcodeGasActivators returns the activators setting the constant gas of the opcodes, which are
+// enabled through the reserved EIP numbers returned by OpcodeGasEIPs.
+func opcodeGasActivators() map[int]func(*vm.JumpTable) {
+	activators := make(map[int]func(*vm.JumpTable), 256*(opcodeGasBits+1))
+	for i := 0; i < 256; i++ {
+		op := vm.OpCode(i)
+		activators[opcodeGasEIP(op, 0)] = func(jt *vm.JumpTable) {
+			if jt[op] != nil {
+				jt[op].SetConstantGas(0)
+			}
+		}
+		for bit := 0; bit < opcodeGasBits; bit++ {
+			activators[opcodeGasEIP(op, bit+1)] = func(jt *vm.JumpTable) {
+				if jt[op] != nil {
+					jt[op].SetConstantGas(jt[op].GetConstantGas() | 1<<bit)
+				}
+			}
+		}
+	}
+	return activators
+}
+
+// extendOpcodeGasActivators adds the activators of the opcode gas overrides to the go-ethereum
+// activators map, unless they have been added by a previous configuration.
+func extendOpcodeGasActivators() error {
+	if vm.ValidEip(opcodeGasEIP(0, 0)) {
+		return nil
+	}
+	return vm.ExtendActivators(opcodeGasActivators())
+}
+
+// OpcodeGasEIPs returns the reserved EIP numbers applying the opcode gas overrides to the jump
+// table, after the extra EIPs of the params.
+func OpcodeGasEIPs(overrides []OpcodeGasOverride) []int {
+	var eips []int
+	for _, override := range overrides {
+		op := vm.StringToOp(override.Opcode)
+		eips = append(eips, opcodeGasEIP(op, 0))
+		for bit := 0; bit < opcodeGasBits; bit++ {
+			if override.ConstantGas&(1<<bit) != 0 {
+				eips = append(eips, opcodeGasEIP(op, bit+1))
+			}
+		}
+	}
+	return eips
+}
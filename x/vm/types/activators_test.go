@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpcodeGasEIPs(t *testing.T) {
+	require.NoError(t, extendOpcodeGasActivators())
+	// the activators are only added once
+	require.NoError(t, extendOpcodeGasActivators())
+
+	overrides := []OpcodeGasOverride{
+		{Opcode: "ADDRESS", ConstantGas: 1002},
+		{Opcode: "SLOAD", ConstantGas: 0},
+		{Opcode: "ADD", ConstantGas: MaxOpcodeConstantGas},
+	}
+	jt, err := vm.LookupInstructionSet(params.MergedTestChainConfig.Rules(big.NewInt(1), true, 0))
+	require.NoError(t, err)
+	for _, eip := range OpcodeGasEIPs(overrides) {
+		require.True(t, isOpcodeGasEIP(int64(eip)))
+		require.NoError(t, vm.EnableEIP(eip, &jt))
+	}
+
+	require.Equal(t, uint64(1002), jt[vm.ADDRESS].GetConstantGas())
+	require.Equal(t, uint64(0), jt[vm.SLOAD].GetConstantGas())
+	require.Equal(t, uint64(MaxOpcodeConstantGas), jt[vm.ADD].GetConstantGas())
+	require.Equal(t, uint64(2), jt[vm.ORIGIN].GetConstantGas())
+}
+
+func TestIsOpcodeGasEIP(t *testing.T) {
+	require.False(t, isOpcodeGasEIP(3855))
+	require.False(t, isOpcodeGasEIP(opcodeGasEIPBase-1))
+	require.True(t, isOpcodeGasEIP(opcodeGasEIPBase))
+	require.True(t, isOpcodeGasEIP(int64(opcodeGasEIP(vm.OpCode(255), opcodeGasBits))))
+	require.False(t, isOpcodeGasEIP(int64(opcodeGasEIP(vm.OpCode(255), opcodeGasBits+1))))
+}
@@ -20,7 +20,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/evm/MsgUpdateParams"
+	updateParamsName      = "os/evm/MsgUpdateParams"
+	scheduleHardForkName  = "os/evm/MsgScheduleHardFork"
+	updateChainConfigName = "os/evm/MsgUpdateChainConfig"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +41,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgScheduleHardFork{},
+		&MsgUpdateChainConfig{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +51,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgScheduleHardFork{}, scheduleHardForkName, nil)
+	cdc.RegisterConcrete(&MsgUpdateChainConfig{}, updateChainConfigName, nil)
 }
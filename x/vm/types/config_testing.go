@@ -38,6 +38,10 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	if err := extendOpcodeGasActivators(); err != nil {
+		return err
+	}
+
 	// After applying modifications, the configurator is sealed. This way, it is not possible
 	// to call the configure method twice.
 	ec.sealed = true
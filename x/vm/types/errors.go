@@ -32,6 +32,7 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrRejectedByHook
 )
 
 var (
@@ -92,6 +93,13 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrRejectedByHook returns an error if a message is rejected by an evm hook
+	ErrRejectedByHook = errorsmod.Register(ModuleName, codeErrRejectedByHook, "message rejected by hook")
+
+	// ErrSkipEvmHooks is returned by an evm hook of MultiEvmHooks to skip the remaining hooks
+	// without error.
+	ErrSkipEvmHooks = errors.New("skip the remaining evm hooks")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
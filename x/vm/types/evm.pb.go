@@ -73,6 +73,21 @@ type Params struct {
 	ActiveStaticPrecompiles []string              `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64                `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 	ExtendedDenomOptions    *ExtendedDenomOptions `protobuf:"bytes,11,opt,name=extended_denom_options,json=extendedDenomOptions,proto3" json:"extended_denom_options,omitempty"`
+	// opcode_gas_overrides defines the constant gas of the opcodes, overriding
+	// the gas schedule of the hard fork and of the extra EIPs
+	OpcodeGasOverrides []OpcodeGasOverride `protobuf:"bytes,12,rep,name=opcode_gas_overrides,json=opcodeGasOverrides,proto3" json:"opcode_gas_overrides"`
+	// max_code_size defines the maximum size of the code deployed by a contract
+	// creation transaction (EIP-170). Zero means the Ethereum limit, which can
+	// only be lowered and bounds the CREATE and CREATE2 opcodes.
+	MaxCodeSize uint64 `protobuf:"varint,13,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size defines the maximum size of the init code of a
+	// contract creation transaction (EIP-3860). Zero means the Ethereum limit,
+	// which can only be lowered and bounds the CREATE and CREATE2 opcodes.
+	MaxInitCodeSize uint64 `protobuf:"varint,14,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
+	// refund_quotient defines the cap on the gas refund as the quotient of the
+	// gas used, which is at least the EIP-3529 one. Zero means the quotient of
+	// the hard fork.
+	RefundQuotient uint64 `protobuf:"varint,15,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -157,6 +172,90 @@ func (m *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
 	return nil
 }
 
+func (m *Params) GetOpcodeGasOverrides() []OpcodeGasOverride {
+	if m != nil {
+		return m.OpcodeGasOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetRefundQuotient() uint64 {
+	if m != nil {
+		return m.RefundQuotient
+	}
+	return 0
+}
+
+// OpcodeGasOverride defines the constant gas of an opcode
+type OpcodeGasOverride struct {
+	// opcode is the name of the opcode, e.g. SSTORE
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged for the opcode, in addition to
+	// its dynamic gas
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+}
+
+func (m *OpcodeGasOverride) Reset()         { *m = OpcodeGasOverride{} }
+func (m *OpcodeGasOverride) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGasOverride) ProtoMessage()    {}
+func (*OpcodeGasOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *OpcodeGasOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpcodeGasOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGasOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpcodeGasOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGasOverride.Merge(m, src)
+}
+func (m *OpcodeGasOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpcodeGasOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGasOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGasOverride proto.InternalMessageInfo
+
+func (m *OpcodeGasOverride) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGasOverride) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
 type ExtendedDenomOptions struct {
 	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
 }
@@ -165,7 +264,7 @@ func (m *ExtendedDenomOptions) Reset()         { *m = ExtendedDenomOptions{} }
 func (m *ExtendedDenomOptions) String() string { return proto.CompactTextString(m) }
 func (*ExtendedDenomOptions) ProtoMessage()    {}
 func (*ExtendedDenomOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *ExtendedDenomOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,13 +307,16 @@ type AccessControl struct {
 	Create AccessControlType `protobuf:"bytes,1,opt,name=create,proto3" json:"create"`
 	// call defines the permission policy for calling contracts
 	Call AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call"`
+	// contracts defines the permission policies for calling specific contracts,
+	// which apply in addition to the call permission policy
+	Contracts []ContractAccessControl `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts"`
 }
 
 func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,6 +359,138 @@ func (m *AccessControl) GetCall() AccessControlType {
 	return AccessControlType{}
 }
 
+func (m *AccessControl) GetContracts() []ContractAccessControl {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+// ContractAccessControl defines the permission policies for calling a target
+// contract
+type ContractAccessControl struct {
+	// address is the hex address of the target contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// call defines the permission policy for the calls to the contract that
+	// don't match any of the function policies
+	Call AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call"`
+	// functions defines the permission policies for calling specific functions
+	// of the contract. They apply to the transaction calls and to the calls of
+	// the other contracts, matched by the selector in their input.
+	Functions []FunctionAccessControl `protobuf:"bytes,3,rep,name=functions,proto3" json:"functions"`
+}
+
+func (m *ContractAccessControl) Reset()         { *m = ContractAccessControl{} }
+func (m *ContractAccessControl) String() string { return proto.CompactTextString(m) }
+func (*ContractAccessControl) ProtoMessage()    {}
+func (*ContractAccessControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
+}
+func (m *ContractAccessControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAccessControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAccessControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAccessControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAccessControl.Merge(m, src)
+}
+func (m *ContractAccessControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAccessControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAccessControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAccessControl proto.InternalMessageInfo
+
+func (m *ContractAccessControl) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractAccessControl) GetCall() AccessControlType {
+	if m != nil {
+		return m.Call
+	}
+	return AccessControlType{}
+}
+
+func (m *ContractAccessControl) GetFunctions() []FunctionAccessControl {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+// FunctionAccessControl defines the permission policy for calling a function of
+// a target contract
+type FunctionAccessControl struct {
+	// selector is the hex encoded 4 bytes selector of the function, e.g.
+	// 0x40c10f19 for mint(address,uint256)
+	Selector string `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// call defines the permission policy for the calls to the function
+	Call AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call"`
+}
+
+func (m *FunctionAccessControl) Reset()         { *m = FunctionAccessControl{} }
+func (m *FunctionAccessControl) String() string { return proto.CompactTextString(m) }
+func (*FunctionAccessControl) ProtoMessage()    {}
+func (*FunctionAccessControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
+}
+func (m *FunctionAccessControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunctionAccessControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunctionAccessControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunctionAccessControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionAccessControl.Merge(m, src)
+}
+func (m *FunctionAccessControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunctionAccessControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionAccessControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionAccessControl proto.InternalMessageInfo
+
+func (m *FunctionAccessControl) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *FunctionAccessControl) GetCall() AccessControlType {
+	if m != nil {
+		return m.Call
+	}
+	return AccessControlType{}
+}
+
 // AccessControlType defines the permission type for policies
 type AccessControlType struct {
 	// access_type defines which type of permission is required for the operation
@@ -275,7 +509,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +619,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,6 +676,81 @@ func (m *ChainConfig) GetDecimals() uint64 {
 	return 0
 }
 
+// HardFork defines an EVM hard fork of the chain config and its activation.
+type HardFork struct {
+	// name of the hard fork, as the chain config field without its block or time
+	// suffix (e.g. "prague")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// activation is the block height or, for the time based hard forks, the block
+	// timestamp from which the hard fork is active
+	Activation uint64 `protobuf:"varint,2,opt,name=activation,proto3" json:"activation,omitempty"`
+	// time_based is true if the activation is a block timestamp
+	TimeBased bool `protobuf:"varint,3,opt,name=time_based,json=timeBased,proto3" json:"time_based,omitempty"`
+	// active is true if the hard fork is active at the queried height
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *HardFork) Reset()         { *m = HardFork{} }
+func (m *HardFork) String() string { return proto.CompactTextString(m) }
+func (*HardFork) ProtoMessage()    {}
+func (*HardFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
+}
+func (m *HardFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HardFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HardFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HardFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HardFork.Merge(m, src)
+}
+func (m *HardFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *HardFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_HardFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HardFork proto.InternalMessageInfo
+
+func (m *HardFork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HardFork) GetActivation() uint64 {
+	if m != nil {
+		return m.Activation
+	}
+	return 0
+}
+
+func (m *HardFork) GetTimeBased() bool {
+	if m != nil {
+		return m.TimeBased
+	}
+	return false
+}
+
+func (m *HardFork) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -454,7 +763,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +820,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +898,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +1020,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +1061,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +1121,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{14}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1242,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{11}
+	return fileDescriptor_d1129b8db63d55c7, []int{15}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1303,7 @@ func (m *EvmCoinInfo) Reset()         { *m = EvmCoinInfo{} }
 func (m *EvmCoinInfo) String() string { return proto.CompactTextString(m) }
 func (*EvmCoinInfo) ProtoMessage()    {}
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{12}
+	return fileDescriptor_d1129b8db63d55c7, []int{16}
 }
 func (m *EvmCoinInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,10 +1363,14 @@ func (m *EvmCoinInfo) GetDecimals() uint32 {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*OpcodeGasOverride)(nil), "cosmos.evm.vm.v1.OpcodeGasOverride")
 	proto.RegisterType((*ExtendedDenomOptions)(nil), "cosmos.evm.vm.v1.ExtendedDenomOptions")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
+	proto.RegisterType((*ContractAccessControl)(nil), "cosmos.evm.vm.v1.ContractAccessControl")
+	proto.RegisterType((*FunctionAccessControl)(nil), "cosmos.evm.vm.v1.FunctionAccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
+	proto.RegisterType((*HardFork)(nil), "cosmos.evm.vm.v1.HardFork")
 	proto.RegisterType((*State)(nil), "cosmos.evm.vm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "cosmos.evm.vm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "cosmos.evm.vm.v1.Log")
@@ -1071,139 +1384,156 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
-	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	// 2369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0x23, 0xb7,
+	0xf5, 0xb7, 0x2c, 0xd9, 0x1e, 0x51, 0xb2, 0x3c, 0xa6, 0x65, 0xef, 0xac, 0x36, 0xf1, 0x38, 0x93,
+	0xff, 0xbf, 0x71, 0xd3, 0xd4, 0x5e, 0x3b, 0x71, 0xbb, 0xd8, 0x34, 0x2d, 0x2c, 0x5b, 0x49, 0xec,
+	0xec, 0x87, 0x4b, 0xb9, 0x59, 0xa4, 0x4d, 0x31, 0xa0, 0x66, 0x68, 0x69, 0xe2, 0x99, 0xa1, 0x3a,
+	0x1c, 0x69, 0xe5, 0x7d, 0x81, 0x06, 0xdb, 0x9b, 0xbc, 0xc0, 0x02, 0x01, 0x7a, 0x93, 0xcb, 0xbc,
+	0x40, 0x81, 0x5e, 0xe6, 0x32, 0x97, 0x45, 0x81, 0x0e, 0x0a, 0xef, 0x45, 0x50, 0x5f, 0xfa, 0x09,
+	0x0a, 0x7e, 0xe8, 0x5b, 0x2b, 0xb8, 0x68, 0x01, 0xc1, 0xe6, 0x39, 0x3c, 0xe7, 0xf7, 0xe3, 0x21,
+	0x0f, 0xc9, 0xc3, 0x01, 0x25, 0x87, 0xb2, 0x80, 0xb2, 0x6d, 0xd2, 0x0e, 0xb6, 0xf9, 0x6f, 0x87,
+	0xb7, 0xb6, 0x9a, 0x11, 0x8d, 0x29, 0xd4, 0x65, 0xdf, 0x16, 0xd7, 0xf0, 0xdf, 0x4e, 0x69, 0x19,
+	0x07, 0x5e, 0x48, 0xb7, 0xc5, 0x5f, 0x69, 0x54, 0x2a, 0xd6, 0x69, 0x9d, 0x8a, 0xe6, 0x36, 0x6f,
+	0x49, 0xad, 0xf5, 0xaf, 0x39, 0x30, 0x7f, 0x82, 0x23, 0x1c, 0x30, 0xb8, 0x03, 0xb2, 0xa4, 0x1d,
+	0xd8, 0x2e, 0x09, 0x69, 0x60, 0xa4, 0x36, 0x52, 0x9b, 0xd9, 0x72, 0xf1, 0x3a, 0x31, 0xf5, 0x0b,
+	0x1c, 0xf8, 0xf7, 0xad, 0x5e, 0x97, 0x85, 0x34, 0xd2, 0x0e, 0x0e, 0x79, 0x13, 0xee, 0x03, 0x40,
+	0x3a, 0x71, 0x84, 0x6d, 0xe2, 0x35, 0x99, 0x91, 0xd9, 0x48, 0x6f, 0xa6, 0xcb, 0xd6, 0x65, 0x62,
+	0x66, 0x2b, 0x5c, 0x5b, 0x39, 0x3a, 0x61, 0xd7, 0x89, 0xb9, 0xac, 0x00, 0x7a, 0x86, 0x16, 0xca,
+	0x0a, 0xa1, 0xe2, 0x35, 0x19, 0xdc, 0x05, 0x79, 0x0e, 0xed, 0x34, 0x70, 0x18, 0x12, 0x9f, 0x19,
+	0x0b, 0x1b, 0xe9, 0xcd, 0x6c, 0x79, 0xe9, 0x32, 0x31, 0x73, 0x95, 0x4f, 0x1f, 0x1e, 0x28, 0x35,
+	0xca, 0x91, 0x76, 0xd0, 0x15, 0xe0, 0xef, 0x41, 0x01, 0x3b, 0x0e, 0x61, 0xcc, 0x76, 0x68, 0x18,
+	0x47, 0xd4, 0x37, 0xb4, 0x8d, 0xd4, 0x66, 0x6e, 0xd7, 0xdc, 0x1a, 0x9d, 0x88, 0xad, 0x7d, 0x61,
+	0x77, 0x20, 0xcd, 0xca, 0xab, 0xdf, 0x25, 0xe6, 0xcc, 0x65, 0x62, 0x2e, 0x0e, 0xa9, 0xd1, 0x22,
+	0x1e, 0x14, 0xe1, 0x7d, 0x70, 0x1b, 0x3b, 0xb1, 0xd7, 0x26, 0x36, 0x8b, 0x71, 0xec, 0x39, 0x76,
+	0x33, 0x22, 0x0e, 0x0d, 0x9a, 0x9e, 0x4f, 0x98, 0x91, 0xe5, 0xe3, 0x43, 0xb7, 0xa4, 0x41, 0x55,
+	0xf4, 0x9f, 0xf4, 0xbb, 0xe1, 0x5d, 0x50, 0x6c, 0x78, 0x2c, 0xa6, 0xd1, 0x85, 0xcd, 0x48, 0xd4,
+	0x26, 0xf6, 0x53, 0x2f, 0x74, 0xe9, 0x53, 0x03, 0x6c, 0xa4, 0x36, 0x33, 0x08, 0xaa, 0xbe, 0x2a,
+	0xef, 0x7a, 0x22, 0x7a, 0xe0, 0xe7, 0x60, 0x8d, 0x74, 0x62, 0x12, 0xba, 0xc4, 0x95, 0x13, 0x6c,
+	0xd3, 0x66, 0xec, 0xd1, 0x90, 0x19, 0x39, 0x11, 0xd4, 0x8f, 0xc6, 0x83, 0xaa, 0x28, 0x7b, 0xb1,
+	0x08, 0x8f, 0xa5, 0x35, 0x2a, 0x92, 0x09, 0x5a, 0xf8, 0x3b, 0x50, 0xa4, 0x4d, 0x87, 0xba, 0xc4,
+	0xae, 0x63, 0x66, 0xd3, 0x36, 0x89, 0x22, 0xcf, 0x25, 0xcc, 0xc8, 0x6f, 0xa4, 0x37, 0x73, 0xbb,
+	0x6f, 0x8e, 0x63, 0x3f, 0x16, 0xd6, 0x1f, 0x61, 0xf6, 0x58, 0xd9, 0x96, 0x33, 0x7c, 0xd2, 0x10,
+	0xa4, 0xa3, 0x1d, 0x0c, 0x5a, 0x60, 0x31, 0xc0, 0x1d, 0x5b, 0xc0, 0x33, 0xef, 0x19, 0x31, 0x16,
+	0x45, 0x94, 0xb9, 0x00, 0x77, 0x0e, 0xa8, 0x4b, 0xaa, 0xde, 0x33, 0x02, 0x7f, 0x02, 0x20, 0xb7,
+	0xf1, 0x42, 0x2f, 0x1e, 0x30, 0x2c, 0x08, 0xc3, 0xa5, 0x00, 0x77, 0x8e, 0x42, 0x2f, 0xee, 0x19,
+	0xbf, 0x05, 0x96, 0x22, 0x72, 0xd6, 0x0a, 0x5d, 0xfb, 0x0f, 0x2d, 0x1a, 0x7b, 0x24, 0x8c, 0x8d,
+	0x25, 0x61, 0x59, 0x90, 0xea, 0x5f, 0x2b, 0xed, 0xfd, 0x3b, 0xcf, 0x7f, 0xf8, 0xf6, 0xed, 0xb5,
+	0x81, 0x2d, 0xd1, 0xe1, 0x9b, 0x42, 0x26, 0xf2, 0x71, 0x46, 0x9b, 0xd5, 0xd3, 0xc7, 0x19, 0x2d,
+	0xad, 0x67, 0x8e, 0x33, 0xda, 0x9c, 0x3e, 0x7f, 0x9c, 0xd1, 0xe6, 0xf5, 0x05, 0xeb, 0x11, 0x58,
+	0x1e, 0x8b, 0x0e, 0xae, 0x81, 0x79, 0x19, 0x99, 0x4c, 0x79, 0xa4, 0x24, 0xf8, 0x06, 0xc8, 0x3b,
+	0x34, 0x64, 0x31, 0x0e, 0x63, 0x3e, 0x75, 0xc6, 0xac, 0x0c, 0xad, 0xab, 0xfb, 0x08, 0x33, 0xeb,
+	0x03, 0x50, 0x9c, 0xb4, 0x12, 0xf0, 0xff, 0x41, 0x61, 0x78, 0x45, 0x15, 0xf4, 0xe2, 0xd0, 0x0a,
+	0x59, 0x49, 0x0a, 0x0c, 0xe7, 0x21, 0xdc, 0x07, 0xf3, 0x4e, 0x44, 0x70, 0x2c, 0xc7, 0x32, 0x71,
+	0x79, 0x86, 0x1c, 0x4e, 0x2f, 0x9a, 0xdd, 0xe5, 0x51, 0x8e, 0xf0, 0x03, 0x90, 0x71, 0xb0, 0xef,
+	0x1b, 0xb3, 0xff, 0x29, 0x80, 0x70, 0x83, 0x9f, 0x80, 0xac, 0xd8, 0x52, 0xd8, 0x89, 0x99, 0x91,
+	0x16, 0x39, 0xf2, 0xd6, 0x38, 0xc6, 0x81, 0x32, 0x19, 0xde, 0x5c, 0x12, 0xa7, 0xef, 0x6f, 0xfd,
+	0x25, 0x05, 0x56, 0x27, 0x9a, 0x42, 0x03, 0x2c, 0x60, 0xd7, 0x8d, 0x08, 0x63, 0x6a, 0x6a, 0xba,
+	0xe2, 0xff, 0x60, 0xfc, 0x67, 0xad, 0xd0, 0x91, 0xfb, 0xe7, 0x95, 0xe3, 0xff, 0x50, 0x99, 0x4c,
+	0x1c, 0x7f, 0xcf, 0xdf, 0x8a, 0xc0, 0xea, 0x44, 0x4b, 0x58, 0x02, 0x1a, 0x23, 0x3e, 0x71, 0x62,
+	0x1a, 0xa9, 0xf1, 0xf7, 0xe4, 0xff, 0x32, 0x00, 0xeb, 0x1f, 0x29, 0xb0, 0x3c, 0x66, 0x01, 0x1d,
+	0x90, 0x53, 0x07, 0x5e, 0x7c, 0xd1, 0x94, 0xd9, 0x51, 0xd8, 0x7d, 0xed, 0x55, 0xd8, 0x02, 0xf4,
+	0xff, 0x2e, 0x13, 0x13, 0xf4, 0xe5, 0xeb, 0xc4, 0x84, 0xf2, 0x1c, 0x1e, 0x00, 0xb2, 0x10, 0xc0,
+	0x3d, 0x0b, 0xe8, 0x80, 0x95, 0xe1, 0x53, 0xd5, 0xf6, 0x3d, 0x16, 0x1b, 0xb3, 0xe2, 0x40, 0x7e,
+	0xf7, 0x32, 0x31, 0x87, 0x07, 0xf6, 0xc0, 0x63, 0xf1, 0x75, 0x62, 0x96, 0x86, 0x50, 0x07, 0x3d,
+	0x2d, 0xb4, 0x8c, 0x47, 0x1d, 0xac, 0x6f, 0x74, 0x90, 0x3b, 0x68, 0x60, 0x2f, 0x3c, 0xa0, 0xe1,
+	0x99, 0x57, 0x87, 0x9f, 0x83, 0xa5, 0x06, 0x0d, 0x08, 0x8b, 0x09, 0x76, 0xed, 0x9a, 0x4f, 0x9d,
+	0x73, 0x75, 0xf5, 0xbc, 0xfb, 0xf7, 0xc4, 0x5c, 0x95, 0x01, 0x32, 0xf7, 0x7c, 0xcb, 0xa3, 0xdb,
+	0x01, 0x8e, 0x1b, 0x5b, 0x47, 0x21, 0x27, 0x5d, 0x93, 0xa4, 0x23, 0x9e, 0x16, 0x2a, 0xf4, 0x34,
+	0x65, 0xae, 0x80, 0x0d, 0x50, 0x70, 0x31, 0xb5, 0xcf, 0x68, 0x74, 0xae, 0xc0, 0x67, 0x05, 0x78,
+	0xf9, 0x95, 0xe0, 0x97, 0x89, 0x99, 0x3f, 0xdc, 0x7f, 0xfc, 0x21, 0x8d, 0xce, 0x05, 0xc4, 0x75,
+	0x62, 0xae, 0x4a, 0xb2, 0x61, 0x20, 0x0b, 0xe5, 0x5d, 0x4c, 0x7b, 0x66, 0xf0, 0x09, 0xd0, 0x7b,
+	0x06, 0xac, 0xd5, 0x6c, 0xd2, 0x28, 0x36, 0xd2, 0x1b, 0xa9, 0x4d, 0xad, 0xfc, 0xd3, 0xcb, 0xc4,
+	0x2c, 0x28, 0xc8, 0xaa, 0xec, 0xb9, 0x4e, 0xcc, 0x5b, 0x23, 0xa0, 0xca, 0xc7, 0x42, 0x05, 0x05,
+	0xab, 0x4c, 0x61, 0x0d, 0xe4, 0x89, 0xd7, 0xdc, 0xd9, 0xbb, 0xab, 0x02, 0xc8, 0x88, 0x00, 0x7e,
+	0x35, 0x2d, 0x80, 0x5c, 0xe5, 0xe8, 0x64, 0x67, 0xef, 0x6e, 0x77, 0xfc, 0x2b, 0x92, 0x6a, 0x10,
+	0xc5, 0x42, 0x39, 0x29, 0xca, 0xc1, 0x77, 0x39, 0xf6, 0x14, 0xc7, 0xfc, 0x4d, 0x39, 0xf6, 0x26,
+	0x71, 0xec, 0x0d, 0x73, 0xec, 0x0d, 0x73, 0xdc, 0x53, 0x1c, 0x0b, 0x37, 0xe5, 0xb8, 0x37, 0x89,
+	0xe3, 0xde, 0x30, 0x87, 0xb4, 0xe1, 0xc9, 0x54, 0xbb, 0x78, 0x86, 0xc3, 0xd8, 0x6b, 0x05, 0x8a,
+	0x46, 0xbb, 0x71, 0x32, 0x8d, 0x78, 0x5a, 0xa8, 0xd0, 0xd3, 0x48, 0xf4, 0x73, 0x50, 0xec, 0x9e,
+	0xfe, 0x5e, 0x48, 0x9b, 0x3e, 0x51, 0x14, 0x59, 0x41, 0x71, 0x6f, 0x1a, 0xc5, 0x1d, 0x49, 0x31,
+	0xc9, 0xdd, 0x42, 0x2b, 0xc3, 0x6a, 0x49, 0x66, 0x03, 0xbd, 0x49, 0x62, 0x12, 0xb1, 0x5a, 0x2b,
+	0xaa, 0x2b, 0x22, 0x20, 0x88, 0xde, 0x9b, 0x46, 0xa4, 0xd2, 0x6a, 0xd4, 0xd5, 0x42, 0x4b, 0x7d,
+	0x95, 0x24, 0xf8, 0x0c, 0x14, 0x3c, 0xce, 0x5a, 0x6b, 0xf9, 0x0a, 0x3e, 0x27, 0xe0, 0x77, 0xa7,
+	0xc1, 0xab, 0xad, 0x30, 0xec, 0x68, 0xa1, 0xc5, 0xae, 0x42, 0x42, 0xbb, 0x00, 0x06, 0x2d, 0x2f,
+	0xb2, 0xeb, 0x3e, 0x76, 0x3c, 0x12, 0x29, 0xf8, 0xbc, 0x80, 0xff, 0xd9, 0x34, 0xf8, 0xdb, 0x12,
+	0x7e, 0xdc, 0xd9, 0x42, 0x3a, 0x57, 0x7e, 0x24, 0x75, 0x92, 0xa5, 0x0a, 0xf2, 0x35, 0x12, 0xf9,
+	0x5e, 0xa8, 0xf0, 0x17, 0x05, 0xfe, 0xdd, 0x69, 0xf8, 0x2a, 0x83, 0x06, 0xdd, 0x2c, 0x94, 0x93,
+	0x62, 0x0f, 0xd4, 0xa7, 0xa1, 0x4b, 0xbb, 0xa0, 0xcb, 0x37, 0x06, 0x1d, 0x74, 0xb3, 0x50, 0x4e,
+	0x8a, 0x12, 0xb4, 0x0e, 0x56, 0x70, 0x14, 0xd1, 0xa7, 0x23, 0x13, 0x02, 0x05, 0xf6, 0xcf, 0xa7,
+	0x61, 0x77, 0x0f, 0xd7, 0x71, 0x6f, 0x7e, 0xb8, 0x72, 0xed, 0xd0, 0x94, 0xb8, 0x00, 0xd6, 0x23,
+	0x7c, 0x31, 0xc2, 0x53, 0xbc, 0xf1, 0xc4, 0x8f, 0x3b, 0x5b, 0x48, 0xe7, 0xca, 0x21, 0x96, 0x2f,
+	0x40, 0x31, 0x20, 0x51, 0x9d, 0xd8, 0x21, 0x89, 0x59, 0xd3, 0xf7, 0x62, 0xc5, 0xb3, 0x7a, 0xe3,
+	0x7d, 0x30, 0xc9, 0xdd, 0x42, 0x50, 0xa8, 0x1f, 0x29, 0xad, 0xe4, 0xba, 0x0d, 0x34, 0x87, 0xdf,
+	0x16, 0xb6, 0xe7, 0x1a, 0x86, 0xa8, 0xc0, 0x16, 0x84, 0x7c, 0xe4, 0xc2, 0x22, 0x98, 0x93, 0xc5,
+	0xd5, 0x6d, 0x71, 0x03, 0x4b, 0x81, 0x5f, 0xcd, 0x2e, 0x71, 0xbc, 0x00, 0xfb, 0xcc, 0x28, 0x09,
+	0x87, 0x9e, 0x0c, 0x3f, 0x05, 0x8b, 0xac, 0x81, 0xc3, 0x7a, 0x03, 0x7b, 0x76, 0xec, 0x05, 0xc4,
+	0xb8, 0x23, 0x46, 0xbc, 0x33, 0x6d, 0xc4, 0x45, 0x39, 0xe2, 0x21, 0x3f, 0x0b, 0xe5, 0xbb, 0xf2,
+	0xa9, 0x17, 0x10, 0x78, 0x02, 0x72, 0x0e, 0x0e, 0x9d, 0x56, 0x28, 0x51, 0x5f, 0x13, 0xa8, 0xdb,
+	0xd3, 0x50, 0xd5, 0x55, 0x3c, 0xe0, 0x65, 0x21, 0x20, 0xa5, 0x2e, 0x62, 0x33, 0xc2, 0xf5, 0x16,
+	0x91, 0x88, 0xaf, 0xdf, 0x18, 0x71, 0xc0, 0xcb, 0x42, 0x40, 0x4a, 0x5d, 0xc4, 0x36, 0x89, 0xce,
+	0x7d, 0x85, 0xb8, 0x7e, 0x63, 0xc4, 0x01, 0x2f, 0x0b, 0x01, 0x29, 0x09, 0xc4, 0x87, 0x00, 0x50,
+	0x86, 0xcf, 0xb1, 0x04, 0x34, 0x05, 0xe0, 0xd6, 0x34, 0x40, 0xf5, 0x0e, 0xec, 0x3b, 0x59, 0x28,
+	0x2b, 0x04, 0x0e, 0xd7, 0x2b, 0xd4, 0xd7, 0xf4, 0x5b, 0xc7, 0x19, 0xed, 0x96, 0x6e, 0x58, 0x2d,
+	0xa0, 0x7d, 0x8c, 0x23, 0x97, 0x5f, 0x86, 0x10, 0x82, 0x4c, 0x88, 0x83, 0x6e, 0x8d, 0x2e, 0xda,
+	0x70, 0x1d, 0x00, 0xf1, 0x0a, 0xc3, 0xbc, 0x40, 0x53, 0xf5, 0xf9, 0x80, 0x06, 0xbe, 0x0e, 0x00,
+	0x67, 0xb1, 0x6b, 0x98, 0x11, 0x57, 0x5e, 0xc6, 0x28, 0xcb, 0x35, 0x65, 0xae, 0xe0, 0x85, 0xbf,
+	0x30, 0x26, 0xe2, 0x4a, 0xd5, 0x90, 0x92, 0xac, 0x6d, 0x30, 0xc7, 0x9f, 0x75, 0x04, 0xea, 0x20,
+	0x7d, 0x4e, 0x2e, 0x14, 0x25, 0x6f, 0xf2, 0x94, 0x6b, 0x63, 0xbf, 0x45, 0x64, 0x15, 0x81, 0xa4,
+	0x60, 0x9d, 0x80, 0xa5, 0xd3, 0x08, 0x87, 0x0c, 0x8b, 0x4a, 0xf1, 0x01, 0xad, 0x33, 0x3e, 0xdc,
+	0x06, 0x66, 0x8d, 0xee, 0x70, 0x79, 0x1b, 0xfe, 0x18, 0x64, 0x7c, 0x5a, 0x67, 0xa2, 0x9e, 0xca,
+	0xed, 0xae, 0x8e, 0x17, 0x6f, 0x0f, 0x68, 0x1d, 0x09, 0x13, 0xeb, 0x8f, 0x69, 0x90, 0x7e, 0x40,
+	0xeb, 0x53, 0xca, 0xe4, 0x35, 0x30, 0x1f, 0xd3, 0xa6, 0xe7, 0x48, 0xb8, 0x2c, 0x52, 0x12, 0x27,
+	0x76, 0x71, 0x8c, 0x45, 0xb4, 0x79, 0x24, 0xda, 0xfc, 0x85, 0x2d, 0x76, 0x98, 0x1d, 0xb6, 0x82,
+	0x1a, 0x89, 0x44, 0xb8, 0x99, 0xf2, 0xd2, 0x55, 0x62, 0xe6, 0x84, 0xfe, 0x91, 0x50, 0xa3, 0x41,
+	0x01, 0xbe, 0x03, 0x16, 0xe2, 0x8e, 0x2d, 0x62, 0x98, 0x13, 0x2b, 0xbb, 0x72, 0x95, 0x98, 0x4b,
+	0x71, 0x3f, 0xcc, 0x8f, 0x31, 0x6b, 0xa0, 0xf9, 0xb8, 0xc3, 0xff, 0xc3, 0x6d, 0xa0, 0xc5, 0xfc,
+	0x89, 0xe7, 0x92, 0x8e, 0xa8, 0x1d, 0x32, 0xe5, 0xe2, 0x55, 0x62, 0xea, 0x03, 0xe6, 0x47, 0xbc,
+	0x0f, 0x2d, 0xc4, 0x1d, 0xd1, 0x80, 0xef, 0x00, 0x20, 0x87, 0x24, 0x18, 0x64, 0x29, 0xb0, 0x78,
+	0x95, 0x98, 0x59, 0xa1, 0x15, 0xd8, 0xfd, 0x26, 0xb4, 0xc0, 0x9c, 0xc4, 0xd6, 0x04, 0x76, 0xfe,
+	0x2a, 0x31, 0x35, 0x9f, 0xd6, 0x25, 0xa6, 0xec, 0xe2, 0x53, 0x15, 0x91, 0x80, 0xb6, 0x89, 0x2b,
+	0xee, 0x63, 0x0d, 0x75, 0x45, 0xf8, 0x3e, 0x58, 0x92, 0x5c, 0x7c, 0xe9, 0x59, 0x8c, 0x83, 0xa6,
+	0x7c, 0x8c, 0x97, 0xe1, 0x55, 0x62, 0x16, 0x44, 0xd7, 0x69, 0xb7, 0x07, 0x8d, 0xc8, 0xd6, 0x57,
+	0xb3, 0x40, 0x3b, 0xed, 0x20, 0xc2, 0x5a, 0x7e, 0x0c, 0x3f, 0x04, 0x7a, 0xf7, 0x71, 0x63, 0x0f,
+	0xad, 0x4b, 0xf9, 0x4e, 0xff, 0xea, 0x1d, 0xb5, 0xb0, 0xd0, 0x52, 0x57, 0xb5, 0xaf, 0x16, 0xaf,
+	0x08, 0xe6, 0x6a, 0x3e, 0xa5, 0x81, 0x48, 0xa3, 0x3c, 0x92, 0x02, 0x7c, 0x22, 0xa6, 0x5c, 0xa4,
+	0x48, 0x5a, 0xbc, 0x1d, 0xde, 0x18, 0x4f, 0x91, 0x91, 0x3c, 0x2b, 0xdf, 0xe1, 0x2f, 0x87, 0xeb,
+	0xc4, 0x2c, 0x48, 0x6e, 0xe5, 0x6f, 0x7d, 0xf3, 0xc3, 0xb7, 0x6f, 0xa7, 0xf8, 0xea, 0x88, 0x64,
+	0xd4, 0x41, 0x3a, 0x22, 0xb1, 0x58, 0xf6, 0x3c, 0xe2, 0x4d, 0x7e, 0x48, 0x46, 0xa4, 0x4d, 0xa2,
+	0x98, 0xb8, 0x62, 0x79, 0x35, 0xd4, 0x93, 0xf9, 0x89, 0xcb, 0xbf, 0x14, 0xb4, 0xf8, 0x9e, 0x99,
+	0x97, 0x27, 0x6e, 0x1d, 0xb3, 0xdf, 0x30, 0xe2, 0xde, 0xcf, 0x7c, 0xf9, 0xb5, 0x39, 0x63, 0x61,
+	0x90, 0x53, 0xcf, 0x8a, 0x56, 0xd3, 0x27, 0x53, 0x72, 0x74, 0x17, 0xe4, 0x59, 0x4c, 0x23, 0x5c,
+	0x27, 0xf6, 0x39, 0xb9, 0x50, 0x99, 0x2a, 0xf3, 0x4e, 0xe9, 0x3f, 0x21, 0x17, 0x0c, 0x0d, 0x0a,
+	0x8a, 0xe2, 0xeb, 0x0c, 0xc8, 0x9d, 0x46, 0xd8, 0x21, 0xea, 0x91, 0xc0, 0xb3, 0x9d, 0x8b, 0xdd,
+	0xd7, 0x96, 0x92, 0x38, 0x37, 0x5f, 0x54, 0xda, 0x8a, 0xd5, 0x8e, 0xec, 0x8a, 0xdc, 0x23, 0x22,
+	0xa4, 0x43, 0x1c, 0x31, 0x97, 0x19, 0xa4, 0x24, 0xb8, 0x07, 0x16, 0x5d, 0x8f, 0xe1, 0x9a, 0x2f,
+	0xbe, 0xed, 0x38, 0xe7, 0x32, 0xfc, 0xb2, 0x7e, 0x95, 0x98, 0x79, 0xd5, 0x51, 0xe5, 0x7a, 0x34,
+	0x24, 0xf1, 0x1c, 0xea, 0xbb, 0x89, 0xd1, 0x8a, 0xb9, 0xd1, 0x64, 0x0e, 0xf5, 0x4c, 0x45, 0x0f,
+	0x1a, 0x91, 0xe5, 0x45, 0x55, 0x6b, 0xd5, 0x45, 0xfa, 0x6a, 0x48, 0x0a, 0x5c, 0xeb, 0x7b, 0x81,
+	0x17, 0x8b, 0x74, 0x9d, 0x43, 0x52, 0x80, 0xef, 0x83, 0x6c, 0xff, 0x1b, 0x0d, 0x10, 0x69, 0xf0,
+	0xfa, 0x84, 0xf7, 0x77, 0xff, 0x01, 0x85, 0xfa, 0xf6, 0x3c, 0x38, 0x12, 0x8a, 0x41, 0x06, 0x24,
+	0xa0, 0xd1, 0x85, 0x91, 0xeb, 0x07, 0x27, 0x3b, 0x1e, 0x0a, 0x3d, 0x1a, 0x92, 0x60, 0x19, 0x40,
+	0xe5, 0x16, 0x91, 0xb8, 0x15, 0x85, 0xb6, 0x38, 0x41, 0xf2, 0xc2, 0x57, 0xec, 0x63, 0xd9, 0x8b,
+	0x44, 0xe7, 0x21, 0x8e, 0x31, 0x1a, 0xd3, 0xc0, 0x5f, 0x02, 0x28, 0xd7, 0xc4, 0xfe, 0x82, 0xd1,
+	0x90, 0x3f, 0x03, 0xcf, 0xbc, 0xba, 0x2a, 0xc9, 0x04, 0xbf, 0xec, 0x55, 0x63, 0xd6, 0xa5, 0x74,
+	0xcc, 0xa8, 0x8a, 0xe2, 0x38, 0xa3, 0x65, 0xf4, 0xb9, 0xe3, 0x8c, 0xb6, 0xa0, 0x6b, 0xbd, 0xf9,
+	0x53, 0x51, 0xa0, 0x95, 0xae, 0x3c, 0x30, 0x3c, 0xeb, 0x11, 0x00, 0x27, 0x11, 0xf1, 0x78, 0xe1,
+	0xec, 0xfb, 0x13, 0xaf, 0x87, 0x81, 0xc4, 0x9c, 0x1d, 0x4e, 0x4c, 0x08, 0x32, 0xe2, 0x83, 0x4f,
+	0x5a, 0x5a, 0xf3, 0xb6, 0xf5, 0xa7, 0x14, 0xc8, 0x55, 0xda, 0xc1, 0x01, 0xf5, 0xc2, 0xa3, 0xf0,
+	0x8c, 0xf6, 0xab, 0x8b, 0xd4, 0x60, 0x75, 0x31, 0xfe, 0x65, 0x67, 0x76, 0xc2, 0x97, 0x1d, 0xf8,
+	0xa6, 0xc8, 0xb2, 0xa6, 0x8f, 0x2f, 0x94, 0x95, 0x64, 0xca, 0x2b, 0xe5, 0xe1, 0x58, 0xa5, 0xc2,
+	0xf7, 0xe6, 0x62, 0xbf, 0x52, 0x79, 0xfb, 0xaf, 0x29, 0x30, 0xf0, 0x76, 0x87, 0xbf, 0x00, 0xa5,
+	0xfd, 0x83, 0x83, 0x4a, 0xb5, 0x6a, 0x9f, 0x7e, 0x76, 0x52, 0xb1, 0x4f, 0x2a, 0xe8, 0xe1, 0x51,
+	0xb5, 0x7a, 0xf4, 0xf8, 0xd1, 0x83, 0x4a, 0xb5, 0xaa, 0xcf, 0x94, 0x5e, 0x7b, 0xfe, 0x62, 0xc3,
+	0xe8, 0xdb, 0x9f, 0x90, 0x28, 0xf0, 0x18, 0xf3, 0x68, 0xe8, 0xf3, 0x70, 0xdf, 0x03, 0x6b, 0x83,
+	0xde, 0xa8, 0x52, 0x3d, 0x45, 0x47, 0x07, 0xa7, 0x95, 0x43, 0x3d, 0x55, 0x32, 0x9e, 0xbf, 0xd8,
+	0x28, 0xf6, 0x3d, 0x11, 0x61, 0x71, 0xe4, 0x39, 0xfc, 0x1c, 0xb8, 0x07, 0x8c, 0xc9, 0x9c, 0x95,
+	0x43, 0x7d, 0xb6, 0x54, 0x7a, 0xfe, 0x62, 0x63, 0x6d, 0x12, 0x23, 0x71, 0x4b, 0x99, 0x2f, 0xff,
+	0xbc, 0x3e, 0x53, 0xbe, 0xff, 0xdd, 0xe5, 0x7a, 0xea, 0xfb, 0xcb, 0xf5, 0xd4, 0x3f, 0x2f, 0xd7,
+	0x53, 0x5f, 0xbd, 0x5c, 0x9f, 0xf9, 0xfe, 0xe5, 0xfa, 0xcc, 0xdf, 0x5e, 0xae, 0xcf, 0xfc, 0x76,
+	0xa3, 0xee, 0xc5, 0x8d, 0x56, 0x6d, 0xcb, 0xa1, 0xc1, 0xf6, 0xe8, 0x17, 0x3c, 0xfe, 0x55, 0x82,
+	0xd5, 0xe6, 0xc5, 0xb7, 0xe9, 0x77, 0xff, 0x3d, 0x00, 0xb8, 0x57, 0xb9, 0x92, 0xf4, 0x16, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1226,6 +1556,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundQuotient != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.RefundQuotient))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxInitCodeSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.OpcodeGasOverrides) > 0 {
+		for iNdEx := len(m.OpcodeGasOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpcodeGasOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ExtendedDenomOptions != nil {
 		{
 			size, err := m.ExtendedDenomOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1300,6 +1659,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OpcodeGasOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpcodeGasOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpcodeGasOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConstantGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ConstantGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Opcode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtendedDenomOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1350,6 +1744,20 @@ func (m *AccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1373,7 +1781,7 @@ func (m *AccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccessControlType) Marshal() (dAtA []byte, err error) {
+func (m *ContractAccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1383,24 +1791,118 @@ func (m *AccessControlType) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccessControlType) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractAccessControl) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractAccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AccessControlList) > 0 {
-		for iNdEx := len(m.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AccessControlList[iNdEx])
-			copy(dAtA[i:], m.AccessControlList[iNdEx])
-			i = encodeVarintEvm(dAtA, i, uint64(len(m.AccessControlList[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Functions) > 0 {
+		for iNdEx := len(m.Functions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Functions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunctionAccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionAccessControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunctionAccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessControlType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessControlType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessControlList) > 0 {
+		for iNdEx := len(m.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccessControlList[iNdEx])
+			copy(dAtA[i:], m.AccessControlList[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AccessControlList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if m.AccessType != 0 {
 		i = encodeVarintEvm(dAtA, i, uint64(m.AccessType))
@@ -1724,6 +2226,61 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HardFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HardFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HardFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeBased {
+		i--
+		if m.TimeBased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Activation != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Activation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *State) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2261,6 +2818,37 @@ func (m *Params) Size() (n int) {
 		l = m.ExtendedDenomOptions.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if len(m.OpcodeGasOverrides) > 0 {
+		for _, e := range m.OpcodeGasOverrides {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovEvm(uint64(m.MaxInitCodeSize))
+	}
+	if m.RefundQuotient != 0 {
+		n += 1 + sovEvm(uint64(m.RefundQuotient))
+	}
+	return n
+}
+
+func (m *OpcodeGasOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovEvm(uint64(m.ConstantGas))
+	}
 	return n
 }
 
@@ -2287,6 +2875,48 @@ func (m *AccessControl) Size() (n int) {
 	n += 1 + l + sovEvm(uint64(l))
 	l = m.Call.Size()
 	n += 1 + l + sovEvm(uint64(l))
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractAccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.Functions) > 0 {
+		for _, e := range m.Functions {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FunctionAccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -2410,6 +3040,28 @@ func (m *ChainConfig) Size() (n int) {
 	return n
 }
 
+func (m *HardFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Activation != 0 {
+		n += 1 + sovEvm(uint64(m.Activation))
+	}
+	if m.TimeBased {
+		n += 2
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
 func (m *State) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2862,20 +3514,432 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryServeWindow", wireType)
+			}
+			m.HistoryServeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryServeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenomOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedDenomOptions == nil {
+				m.ExtendedDenomOptions = &ExtendedDenomOptions{}
+			}
+			if err := m.ExtendedDenomOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpcodeGasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpcodeGasOverrides = append(m.OpcodeGasOverrides, OpcodeGasOverride{})
+			if err := m.OpcodeGasOverrides[len(m.OpcodeGasOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
+			}
+			m.RefundQuotient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundQuotient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGasOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGasOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGasOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedDenomOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedDenomOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryServeWindow", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 			}
-			m.HistoryServeWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2885,14 +3949,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoryServeWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenomOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2919,10 +3997,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtendedDenomOptions == nil {
-				m.ExtendedDenomOptions = &ExtendedDenomOptions{}
-			}
-			if err := m.ExtendedDenomOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contracts = append(m.Contracts, ContractAccessControl{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2947,7 +4023,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
+func (m *ContractAccessControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2970,15 +4046,15 @@ func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtendedDenomOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAccessControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtendedDenomOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3006,7 +4082,74 @@ func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Functions = append(m.Functions, FunctionAccessControl{})
+			if err := m.Functions[len(m.Functions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3029,7 +4172,7 @@ func (m *ExtendedDenomOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessControl) Unmarshal(dAtA []byte) error {
+func (m *FunctionAccessControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3052,17 +4195,17 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunctionAccessControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunctionAccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -3072,24 +4215,23 @@ func (m *AccessControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4106,6 +5248,147 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HardFork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardFork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardFork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activation", wireType)
+			}
+			m.Activation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Activation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeBased", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimeBased = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *State) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -66,5 +66,11 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	if gs.ChainConfig != nil {
+		if err := gs.ChainConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid chain config: %w", err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
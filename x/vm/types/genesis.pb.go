@@ -32,6 +32,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// chain_config defines the EVM chain config updated by governance. The chain
+	// config of the application is used if not set.
+	ChainConfig *ChainConfig `protobuf:"bytes,4,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +91,13 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetChainConfig() *ChainConfig {
+	if m != nil {
+		return m.ChainConfig
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -162,29 +172,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0xed, 0x00, 0x81, 0xc7, 0x94, 0xbc, 0xbc, 0x37, 0x21, 0xb1, 0x21, 0x5a, 0x1a, 0x56, 0xc4,
+	0x45, 0x1b, 0x70, 0xa7, 0x1b, 0x85, 0x05, 0x71, 0x67, 0xca, 0xce, 0x8d, 0x19, 0x86, 0xb1, 0x34,
+	0xa1, 0x9d, 0xa6, 0x33, 0x10, 0xfd, 0x02, 0xb7, 0xfe, 0x82, 0x3b, 0xe3, 0xca, 0xcf, 0x60, 0xc9,
+	0xd2, 0x95, 0x1a, 0x58, 0xf8, 0x1b, 0x66, 0x66, 0x00, 0xab, 0x35, 0x99, 0x34, 0x77, 0x7a, 0xcf,
+	0x39, 0xf7, 0x9e, 0x3b, 0x17, 0xda, 0x84, 0xf1, 0x88, 0x71, 0x8f, 0xce, 0x23, 0x4f, 0x9e, 0x8e,
+	0x17, 0xd0, 0x98, 0xf2, 0x90, 0xbb, 0x49, 0xca, 0x04, 0x43, 0xff, 0x74, 0xde, 0xa5, 0xf3, 0xc8,
+	0x95, 0xa7, 0xd3, 0xf8, 0x8f, 0xa3, 0x30, 0x66, 0x9e, 0xfa, 0x6a, 0x50, 0xa3, 0x91, 0x13, 0x91,
+	0x70, 0x9d, 0xab, 0x07, 0x2c, 0x60, 0x2a, 0xf4, 0x64, 0xa4, 0xff, 0xb6, 0x1e, 0x0a, 0xb0, 0x36,
+	0xd0, 0x85, 0x86, 0x02, 0x0b, 0x8a, 0x06, 0xf0, 0x0f, 0x26, 0x84, 0xcd, 0x62, 0xc1, 0x2d, 0xe0,
+	0x14, 0xdb, 0x66, 0xd7, 0x71, 0x7f, 0x96, 0x76, 0x37, 0x8c, 0x33, 0x0d, 0xec, 0x55, 0x17, 0xaf,
+	0x4d, 0xe3, 0xf1, 0xe3, 0xf9, 0x10, 0xf8, 0x3b, 0x32, 0x3a, 0x81, 0xe5, 0x04, 0xa7, 0x38, 0xe2,
+	0x56, 0xc1, 0x01, 0x6d, 0xb3, 0x6b, 0xe5, 0x65, 0x2e, 0x54, 0x3e, 0x4b, 0xdf, 0x50, 0xd0, 0x39,
+	0x34, 0x93, 0x94, 0x86, 0x31, 0x17, 0x78, 0x3a, 0xe5, 0x56, 0x51, 0x35, 0xb2, 0xff, 0x8b, 0xc2,
+	0x0e, 0x94, 0x55, 0xc9, 0x72, 0xd1, 0x29, 0xac, 0x91, 0x09, 0x0e, 0xe3, 0x2b, 0xc2, 0xe2, 0xeb,
+	0x30, 0xb0, 0x4a, 0xaa, 0x9b, 0x83, 0xbc, 0x56, 0x5f, 0xa2, 0xfa, 0x0a, 0xe4, 0x9b, 0xe4, 0xeb,
+	0xd2, 0xba, 0x03, 0xf0, 0xef, 0x77, 0xc7, 0xc8, 0x82, 0x15, 0x3c, 0x1e, 0xa7, 0x94, 0xcb, 0x21,
+	0x81, 0x76, 0xd5, 0xdf, 0x5e, 0x11, 0x82, 0x25, 0xc2, 0xc6, 0x54, 0x99, 0xae, 0xfa, 0x2a, 0x46,
+	0x03, 0x58, 0xe1, 0x82, 0xa5, 0x38, 0xa0, 0x1b, 0x27, 0x7b, 0xf9, 0xea, 0x6a, 0xfa, 0xbd, 0xba,
+	0x34, 0xf1, 0xf4, 0xd6, 0xac, 0x0c, 0x35, 0x5e, 0xfb, 0xd9, 0xb2, 0x7b, 0xc7, 0x8b, 0x95, 0x0d,
+	0x96, 0x2b, 0x1b, 0xbc, 0xaf, 0x6c, 0x70, 0xbf, 0xb6, 0x8d, 0xe5, 0xda, 0x36, 0x5e, 0xd6, 0xb6,
+	0x71, 0xe9, 0x04, 0xa1, 0x98, 0xcc, 0x46, 0x2e, 0x61, 0x91, 0x97, 0x59, 0x82, 0x1b, 0xb9, 0x06,
+	0xe2, 0x36, 0xa1, 0x7c, 0x54, 0x56, 0x0f, 0x7e, 0xf4, 0x39, 0x00, 0x50, 0x29, 0xfb, 0x2a, 0x69,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +219,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainConfig != nil {
+		{
+			size, err := m.ChainConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +354,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ChainConfig != nil {
+		l = m.ChainConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -492,6 +520,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainConfig == nil {
+				m.ChainConfig = &ChainConfig{}
+			}
+			if err := m.ChainConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
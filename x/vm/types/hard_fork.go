@@ -0,0 +1,143 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// hardForkField is a hard fork of the chain config.
+type hardForkField struct {
+	name      string
+	timeBased bool
+	field     func(cc *ChainConfig) **sdkmath.Int
+}
+
+// hardForkFields are the hard forks of the chain config that can be scheduled,
+// in activation order. Verkle isn't supported by the state, so it can't be.
+var hardForkFields = []hardForkField{
+	{name: "homestead", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.HomesteadBlock }},
+	{name: "daoFork", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.DAOForkBlock }},
+	{name: "eip150", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP150Block }},
+	{name: "eip155", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP155Block }},
+	{name: "eip158", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.EIP158Block }},
+	{name: "byzantium", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.ByzantiumBlock }},
+	{name: "constantinople", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.ConstantinopleBlock }},
+	{name: "petersburg", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.PetersburgBlock }},
+	{name: "istanbul", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.IstanbulBlock }},
+	{name: "muirGlacier", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.MuirGlacierBlock }},
+	{name: "berlin", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.BerlinBlock }},
+	{name: "london", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.LondonBlock }},
+	{name: "arrowGlacier", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.ArrowGlacierBlock }},
+	{name: "grayGlacier", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.GrayGlacierBlock }},
+	{name: "mergeNetsplit", field: func(cc *ChainConfig) **sdkmath.Int { return &cc.MergeNetsplitBlock }},
+	{name: "shanghai", timeBased: true, field: func(cc *ChainConfig) **sdkmath.Int { return &cc.ShanghaiTime }},
+	{name: "cancun", timeBased: true, field: func(cc *ChainConfig) **sdkmath.Int { return &cc.CancunTime }},
+	{name: "prague", timeBased: true, field: func(cc *ChainConfig) **sdkmath.Int { return &cc.PragueTime }},
+	{name: "osaka", timeBased: true, field: func(cc *ChainConfig) **sdkmath.Int { return &cc.OsakaTime }},
+}
+
+// findHardFork returns the hard fork of the given name.
+func findHardFork(name string) (hardForkField, bool) {
+	for _, hf := range hardForkFields {
+		if hf.name == name {
+			return hf, true
+		}
+	}
+	return hardForkField{}, false
+}
+
+// isActiveAt returns true if the activation is reached at the block height or
+// timestamp.
+func (hf hardForkField) isActiveAt(activation *sdkmath.Int, height int64, blockTime uint64) bool {
+	if activation == nil || activation.IsNegative() {
+		return false
+	}
+	if hf.timeBased {
+		return activation.LTE(sdkmath.NewIntFromUint64(blockTime))
+	}
+	return activation.LTE(sdkmath.NewInt(height))
+}
+
+// ValidateHardForkName returns an error if the name isn't a hard fork of the
+// chain config.
+func ValidateHardForkName(name string) error {
+	if _, ok := findHardFork(name); !ok {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "unknown hard fork %q", name)
+	}
+	return nil
+}
+
+// HardForks returns the hard forks set in the chain config, in activation
+// order, and whether they are active at the block height and timestamp.
+func (cc ChainConfig) HardForks(height int64, blockTime uint64) []HardFork {
+	forks := make([]HardFork, 0, len(hardForkFields))
+	for _, hf := range hardForkFields {
+		activation := *hf.field(&cc)
+		if activation == nil || activation.IsNegative() {
+			continue
+		}
+		forks = append(forks, HardFork{
+			Name:       hf.name,
+			Activation: activation.Uint64(),
+			TimeBased:  hf.timeBased,
+			Active:     hf.isActiveAt(activation, height, blockTime),
+		})
+	}
+	return forks
+}
+
+// ScheduleHardFork returns a copy of the chain config with the activation of
+// the given hard fork set, which is a block height or, for the time based hard
+// forks, a block timestamp.
+func (cc ChainConfig) ScheduleHardFork(name string, activation uint64) (ChainConfig, error) {
+	hf, ok := findHardFork(name)
+	if !ok {
+		return ChainConfig{}, errorsmod.Wrapf(ErrInvalidChainConfig, "unknown hard fork %q", name)
+	}
+	value := sdkmath.NewIntFromUint64(activation)
+	*hf.field(&cc) = &value
+	return cc, nil
+}
+
+// ValidateChainConfigUpdate checks that the updated chain config only changes
+// the hard forks that are not active yet at the block height and timestamp,
+// that the changed hard forks are scheduled in the future and that the
+// resulting hard forks are ordered.
+func ValidateChainConfigUpdate(current, updated ChainConfig, height int64, blockTime uint64) error {
+	if updated.ChainId != current.ChainId {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "chain id cannot be changed: %d != %d", updated.ChainId, current.ChainId)
+	}
+	if updated.DAOForkSupport != current.DAOForkSupport {
+		return errorsmod.Wrap(ErrInvalidChainConfig, "dao fork support cannot be changed")
+	}
+	if !equalActivation(current.VerkleTime, updated.VerkleTime) {
+		return errorsmod.Wrap(ErrInvalidChainConfig, "verkle time cannot be changed")
+	}
+
+	for _, hf := range hardForkFields {
+		prev, next := *hf.field(&current), *hf.field(&updated)
+		if equalActivation(prev, next) {
+			continue
+		}
+		if hf.isActiveAt(prev, height, blockTime) {
+			return errorsmod.Wrapf(ErrInvalidChainConfig, "hard fork %s is already active", hf.name)
+		}
+		// the changed hard fork must be activated after the current block
+		if next != nil && !next.IsNegative() && hf.isActiveAt(next, height, blockTime) {
+			return errorsmod.Wrapf(ErrInvalidChainConfig, "hard fork %s must be scheduled in the future: %s", hf.name, next)
+		}
+	}
+
+	return updated.Validate()
+}
+
+// equalActivation returns true if both activations are equal, nil and the
+// negative values meaning that the hard fork isn't scheduled.
+func equalActivation(a, b *sdkmath.Int) bool {
+	aUnset := a == nil || a.IsNegative()
+	bUnset := b == nil || b.IsNegative()
+	if aUnset || bUnset {
+		return aUnset == bUnset
+	}
+	return a.Equal(*b)
+}
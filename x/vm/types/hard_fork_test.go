@@ -0,0 +1,99 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestHardForks(t *testing.T) {
+	cfg := *DefaultChainConfig(0)
+	cfg, err := cfg.ScheduleHardFork("osaka", 2000)
+	require.NoError(t, err)
+
+	forks := cfg.HardForks(10, 1000)
+	require.Len(t, forks, 19)
+	require.Equal(t, HardFork{Name: "homestead", Activation: 0, TimeBased: false, Active: true}, forks[0])
+	require.Equal(t, HardFork{Name: "prague", Activation: 0, TimeBased: true, Active: true}, forks[17])
+	require.Equal(t, HardFork{Name: "osaka", Activation: 2000, TimeBased: true, Active: false}, forks[18])
+
+	forks = cfg.HardForks(10, 2000)
+	require.True(t, forks[18].Active)
+
+	_, err = cfg.ScheduleHardFork("amsterdam", 2000)
+	require.ErrorIs(t, err, ErrInvalidChainConfig)
+	_, err = cfg.ScheduleHardFork("verkle", 3000)
+	require.ErrorIs(t, err, ErrInvalidChainConfig)
+}
+
+func TestValidateChainConfigUpdate(t *testing.T) {
+	const (
+		height    = int64(100)
+		blockTime = uint64(1000)
+	)
+	intPtr := func(i int64) *sdkmath.Int {
+		v := sdkmath.NewInt(i)
+		return &v
+	}
+	current := *DefaultChainConfig(0)
+	current.OsakaTime = intPtr(2000)
+
+	testCases := []struct {
+		name   string
+		update func(cc *ChainConfig)
+		expErr string
+	}{
+		{
+			name:   "pass - unchanged",
+			update: func(*ChainConfig) {},
+		},
+		{
+			name:   "pass - reschedule a future hard fork",
+			update: func(cc *ChainConfig) { cc.OsakaTime = intPtr(3000) },
+		},
+		{
+			name:   "pass - cancel a future hard fork",
+			update: func(cc *ChainConfig) { cc.OsakaTime = nil },
+		},
+		{
+			name:   "fail - schedule verkle",
+			update: func(cc *ChainConfig) { cc.VerkleTime = intPtr(3000) },
+			expErr: "verkle time cannot be changed",
+		},
+		{
+			name:   "fail - change the chain id",
+			update: func(cc *ChainConfig) { cc.ChainId++ },
+			expErr: "chain id cannot be changed",
+		},
+		{
+			name:   "fail - change an active block hard fork",
+			update: func(cc *ChainConfig) { cc.LondonBlock = intPtr(200) },
+			expErr: "hard fork london is already active",
+		},
+		{
+			name:   "fail - change an active time hard fork",
+			update: func(cc *ChainConfig) { cc.PragueTime = nil },
+			expErr: "hard fork prague is already active",
+		},
+		{
+			name:   "fail - schedule a hard fork in the past",
+			update: func(cc *ChainConfig) { cc.OsakaTime = intPtr(int64(blockTime)) },
+			expErr: "hard fork osaka must be scheduled in the future",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := current
+			tc.update(&updated)
+			err := ValidateChainConfigUpdate(current, updated, height, blockTime)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
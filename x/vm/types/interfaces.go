@@ -28,6 +28,7 @@ type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
 	SetAccount(ctx context.Context, account sdk.AccountI)
 	RemoveAccount(ctx context.Context, account sdk.AccountI)
 	GetParams(ctx context.Context) (params authtypes.Params)
@@ -81,6 +82,36 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, sender common.Address, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// PreTxHooks are optional evm hooks called before each message is executed. They are called once
+// per top-level message, for the transactions, the messages applied by the modules and the
+// simulated calls, so that the simulations match the execution, but not for the calls made during
+// its execution.
+type PreTxHooks interface {
+	// PreTxProcessing is called before the message is executed, which the hooks can observe but
+	// not modify. If it returns an error, wrapping ErrRejectedByHook, the message fails without
+	// being executed, using its intrinsic gas.
+	PreTxProcessing(ctx sdk.Context, msg core.Message) error
+}
+
+// PostCallHooks are optional evm hooks called after each message is executed. They are called once
+// per top-level message, for the transactions, the messages applied by the modules and the
+// simulated calls, so that the simulations match the execution, but not for the calls made during
+// its execution.
+type PostCallHooks interface {
+	// PostCallProcessing is called with the result of the executed message, failed or not, on top
+	// of its state changes, including for the simulated calls. If it returns an error, wrapping
+	// ErrRejectedByHook, the message fails and its state changes are discarded with the ones of
+	// the hooks.
+	PostCallProcessing(ctx sdk.Context, msg core.Message, res *MsgEthereumTxResponse) error
+}
+
+// PrioritizedEvmHooks are evm hooks with a priority, used to order them within MultiEvmHooks.
+type PrioritizedEvmHooks interface {
+	// Priority returns the priority of the hooks, the ones with the lowest priority run first.
+	// The hooks without priority have a priority of 0.
+	Priority() int64
+}
+
 // BankWrapper defines the methods required by the wrapper around
 // the Cosmos SDK x/bank keeper that is used to manage an EVM coin
 // with a configurable value for decimals.
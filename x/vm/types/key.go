@@ -30,12 +30,14 @@ const (
 	prefixParams
 	prefixCodeHash
 	prefixEvmCoinInfo
+	prefixChainConfig
 )
 
 // prefix bytes for the EVM object store
 const (
 	prefixObjectBloom = iota + 1
 	prefixObjectGasUsed
+	prefixObjectChainConfig
 )
 
 // KVStore key prefixes
@@ -45,12 +47,14 @@ var (
 	KeyPrefixParams      = []byte{prefixParams}
 	KeyPrefixCodeHash    = []byte{prefixCodeHash}
 	KeyPrefixEvmCoinInfo = []byte{prefixEvmCoinInfo}
+	KeyPrefixChainConfig = []byte{prefixChainConfig}
 )
 
 // Object Store key prefixes
 var (
-	KeyPrefixObjectBloom   = []byte{prefixObjectBloom}
-	KeyPrefixObjectGasUsed = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectBloom       = []byte{prefixObjectBloom}
+	KeyPrefixObjectGasUsed     = []byte{prefixObjectGasUsed}
+	KeyPrefixObjectChainConfig = []byte{prefixObjectChainConfig}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -144,6 +144,11 @@ func (_m *AccountKeeper) HasAccount(ctx context.Context, addr cosmos_sdktypes.Ac
 	return r0
 }
 
+// IterateAccounts provides a mock function with given fields: ctx, cb
+func (_m *AccountKeeper) IterateAccounts(ctx context.Context, cb func(cosmos_sdktypes.AccountI) bool) {
+	_m.Called(ctx, cb)
+}
+
 // NewAccountWithAddress provides a mock function with given fields: ctx, addr
 func (_m *AccountKeeper) NewAccountWithAddress(ctx context.Context, addr cosmos_sdktypes.AccAddress) cosmos_sdktypes.AccountI {
 	ret := _m.Called(ctx, addr)
@@ -34,6 +34,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgScheduleHardFork{}
+	_ sdk.Msg    = &MsgUpdateChainConfig{}
 )
 
 // message type and route constants
@@ -359,3 +361,31 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return AminoCdc.MustMarshalJSON(&m)
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgScheduleHardFork) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateHardForkName(m.Name)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgScheduleHardFork) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateChainConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Config.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateChainConfig) GetSignBytes() []byte {
+	return AminoCdc.MustMarshalJSON(&m)
+}
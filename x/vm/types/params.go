@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -105,9 +108,55 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateOpcodeGasOverrides(p.OpcodeGasOverrides); err != nil {
+		return err
+	}
+
+	if err := validateCodeSizeLimits(p.MaxCodeSize, p.MaxInitCodeSize); err != nil {
+		return err
+	}
+
+	if err := validateRefundQuotient(p.RefundQuotient); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
+// CodeSizeLimit returns the maximum size of the code deployed by a contract creation transaction,
+// which is at most the Ethereum limit. The creations of the contracts are bound by the Ethereum
+// limit only, which is enforced by geth.
+func (p Params) CodeSizeLimit() uint64 {
+	if p.MaxCodeSize == 0 {
+		return params.MaxCodeSize
+	}
+	return p.MaxCodeSize
+}
+
+// InitCodeSizeLimit returns the maximum size of the init code of a contract creation
+// transaction, which is at most the Ethereum limit. The creations of the contracts are bound by
+// the Ethereum limit only, which is enforced by geth.
+func (p Params) InitCodeSizeLimit() uint64 {
+	if p.MaxInitCodeSize == 0 {
+		return params.MaxInitCodeSize
+	}
+	return p.MaxInitCodeSize
+}
+
+// GasRefundQuotient returns the cap on the gas refund as the quotient of the gas used,
+// which defaults to the quotient of the hard fork.
+func (p Params) GasRefundQuotient(isLondon bool) uint64 {
+	switch {
+	case p.RefundQuotient != 0:
+		return p.RefundQuotient
+	case isLondon:
+		// After EIP-3529: refunds are capped to gasUsed / 5
+		return params.RefundQuotientEIP3529
+	default:
+		return params.RefundQuotient
+	}
+}
+
 // EIPs returns the ExtraEIPS as a int slice
 func (p Params) EIPs() []int {
 	eips := make([]int, len(p.ExtraEIPs))
@@ -127,7 +176,58 @@ func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
 	}
-	return ac.Call.Validate()
+	if err := ac.Call.Validate(); err != nil {
+		return err
+	}
+
+	contracts := make(map[common.Address]struct{}, len(ac.Contracts))
+	for _, contract := range ac.Contracts {
+		if err := contract.Validate(); err != nil {
+			return err
+		}
+		address := common.HexToAddress(contract.Address)
+		if _, found := contracts[address]; found {
+			return fmt.Errorf("duplicate contract access control: %s", contract.Address)
+		}
+		contracts[address] = struct{}{}
+	}
+	return nil
+}
+
+// GetContractAccessControl returns the permission policies for calling the given contract.
+func (ac AccessControl) GetContractAccessControl(address common.Address) (ContractAccessControl, bool) {
+	for _, contract := range ac.Contracts {
+		if common.HexToAddress(contract.Address) == address {
+			return contract, true
+		}
+	}
+	return ContractAccessControl{}, false
+}
+
+func (cac ContractAccessControl) Validate() error {
+	if err := utils.ValidateAddress(cac.Address); err != nil {
+		return fmt.Errorf("invalid contract address: %s", cac.Address)
+	}
+	if err := cac.Call.Validate(); err != nil {
+		return err
+	}
+
+	selectors := make(map[string]struct{}, len(cac.Functions))
+	for _, function := range cac.Functions {
+		selector, err := hexutil.Decode(function.Selector)
+		if err != nil || len(selector) != 4 {
+			return fmt.Errorf("invalid function selector of contract %s: %s", cac.Address, function.Selector)
+		}
+		key := hexutil.Encode(selector)
+		if _, found := selectors[key]; found {
+			return fmt.Errorf("duplicate function selector of contract %s: %s", cac.Address, function.Selector)
+		}
+		selectors[key] = struct{}{}
+		if err := function.Call.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (act AccessControlType) Validate() error {
@@ -155,12 +255,75 @@ func validateAllowlistAddresses(addresses []string) error {
 	return nil
 }
 
+// stateOpcodes are the opcodes accessing the state, which can't be free.
+var stateOpcodes = []vm.OpCode{
+	vm.BALANCE, vm.EXTCODESIZE, vm.EXTCODECOPY, vm.EXTCODEHASH, vm.SELFBALANCE,
+	vm.SLOAD, vm.SSTORE, vm.TLOAD, vm.TSTORE,
+	vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4,
+	vm.CREATE, vm.CREATE2, vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL, vm.SELFDESTRUCT,
+}
+
+func validateOpcodeGasOverrides(overrides []OpcodeGasOverride) error {
+	opcodes := make(map[vm.OpCode]struct{}, len(overrides))
+	for _, override := range overrides {
+		op := vm.StringToOp(override.Opcode)
+		if op.String() != override.Opcode {
+			return fmt.Errorf("invalid opcode gas override: unknown opcode %q", override.Opcode)
+		}
+		if _, found := opcodes[op]; found {
+			return fmt.Errorf("invalid opcode gas override: duplicate opcode %s", op)
+		}
+		opcodes[op] = struct{}{}
+		if override.ConstantGas == 0 && slices.Contains(stateOpcodes, op) {
+			return fmt.Errorf("invalid opcode gas override: the state opcode %s can't be free", op)
+		}
+		if override.ConstantGas > MaxOpcodeConstantGas {
+			return fmt.Errorf("invalid opcode gas override: constant gas %d of %s exceeds the maximum %d", override.ConstantGas, op, uint64(MaxOpcodeConstantGas))
+		}
+	}
+	return nil
+}
+
+// validateCodeSizeLimits checks that the code size limits don't exceed the Ethereum limits, which
+// are enforced by the EVM on all the contract creations.
+func validateCodeSizeLimits(maxCodeSize, maxInitCodeSize uint64) error {
+	if maxCodeSize > params.MaxCodeSize {
+		return fmt.Errorf("max code size %d exceeds the EVM limit %d", maxCodeSize, params.MaxCodeSize)
+	}
+	if maxInitCodeSize > params.MaxInitCodeSize {
+		return fmt.Errorf("max init code size %d exceeds the EVM limit %d", maxInitCodeSize, params.MaxInitCodeSize)
+	}
+	return nil
+}
+
+// validateRefundQuotient checks that the refund quotient, if set, does not raise the refunds above
+// the ones of EIP-3529.
+func validateRefundQuotient(quotient uint64) error {
+	if quotient != 0 && quotient < params.RefundQuotientEIP3529 {
+		return fmt.Errorf("refund quotient %d is below the EIP-3529 one %d", quotient, params.RefundQuotientEIP3529)
+	}
+	return nil
+}
+
+// activateableEIPs returns the EIPs which can be enabled by the params, leaving out the ones
+// reserved for the opcode gas overrides.
+func activateableEIPs() []string {
+	return slices.DeleteFunc(vm.ActivateableEips(), func(eip string) bool {
+		num, err := strconv.ParseInt(eip, 10, 64)
+		return err == nil && isOpcodeGasEIP(num)
+	})
+}
+
 func validateEIPs(eips []int64) error {
 	uniqueEIPs := make(map[int64]struct{})
 
 	for _, eip := range eips {
+		if isOpcodeGasEIP(eip) {
+			return fmt.Errorf("EIP %d is reserved for the opcode gas overrides", eip)
+		}
+
 		if !vm.ValidEip(int(eip)) {
-			return fmt.Errorf("EIP %d is not activateable, valid EIPs are: %s", eip, vm.ActivateableEips())
+			return fmt.Errorf("EIP %d is not activateable, valid EIPs are: %s", eip, activateableEIPs())
 		}
 
 		if _, ok := uniqueEIPs[eip]; ok {
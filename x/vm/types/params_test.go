@@ -49,6 +49,110 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid contract access control",
+			params: Params{
+				AccessControl: AccessControl{
+					Contracts: []ContractAccessControl{{
+						Address: "0x0000000000000000000000000000000000000801",
+						Functions: []FunctionAccessControl{
+							{Selector: "0x40c10f19", Call: AccessControlType{AccessType: AccessTypeRestricted}},
+						},
+					}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicate contract access control",
+			params: Params{
+				AccessControl: AccessControl{
+					Contracts: []ContractAccessControl{
+						{Address: "0x0000000000000000000000000000000000000801"},
+						{Address: "0x0000000000000000000000000000000000000801"},
+					},
+				},
+			},
+			errContains: "duplicate contract access control",
+		},
+		{
+			name: "invalid function selector",
+			params: Params{
+				AccessControl: AccessControl{
+					Contracts: []ContractAccessControl{{
+						Address:   "0x0000000000000000000000000000000000000801",
+						Functions: []FunctionAccessControl{{Selector: "0x40c10f"}},
+					}},
+				},
+			},
+			errContains: "invalid function selector",
+		},
+		{
+			name: "duplicate function selector",
+			params: Params{
+				AccessControl: AccessControl{
+					Contracts: []ContractAccessControl{{
+						Address:   "0x0000000000000000000000000000000000000801",
+						Functions: []FunctionAccessControl{{Selector: "0x40c10f19"}, {Selector: "0x40C10F19"}},
+					}},
+				},
+			},
+			errContains: "duplicate function selector",
+		},
+		{
+			name: "unknown opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{Opcode: "SSTOR", ConstantGas: 100}},
+			},
+			errContains: "unknown opcode \"SSTOR\"",
+		},
+		{
+			name: "duplicate opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{
+					{Opcode: "ADD", ConstantGas: 5},
+					{Opcode: "ADD", ConstantGas: 6},
+				},
+			},
+			errContains: "duplicate opcode ADD",
+		},
+		{
+			name: "free state opcode gas override",
+			params: Params{
+				OpcodeGasOverrides: []OpcodeGasOverride{{Opcode: "SSTORE", ConstantGas: 0}},
+			},
+			errContains: "the state opcode SSTORE can't be free",
+		},
+		{
+			name:    "valid code size limits",
+			params:  Params{MaxCodeSize: 16384, MaxInitCodeSize: 32768},
+			expPass: true,
+		},
+		{
+			name:        "max code size above the EVM limit",
+			params:      Params{MaxCodeSize: ethparams.MaxCodeSize + 1},
+			errContains: "max code size 24577 exceeds the EVM limit 24576",
+		},
+		{
+			name:        "max init code size above the EVM limit",
+			params:      Params{MaxInitCodeSize: ethparams.MaxInitCodeSize + 1},
+			errContains: "max init code size 49153 exceeds the EVM limit 49152",
+		},
+		{
+			name:    "refund quotient above the EIP-3529 one",
+			params:  Params{RefundQuotient: 10},
+			expPass: true,
+		},
+		{
+			name:        "refund quotient below the EIP-3529 one",
+			params:      Params{RefundQuotient: 2},
+			errContains: "refund quotient 2 is below the EIP-3529 one 5",
+		},
+		{
+			name:        "opcode gas override above the maximum",
+			params:      Params{OpcodeGasOverrides: []OpcodeGasOverride{{Opcode: "ADD", ConstantGas: MaxOpcodeConstantGas + 1}}},
+			errContains: "constant gas 4294967296 of ADD exceeds the maximum 4294967295",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,6 +190,7 @@ func TestParamsEIPs(t *testing.T) {
 func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateEIPs([]int64{1884}))
 	require.ErrorContains(t, validateEIPs([]int64{1884, 1884, 1885}), "duplicate EIP: 1884")
+	require.ErrorContains(t, validateEIPs([]int64{opcodeGasEIPBase}), "reserved for the opcode gas overrides")
 	require.NoError(t, validateChannels([]string{"channel-0"}))
 	require.Error(t, validateChannels([]string{"invalid"}))
 }
@@ -118,3 +223,19 @@ func TestIsLondon(t *testing.T) {
 		require.Equal(t, IsLondon(ethConfig, tc.height), tc.result)
 	}
 }
+
+func TestParamsLimits(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, uint64(ethparams.MaxCodeSize), params.CodeSizeLimit())
+	require.Equal(t, uint64(ethparams.MaxInitCodeSize), params.InitCodeSizeLimit())
+	require.Equal(t, ethparams.RefundQuotient, params.GasRefundQuotient(false))
+	require.Equal(t, ethparams.RefundQuotientEIP3529, params.GasRefundQuotient(true))
+
+	params.MaxCodeSize = 16384
+	params.MaxInitCodeSize = 32768
+	params.RefundQuotient = 10
+	require.Equal(t, uint64(16384), params.CodeSizeLimit())
+	require.Equal(t, uint64(32768), params.InitCodeSizeLimit())
+	require.Equal(t, uint64(10), params.GasRefundQuotient(false))
+	require.Equal(t, uint64(10), params.GasRefundQuotient(true))
+}
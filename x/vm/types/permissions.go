@@ -2,9 +2,12 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
 )
 
@@ -26,6 +29,15 @@ type PermissionPolicy interface {
 	GetCreateHook(signer common.Address) CreateHook
 }
 
+// CallInputPolicy is an optional extension of the PermissionPolicy for the policies checking the
+// input of the calls, which the EVM opcode hooks don't receive.
+type CallInputPolicy interface {
+	// TracingHooks returns the given tracing hooks of the EVM, which may be nil, extended to
+	// provide the input of every call to the CallHook. The EVM enters the call right before
+	// running the call hooks.
+	TracingHooks(hooks *tracing.Hooks) *tracing.Hooks
+}
+
 // RestrictedPermissionPolicy is a permission policy that restricts contract creation and calls based on a set of accessControl.
 // Note that all the properties are private, this enforces the permissions not to be modified
 // anywhere else within the code.
@@ -34,6 +46,8 @@ type RestrictedPermissionPolicy struct {
 	accessControl *AccessControl
 	canCreate     callerFn
 	canCall       callerFn
+	contracts     map[common.Address]contractCallRules
+	calls         *enteredCall
 }
 
 func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.Address) PermissionPolicy {
@@ -45,18 +59,37 @@ func NewRestrictedPermissionPolicy(accessControl *AccessControl, signer common.A
 		accessControl: accessControl,
 		canCreate:     canCreate,
 		canCall:       canCall,
+		contracts:     getContractCallRules(accessControl.Contracts, signer),
+		calls:         &enteredCall{},
 	}
 }
 
-var _ PermissionPolicy = RestrictedPermissionPolicy{}
+var (
+	_ PermissionPolicy = RestrictedPermissionPolicy{}
+	_ CallInputPolicy  = RestrictedPermissionPolicy{}
+)
 
 // GetCallHook returns a CallHook that checks if the caller is allowed to perform a call.
+// The calls denied by the permission policies of the target contract revert with a reason
+// explaining the policy. The input of the calls to the contracts with function policies is
+// provided by the tracing hooks of the policy, the calls being denied without them.
 func (p RestrictedPermissionPolicy) GetCallHook(signer common.Address) CallHook {
-	return func(_ *vm.EVM, caller, recipient common.Address) error {
-		if p.CanCall(signer, caller, recipient) {
+	return func(evm *vm.EVM, caller, recipient common.Address) error {
+		input, recorded := p.calls.pop()
+		if !p.CanCall(signer, caller, recipient) {
+			return fmt.Errorf("caller address %s does not have permission to perform a call", caller)
+		}
+		rules, found := p.contracts[recipient]
+		if !found {
 			return nil
 		}
-		return fmt.Errorf("caller address %s does not have permission to perform a call", caller)
+		if !recorded && len(rules.functions) > 0 {
+			return revertCall(evm, fmt.Sprintf("the function policies of contract %s can't be checked without the input of the call", recipient))
+		}
+		if reason := rules.check(caller, recipient, input); reason != "" {
+			return revertCall(evm, reason)
+		}
+		return nil
 	}
 }
 
@@ -70,6 +103,38 @@ func (p RestrictedPermissionPolicy) GetCreateHook(signer common.Address) CreateH
 	}
 }
 
+// TracingHooks implements the CallInputPolicy interface.
+// The input of the calls is recorded when entering them, only if some of the target contracts
+// have function policies.
+func (p RestrictedPermissionPolicy) TracingHooks(hooks *tracing.Hooks) *tracing.Hooks {
+	if !p.hasFunctionRules() {
+		return hooks
+	}
+
+	extended := &tracing.Hooks{}
+	if hooks != nil {
+		*extended = *hooks
+	}
+	onEnter := extended.OnEnter
+	extended.OnEnter = func(depth int, typ byte, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+		p.calls.push(input)
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+	return extended
+}
+
+// hasFunctionRules returns true if some of the target contracts have function policies.
+func (p RestrictedPermissionPolicy) hasFunctionRules() bool {
+	for _, rules := range p.contracts {
+		if len(rules.functions) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // CanCreate implements the PermissionPolicy interface.
 // It allows contract creation if access type is set to everybody.
 // Otherwise, it checks if:
@@ -139,3 +204,107 @@ func permissionedCheckFn(addresses []string, signer common.Address) callerFn {
 		return isSignerAllowed || slices.Contains(addresses, strCaller)
 	}
 }
+
+// enteredCall holds the input of the call entered by the EVM, until its call hooks are run.
+type enteredCall struct {
+	input    []byte
+	recorded bool
+}
+
+// push records the input of the call entered by the EVM.
+func (c *enteredCall) push(input []byte) {
+	c.input, c.recorded = input, true
+}
+
+// pop returns the input of the call entered by the EVM, if recorded.
+func (c *enteredCall) pop() ([]byte, bool) {
+	input, recorded := c.input, c.recorded
+	c.input, c.recorded = nil, false
+	return input, recorded
+}
+
+// callRule is a permission policy for calling a target contract or function.
+type callRule struct {
+	accessType AccessType
+	canCall    callerFn
+}
+
+// contractCallRules are the permission policies for calling a target contract.
+type contractCallRules struct {
+	call      callRule
+	functions map[[4]byte]callRule
+}
+
+// getContractCallRules returns the permission policies for calling the target contracts,
+// keyed by contract address.
+func getContractCallRules(contracts []ContractAccessControl, signer common.Address) map[common.Address]contractCallRules {
+	if len(contracts) == 0 {
+		return nil
+	}
+	rules := make(map[common.Address]contractCallRules, len(contracts))
+	for _, contract := range contracts {
+		functions := make(map[[4]byte]callRule, len(contract.Functions))
+		for _, function := range contract.Functions {
+			selector, err := hexutil.Decode(function.Selector)
+			if err != nil || len(selector) != 4 {
+				// the selectors are validated with the params
+				continue
+			}
+			functions[[4]byte(selector)] = newCallRule(function.Call, signer)
+		}
+		rules[common.HexToAddress(contract.Address)] = contractCallRules{
+			call:      newCallRule(contract.Call, signer),
+			functions: functions,
+		}
+	}
+	return rules
+}
+
+func newCallRule(act AccessControlType, signer common.Address) callRule {
+	canCall := func(_ common.Address) bool { return false }
+	switch act.AccessType {
+	case AccessTypePermissionless:
+		canCall = permissionlessCheckFn(act.AccessControlList, signer)
+	case AccessTypePermissioned:
+		canCall = permissionedCheckFn(act.AccessControlList, signer)
+	}
+	return callRule{accessType: act.AccessType, canCall: canCall}
+}
+
+// check returns the reason why the caller isn't allowed to call the contract with the given
+// input, or an empty string if it is allowed. The function policy matching the selector of
+// the input takes precedence over the policy of the contract.
+func (r contractCallRules) check(caller, contract common.Address, input []byte) string {
+	target := "contract " + contract.String()
+	rule := r.call
+	if len(input) >= 4 {
+		if fnRule, found := r.functions[[4]byte(input[:4])]; found {
+			target = fmt.Sprintf("function %s of contract %s", hexutil.Encode(input[:4]), contract)
+			rule = fnRule
+		}
+	}
+	if rule.canCall(caller) {
+		return ""
+	}
+
+	switch rule.accessType {
+	case AccessTypePermissionless:
+		return fmt.Sprintf("caller address %s or the transaction signer is blocked from calling %s", caller, target)
+	case AccessTypePermissioned:
+		return fmt.Sprintf("neither caller address %s nor the transaction signer is allowed to call %s", caller, target)
+	default:
+		return fmt.Sprintf("calls to %s are restricted", target)
+	}
+}
+
+// revertCall makes the denied call revert with the given reason, which is set as the return data
+// of the EVM as the reverted precompile calls do. The EVM only returns it for the message call,
+// the calling contracts receive no return data.
+func revertCall(evm *vm.EVM, reason string) error {
+	revertReasonBz, err := RevertReasonBytes(reason)
+	if err != nil {
+		return vm.ErrExecutionReverted
+	}
+	evm.Interpreter().SetReturnData(revertReasonBz)
+	return vm.ErrExecutionReverted
+}
@@ -1,9 +1,13 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/suite"
 
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
@@ -229,3 +233,140 @@ func (suite *UnitTestSuite) TestAccessControl() {
 		})
 	}
 }
+
+func (suite *UnitTestSuite) TestContractAccessControl() {
+	keyring := testkeyring.New(3)
+	signer, minter, contract := keyring.GetAddr(0), keyring.GetAddr(1), keyring.GetAddr(2)
+	mint := []byte{0x40, 0xc1, 0x0f, 0x19}
+	transfer := []byte{0xa9, 0x05, 0x9c, 0xbb}
+
+	accessControl := types.DefaultParams().AccessControl
+	accessControl.Contracts = []types.ContractAccessControl{{
+		Address: contract.String(),
+		Functions: []types.FunctionAccessControl{{
+			Selector: "0x40c10f19",
+			Call: types.AccessControlType{
+				AccessType:        types.AccessTypePermissioned,
+				AccessControlList: []string{minter.String()},
+			},
+		}},
+	}}
+
+	testCases := []struct {
+		name      string
+		deprecate bool
+		nested    bool
+		noInput   bool
+		caller    common.Address
+		recipient common.Address
+		input     []byte
+		expReason string
+	}{
+		{
+			name:      "should allow call to function without policy",
+			caller:    signer,
+			recipient: contract,
+			input:     transfer,
+		},
+		{
+			name:      "should allow call to other contract",
+			caller:    signer,
+			recipient: minter,
+			input:     mint,
+		},
+		{
+			name:      "should allow call to function from address in AccessControlList",
+			caller:    minter,
+			recipient: contract,
+			input:     mint,
+		},
+		{
+			name:      "should not allow call to function from address not in AccessControlList",
+			caller:    signer,
+			recipient: contract,
+			input:     mint,
+			expReason: "neither caller address " + signer.String() + " nor the transaction signer is allowed to call function 0x40c10f19 of contract " + contract.String(),
+		},
+		{
+			name:      "should not allow call to deprecated contract",
+			deprecate: true,
+			caller:    signer,
+			recipient: contract,
+			input:     transfer,
+			expReason: "calls to contract " + contract.String() + " are restricted",
+		},
+		{
+			name:      "should apply function policy to deprecated contract",
+			deprecate: true,
+			caller:    minter,
+			recipient: contract,
+			input:     mint,
+		},
+		{
+			name:      "should allow nested call to function from address in AccessControlList",
+			nested:    true,
+			caller:    minter,
+			recipient: contract,
+			input:     mint,
+		},
+		{
+			name:      "should allow nested call to function without policy",
+			nested:    true,
+			caller:    minter,
+			recipient: contract,
+			input:     transfer,
+		},
+		{
+			name:      "should not allow nested call to function from address not in AccessControlList",
+			nested:    true,
+			caller:    signer,
+			recipient: contract,
+			input:     mint,
+			expReason: "neither caller address " + signer.String() + " nor the transaction signer is allowed to call function 0x40c10f19 of contract " + contract.String(),
+		},
+		{
+			name:      "should not allow call to contract with function policies without input",
+			noInput:   true,
+			caller:    minter,
+			recipient: contract,
+			input:     mint,
+			expReason: "the function policies of contract " + contract.String() + " can't be checked without the input of the call",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ac := accessControl
+			ac.Contracts = []types.ContractAccessControl{accessControl.Contracts[0]}
+			if tc.deprecate {
+				ac.Contracts[0].Call.AccessType = types.AccessTypeRestricted
+			}
+			permissionPolicy := types.NewRestrictedPermissionPolicy(&ac, signer)
+			evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, nil, params.TestChainConfig, vm.Config{})
+			inputPolicy, ok := permissionPolicy.(types.CallInputPolicy)
+			suite.Require().True(ok)
+			hooks := inputPolicy.TracingHooks(nil)
+			suite.Require().NotNil(hooks.OnEnter)
+			callHook := permissionPolicy.GetCallHook(signer)
+			depth := 0
+			if tc.nested {
+				// the message call enters an intermediate contract
+				hooks.OnEnter(depth, byte(vm.CALL), signer, tc.caller, transfer, 0, nil)
+				suite.Require().NoError(callHook(evm, signer, tc.caller))
+				depth++
+			}
+			if !tc.noInput {
+				hooks.OnEnter(depth, byte(vm.CALL), tc.caller, tc.recipient, tc.input, 0, nil)
+			}
+			err := callHook(evm, tc.caller, tc.recipient)
+			if tc.expReason == "" {
+				suite.Require().NoError(err)
+				return
+			}
+			suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+			reason, err := abi.UnpackRevert(evm.Interpreter().ReturnData())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expReason, reason)
+		})
+	}
+}
@@ -78,6 +78,15 @@ var xxx_messageInfo_QueryConfigRequest proto.InternalMessageInfo
 type QueryConfigResponse struct {
 	// config is the evm configuration
 	Config *ChainConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// max_code_size is the maximum size of the code deployed by a contract
+	// creation transaction
+	MaxCodeSize uint64 `protobuf:"varint,2,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// max_init_code_size is the maximum size of the init code of a contract
+	// creation transaction
+	MaxInitCodeSize uint64 `protobuf:"varint,3,opt,name=max_init_code_size,json=maxInitCodeSize,proto3" json:"max_init_code_size,omitempty"`
+	// refund_quotient is the cap on the gas refund as the quotient of the gas
+	// used at the current block
+	RefundQuotient uint64 `protobuf:"varint,4,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty"`
 }
 
 func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
@@ -120,6 +129,355 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+func (m *QueryConfigResponse) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *QueryConfigResponse) GetMaxInitCodeSize() uint64 {
+	if m != nil {
+		return m.MaxInitCodeSize
+	}
+	return 0
+}
+
+func (m *QueryConfigResponse) GetRefundQuotient() uint64 {
+	if m != nil {
+		return m.RefundQuotient
+	}
+	return 0
+}
+
+// QueryHardForksRequest defines the request type for querying the hard forks.
+type QueryHardForksRequest struct {
+}
+
+func (m *QueryHardForksRequest) Reset()         { *m = QueryHardForksRequest{} }
+func (m *QueryHardForksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHardForksRequest) ProtoMessage()    {}
+func (*QueryHardForksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{2}
+}
+func (m *QueryHardForksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHardForksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHardForksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHardForksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHardForksRequest.Merge(m, src)
+}
+func (m *QueryHardForksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHardForksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHardForksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHardForksRequest proto.InternalMessageInfo
+
+// QueryHardForksResponse returns the hard forks of the EVM chain config.
+type QueryHardForksResponse struct {
+	// hard_forks are the hard forks set in the chain config
+	HardForks []HardFork `protobuf:"bytes,1,rep,name=hard_forks,json=hardForks,proto3" json:"hard_forks"`
+}
+
+func (m *QueryHardForksResponse) Reset()         { *m = QueryHardForksResponse{} }
+func (m *QueryHardForksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHardForksResponse) ProtoMessage()    {}
+func (*QueryHardForksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{3}
+}
+func (m *QueryHardForksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHardForksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHardForksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHardForksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHardForksResponse.Merge(m, src)
+}
+func (m *QueryHardForksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHardForksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHardForksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHardForksResponse proto.InternalMessageInfo
+
+func (m *QueryHardForksResponse) GetHardForks() []HardFork {
+	if m != nil {
+		return m.HardForks
+	}
+	return nil
+}
+
+// QueryOpcodeGasScheduleRequest defines the request type for querying the
+// opcode gas schedule.
+type QueryOpcodeGasScheduleRequest struct {
+}
+
+func (m *QueryOpcodeGasScheduleRequest) Reset()         { *m = QueryOpcodeGasScheduleRequest{} }
+func (m *QueryOpcodeGasScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpcodeGasScheduleRequest) ProtoMessage()    {}
+func (*QueryOpcodeGasScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{4}
+}
+func (m *QueryOpcodeGasScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpcodeGasScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpcodeGasScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpcodeGasScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpcodeGasScheduleRequest.Merge(m, src)
+}
+func (m *QueryOpcodeGasScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpcodeGasScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpcodeGasScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpcodeGasScheduleRequest proto.InternalMessageInfo
+
+// OpcodeGas defines the constant gas of an overridden opcode.
+type OpcodeGas struct {
+	// opcode is the name of the opcode
+	Opcode string `protobuf:"bytes,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	// constant_gas is the constant gas charged for the opcode
+	ConstantGas uint64 `protobuf:"varint,2,opt,name=constant_gas,json=constantGas,proto3" json:"constant_gas,omitempty"`
+	// fork_constant_gas is the constant gas of the opcode in the current hard
+	// fork with the extra EIPs, without the overrides
+	ForkConstantGas uint64 `protobuf:"varint,3,opt,name=fork_constant_gas,json=forkConstantGas,proto3" json:"fork_constant_gas,omitempty"`
+}
+
+func (m *OpcodeGas) Reset()         { *m = OpcodeGas{} }
+func (m *OpcodeGas) String() string { return proto.CompactTextString(m) }
+func (*OpcodeGas) ProtoMessage()    {}
+func (*OpcodeGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{5}
+}
+func (m *OpcodeGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpcodeGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpcodeGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OpcodeGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeGas.Merge(m, src)
+}
+func (m *OpcodeGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpcodeGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeGas proto.InternalMessageInfo
+
+func (m *OpcodeGas) GetOpcode() string {
+	if m != nil {
+		return m.Opcode
+	}
+	return ""
+}
+
+func (m *OpcodeGas) GetConstantGas() uint64 {
+	if m != nil {
+		return m.ConstantGas
+	}
+	return 0
+}
+
+func (m *OpcodeGas) GetForkConstantGas() uint64 {
+	if m != nil {
+		return m.ForkConstantGas
+	}
+	return 0
+}
+
+// QueryOpcodeGasScheduleResponse returns the constant gas of the overridden
+// opcodes.
+type QueryOpcodeGasScheduleResponse struct {
+	// opcodes are the overridden opcodes
+	Opcodes []OpcodeGas `protobuf:"bytes,1,rep,name=opcodes,proto3" json:"opcodes"`
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Reset()         { *m = QueryOpcodeGasScheduleResponse{} }
+func (m *QueryOpcodeGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpcodeGasScheduleResponse) ProtoMessage()    {}
+func (*QueryOpcodeGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{6}
+}
+func (m *QueryOpcodeGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpcodeGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpcodeGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOpcodeGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpcodeGasScheduleResponse.Merge(m, src)
+}
+func (m *QueryOpcodeGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpcodeGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpcodeGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOpcodeGasScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryOpcodeGasScheduleResponse) GetOpcodes() []OpcodeGas {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+// QueryContractAccessControlRequest defines the request type for querying the
+// permission policies of a target contract.
+type QueryContractAccessControlRequest struct {
+	// address is the ethereum hex address of the target contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractAccessControlRequest) Reset()         { *m = QueryContractAccessControlRequest{} }
+func (m *QueryContractAccessControlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractAccessControlRequest) ProtoMessage()    {}
+func (*QueryContractAccessControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{7}
+}
+func (m *QueryContractAccessControlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractAccessControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractAccessControlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractAccessControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractAccessControlRequest.Merge(m, src)
+}
+func (m *QueryContractAccessControlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractAccessControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractAccessControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractAccessControlRequest proto.InternalMessageInfo
+
+func (m *QueryContractAccessControlRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryContractAccessControlResponse returns the permission policies of a
+// target contract.
+type QueryContractAccessControlResponse struct {
+	// contract_access_control is the permission policies of the contract, unset
+	// if the contract has no specific permission policy
+	ContractAccessControl *ContractAccessControl `protobuf:"bytes,1,opt,name=contract_access_control,json=contractAccessControl,proto3" json:"contract_access_control,omitempty"`
+}
+
+func (m *QueryContractAccessControlResponse) Reset()         { *m = QueryContractAccessControlResponse{} }
+func (m *QueryContractAccessControlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractAccessControlResponse) ProtoMessage()    {}
+func (*QueryContractAccessControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{8}
+}
+func (m *QueryContractAccessControlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractAccessControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractAccessControlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractAccessControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractAccessControlResponse.Merge(m, src)
+}
+func (m *QueryContractAccessControlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractAccessControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractAccessControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractAccessControlResponse proto.InternalMessageInfo
+
+func (m *QueryContractAccessControlResponse) GetContractAccessControl() *ContractAccessControl {
+	if m != nil {
+		return m.ContractAccessControl
+	}
+	return nil
+}
+
 // QueryAccountRequest is the request type for the Query/Account RPC method.
 type QueryAccountRequest struct {
 	// address is the ethereum hex address to query the account for.
@@ -130,7 +488,7 @@ func (m *QueryAccountRequest) Reset()         { *m = QueryAccountRequest{} }
 func (m *QueryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountRequest) ProtoMessage()    {}
 func (*QueryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{2}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{9}
 }
 func (m *QueryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +531,7 @@ func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
 func (m *QueryAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountResponse) ProtoMessage()    {}
 func (*QueryAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{3}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{10}
 }
 func (m *QueryAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +592,7 @@ func (m *QueryCosmosAccountRequest) Reset()         { *m = QueryCosmosAccountReq
 func (m *QueryCosmosAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCosmosAccountRequest) ProtoMessage()    {}
 func (*QueryCosmosAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{4}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{11}
 }
 func (m *QueryCosmosAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +636,7 @@ func (m *QueryCosmosAccountResponse) Reset()         { *m = QueryCosmosAccountRe
 func (m *QueryCosmosAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCosmosAccountResponse) ProtoMessage()    {}
 func (*QueryCosmosAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{5}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
 }
 func (m *QueryCosmosAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,7 +697,7 @@ func (m *QueryValidatorAccountRequest) Reset()         { *m = QueryValidatorAcco
 func (m *QueryValidatorAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorAccountRequest) ProtoMessage()    {}
 func (*QueryValidatorAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{6}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
 }
 func (m *QueryValidatorAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +741,7 @@ func (m *QueryValidatorAccountResponse) Reset()         { *m = QueryValidatorAcc
 func (m *QueryValidatorAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorAccountResponse) ProtoMessage()    {}
 func (*QueryValidatorAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{7}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
 }
 func (m *QueryValidatorAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +801,7 @@ func (m *QueryBalanceRequest) Reset()         { *m = QueryBalanceRequest{} }
 func (m *QueryBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBalanceRequest) ProtoMessage()    {}
 func (*QueryBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{8}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
 }
 func (m *QueryBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +840,7 @@ func (m *QueryBalanceResponse) Reset()         { *m = QueryBalanceResponse{} }
 func (m *QueryBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBalanceResponse) ProtoMessage()    {}
 func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{9}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
 }
 func (m *QueryBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +888,7 @@ func (m *QueryStorageRequest) Reset()         { *m = QueryStorageRequest{} }
 func (m *QueryStorageRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageRequest) ProtoMessage()    {}
 func (*QueryStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{10}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
 }
 func (m *QueryStorageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +928,7 @@ func (m *QueryStorageResponse) Reset()         { *m = QueryStorageResponse{} }
 func (m *QueryStorageResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStorageResponse) ProtoMessage()    {}
 func (*QueryStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{11}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
 }
 func (m *QueryStorageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +974,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +1014,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,26 +1050,28 @@ func (m *QueryCodeResponse) GetCode() []byte {
 	return nil
 }
 
-// QueryTxLogsRequest is the request type for the Query/TxLogs RPC method.
-type QueryTxLogsRequest struct {
-	// hash is the ethereum transaction hex hash to query the logs for.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// pagination defines an optional pagination for the request.
+// QueryStorageRangeRequest is the request type for the Query/StorageRange RPC
+// method.
+type QueryStorageRangeRequest struct {
+	// address is the ethereum hex address to query the storage for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request. The key is the
+	// storage key to start from.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
-func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTxLogsRequest) ProtoMessage()    {}
-func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+func (m *QueryStorageRangeRequest) Reset()         { *m = QueryStorageRangeRequest{} }
+func (m *QueryStorageRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeRequest) ProtoMessage()    {}
+func (*QueryStorageRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
-func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryStorageRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTxLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStorageRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTxLogsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStorageRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -721,22 +1081,300 @@ func (m *QueryTxLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTxLogsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTxLogsRequest.Merge(m, src)
+func (m *QueryStorageRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeRequest.Merge(m, src)
 }
-func (m *QueryTxLogsRequest) XXX_Size() int {
+func (m *QueryStorageRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTxLogsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTxLogsRequest.DiscardUnknown(m)
+func (m *QueryStorageRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTxLogsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryStorageRangeRequest proto.InternalMessageInfo
 
-// QueryTxLogsResponse is the response type for the Query/TxLogs RPC method.
-type QueryTxLogsResponse struct {
-	// logs represents the ethereum logs generated from the given transaction.
-	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+// QueryStorageRangeResponse is the response type for the Query/StorageRange
+// RPC method.
+type QueryStorageRangeResponse struct {
+	// storage is the list of storage entries of the account, ordered by key.
+	Storage []State `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageRangeResponse) Reset()         { *m = QueryStorageRangeResponse{} }
+func (m *QueryStorageRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeResponse) ProtoMessage()    {}
+func (*QueryStorageRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+}
+func (m *QueryStorageRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeResponse.Merge(m, src)
+}
+func (m *QueryStorageRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRangeResponse proto.InternalMessageInfo
+
+func (m *QueryStorageRangeResponse) GetStorage() []State {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *QueryStorageRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsRequest is the request type for the Query/Accounts RPC method.
+type QueryAccountsRequest struct {
+	// pagination defines an optional pagination for the request. The key is the
+	// address bytes to start from. Offsets and total counts are not supported.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// skip_code omits the code of the contract accounts.
+	SkipCode bool `protobuf:"varint,2,opt,name=skip_code,json=skipCode,proto3" json:"skip_code,omitempty"`
+}
+
+func (m *QueryAccountsRequest) Reset()         { *m = QueryAccountsRequest{} }
+func (m *QueryAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsRequest) ProtoMessage()    {}
+func (*QueryAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+}
+func (m *QueryAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsRequest.Merge(m, src)
+}
+func (m *QueryAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsRequest proto.InternalMessageInfo
+
+// EthAccount is an Ethereum account returned by the Query/Accounts RPC method.
+type EthAccount struct {
+	// address is the ethereum hex address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the EVM denomination.
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// nonce is the account's sequence number.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// code_hash is the hex-formatted hash of the account code.
+	CodeHash string `protobuf:"bytes,4,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// code is the account code, empty for externally owned accounts or when
+	// skipped.
+	Code []byte `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *EthAccount) Reset()         { *m = EthAccount{} }
+func (m *EthAccount) String() string { return proto.CompactTextString(m) }
+func (*EthAccount) ProtoMessage()    {}
+func (*EthAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+}
+func (m *EthAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthAccount.Merge(m, src)
+}
+func (m *EthAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthAccount proto.InternalMessageInfo
+
+func (m *EthAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EthAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *EthAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *EthAccount) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *EthAccount) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+// QueryAccountsResponse is the response type for the Query/Accounts RPC
+// method.
+type QueryAccountsResponse struct {
+	// accounts is the list of accounts, ordered by address.
+	Accounts []EthAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
+func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsResponse) ProtoMessage()    {}
+func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+}
+func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsResponse.Merge(m, src)
+}
+func (m *QueryAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsResponse) GetAccounts() []EthAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTxLogsRequest is the request type for the Query/TxLogs RPC method.
+type QueryTxLogsRequest struct {
+	// hash is the ethereum transaction hex hash to query the logs for.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
+func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxLogsRequest) ProtoMessage()    {}
+func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+}
+func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxLogsRequest.Merge(m, src)
+}
+func (m *QueryTxLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxLogsRequest proto.InternalMessageInfo
+
+// QueryTxLogsResponse is the response type for the Query/TxLogs RPC method.
+type QueryTxLogsResponse struct {
+	// logs represents the ethereum logs generated from the given transaction.
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -745,7 +1383,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +1434,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1473,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1527,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1606,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,41 +1656,31 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
-// QueryTraceTxRequest defines TraceTx request
-type QueryTraceTxRequest struct {
-	// msg is the MsgEthereumTx for the requested transaction
-	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// trace_config holds extra parameters to trace functions.
-	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
-	// predecessors is an array of transactions included in the same block
-	// need to be replayed first to get correct context for tracing.
-	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
-	// block_number of requested transaction
-	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// block_hash of requested transaction
-	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block_time of requested transaction
-	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
-	// proposer_address is the proposer of the requested block
-	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
-	// chain_id is the eip155 chain id parsed from the requested block header
-	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// block_max_gas of the block of the requested transaction
-	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts uses the same json format as the `eth_simulateV1` json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the gas cap shared by all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// timeout is the maximum duration of the simulation, in the format of
+	// time.ParseDuration. No timeout is applied if empty.
+	Timeout string `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
-func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTraceTxRequest) ProtoMessage()    {}
-func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
 }
-func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1062,49 +1690,348 @@ func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
 }
-func (m *QueryTraceTxRequest) XXX_Size() int {
+func (m *SimulateV1Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
 
-func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+func (m *SimulateV1Request) GetOpts() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Opts
 	}
 	return nil
 }
 
-func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+func (m *SimulateV1Request) GetGasCap() uint64 {
 	if m != nil {
-		return m.TraceConfig
+		return m.GasCap
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
 	if m != nil {
-		return m.Predecessors
+		return m.ProposerAddress
 	}
 	return nil
 }
 
-func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+func (m *SimulateV1Request) GetTimeout() string {
 	if m != nil {
-		return m.BlockNumber
+		return m.Timeout
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryTraceTxRequest) GetBlockHash() string {
-	if m != nil {
-		return m.BlockHash
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CallManyRequest defines CallMany request
+type CallManyRequest struct {
+	// bundles uses the same json format as the bundles of the `eth_callMany`
+	// json rpc api.
+	Bundles []byte `protobuf:"bytes,1,opt,name=bundles,proto3" json:"bundles,omitempty"`
+	// overrides are the state overrides shared by all the bundles, encoded as
+	// json
+	Overrides []byte `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// gas_cap defines the gas cap shared by all the calls
+	GasCap uint64 `protobuf:"varint,3,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,4,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// predecessors is an array of transactions of the requested block that are
+	// applied before the bundles.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,5,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of the requested block, only set along with the predecessors
+	BlockNumber int64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_time of the requested block, only set along with the predecessors
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// validate checks the nonces and the balances of the senders and charges the
+	// fees of the calls, as done for the transactions of a block.
+	Validate bool `protobuf:"varint,8,opt,name=validate,proto3" json:"validate,omitempty"`
+	// timeout is the maximum duration of the execution of the bundles, in the
+	// format of time.ParseDuration. No timeout is applied if empty.
+	Timeout string `protobuf:"bytes,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *CallManyRequest) Reset()         { *m = CallManyRequest{} }
+func (m *CallManyRequest) String() string { return proto.CompactTextString(m) }
+func (*CallManyRequest) ProtoMessage()    {}
+func (*CallManyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{34}
+}
+func (m *CallManyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallManyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallManyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallManyRequest.Merge(m, src)
+}
+func (m *CallManyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallManyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallManyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallManyRequest proto.InternalMessageInfo
+
+func (m *CallManyRequest) GetBundles() []byte {
+	if m != nil {
+		return m.Bundles
+	}
+	return nil
+}
+
+func (m *CallManyRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *CallManyRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *CallManyRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *CallManyRequest) GetPredecessors() []*MsgEthereumTx {
+	if m != nil {
+		return m.Predecessors
+	}
+	return nil
+}
+
+func (m *CallManyRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *CallManyRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *CallManyRequest) GetValidate() bool {
+	if m != nil {
+		return m.Validate
+	}
+	return false
+}
+
+func (m *CallManyRequest) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
+// CallManyResponse defines CallMany response
+type CallManyResponse struct {
+	// data is the json encoded list of the call results of every bundle
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CallManyResponse) Reset()         { *m = CallManyResponse{} }
+func (m *CallManyResponse) String() string { return proto.CompactTextString(m) }
+func (*CallManyResponse) ProtoMessage()    {}
+func (*CallManyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{35}
+}
+func (m *CallManyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallManyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallManyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallManyResponse.Merge(m, src)
+}
+func (m *CallManyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallManyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallManyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallManyResponse proto.InternalMessageInfo
+
+func (m *CallManyResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// QueryTraceTxRequest defines TraceTx request
+type QueryTraceTxRequest struct {
+	// msg is the MsgEthereumTx for the requested transaction
+	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// predecessors is an array of transactions included in the same block
+	// need to be replayed first to get correct context for tracing.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of requested transaction
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of requested transaction
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of requested transaction
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block of the requested transaction
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
+func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceTxRequest) ProtoMessage()    {}
+func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{36}
+}
+func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+}
+func (m *QueryTraceTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+
+func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+	if m != nil {
+		return m.Predecessors
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceTxRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
 	return ""
 }
@@ -1147,7 +2074,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{37}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +2134,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{38}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +2229,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{39}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +2289,7 @@ func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
 func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallRequest) ProtoMessage()    {}
 func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{40}
 }
 func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +2384,7 @@ func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{}
 func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallResponse) ProtoMessage()    {}
 func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{41}
 }
 func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +2429,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{42}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +2468,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{43}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2506,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{44}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +2545,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{45}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,6 +2577,13 @@ var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryHardForksRequest)(nil), "cosmos.evm.vm.v1.QueryHardForksRequest")
+	proto.RegisterType((*QueryHardForksResponse)(nil), "cosmos.evm.vm.v1.QueryHardForksResponse")
+	proto.RegisterType((*QueryOpcodeGasScheduleRequest)(nil), "cosmos.evm.vm.v1.QueryOpcodeGasScheduleRequest")
+	proto.RegisterType((*OpcodeGas)(nil), "cosmos.evm.vm.v1.OpcodeGas")
+	proto.RegisterType((*QueryOpcodeGasScheduleResponse)(nil), "cosmos.evm.vm.v1.QueryOpcodeGasScheduleResponse")
+	proto.RegisterType((*QueryContractAccessControlRequest)(nil), "cosmos.evm.vm.v1.QueryContractAccessControlRequest")
+	proto.RegisterType((*QueryContractAccessControlResponse)(nil), "cosmos.evm.vm.v1.QueryContractAccessControlResponse")
 	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
 	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
@@ -1662,12 +2596,21 @@ func init() {
 	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryStorageRangeRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRangeRequest")
+	proto.RegisterType((*QueryStorageRangeResponse)(nil), "cosmos.evm.vm.v1.QueryStorageRangeResponse")
+	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.evm.vm.v1.QueryAccountsRequest")
+	proto.RegisterType((*EthAccount)(nil), "cosmos.evm.vm.v1.EthAccount")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.evm.vm.v1.QueryAccountsResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "cosmos.evm.vm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "cosmos.evm.vm.v1.SimulateV1Response")
+	proto.RegisterType((*CallManyRequest)(nil), "cosmos.evm.vm.v1.CallManyRequest")
+	proto.RegisterType((*CallManyResponse)(nil), "cosmos.evm.vm.v1.CallManyResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
@@ -1683,115 +2626,158 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0x76, 0xa2, 0x4c, 0xec, 0x8d, 0xcc, 0xb5, 0x25, 0x87, 0xf1,
-	0x77, 0x1c, 0x32, 0xf6, 0x66, 0x17, 0xd8, 0xec, 0x61, 0xd7, 0x36, 0x1c, 0x27, 0x9b, 0x64, 0x91,
-	0x55, 0x8d, 0x1e, 0x0a, 0x14, 0xc2, 0x88, 0x9a, 0x50, 0x84, 0x45, 0x52, 0xe1, 0x50, 0xaa, 0x9c,
-	0x8f, 0x1e, 0x8a, 0x36, 0x4d, 0x90, 0x4b, 0x8a, 0xde, 0xdb, 0x1c, 0x7b, 0x6b, 0x6f, 0xfd, 0x17,
-	0x72, 0x0c, 0x50, 0x14, 0x28, 0x7a, 0x48, 0x8b, 0xa4, 0x40, 0xfb, 0x37, 0xf4, 0x54, 0xcc, 0x07,
-	0x25, 0x52, 0x14, 0x25, 0xa7, 0x48, 0xd1, 0x1e, 0x0a, 0x18, 0x09, 0x67, 0xe6, 0xcd, 0x7b, 0xbf,
-	0x79, 0xdf, 0x4f, 0x60, 0x56, 0x77, 0x88, 0xe5, 0x10, 0x0d, 0xb7, 0x2c, 0x8d, 0xfe, 0x6d, 0x68,
-	0xb7, 0x9a, 0xd8, 0x3d, 0x54, 0x1b, 0xae, 0xe3, 0x39, 0x30, 0xc7, 0x4f, 0x55, 0xdc, 0xb2, 0x54,
-	0xfa, 0xb7, 0x21, 0x9f, 0x40, 0x96, 0x69, 0x3b, 0x1a, 0xfb, 0x97, 0x13, 0xc9, 0x6b, 0x82, 0x45,
-	0x05, 0x11, 0xcc, 0x6f, 0x6b, 0xad, 0x8d, 0x0a, 0xf6, 0xd0, 0x86, 0xd6, 0x40, 0x86, 0x69, 0x23,
-	0xcf, 0x74, 0x6c, 0x41, 0x2b, 0x47, 0xc4, 0x51, 0xd6, 0xfc, 0x6c, 0x26, 0x72, 0xe6, 0xb5, 0xc5,
-	0xd1, 0x94, 0xe1, 0x18, 0x0e, 0xfb, 0xd4, 0xe8, 0x97, 0xd8, 0x9d, 0x35, 0x1c, 0xc7, 0xa8, 0x63,
-	0x0d, 0x35, 0x4c, 0x0d, 0xd9, 0xb6, 0xe3, 0x31, 0x49, 0x44, 0x9c, 0x16, 0xc5, 0x29, 0x5b, 0x55,
-	0x9a, 0x37, 0x35, 0xcf, 0xb4, 0x30, 0xf1, 0x90, 0xd5, 0xe0, 0x04, 0xca, 0x14, 0x80, 0xff, 0xa7,
-	0x68, 0x77, 0x1c, 0xfb, 0xa6, 0x69, 0x94, 0xf0, 0xad, 0x26, 0x26, 0x9e, 0x72, 0x0d, 0x9c, 0x0c,
-	0xed, 0x92, 0x86, 0x63, 0x13, 0x0c, 0xff, 0x0e, 0xc6, 0x75, 0xb6, 0x93, 0x97, 0xe6, 0xa5, 0x95,
-	0xec, 0xe6, 0x9c, 0xda, 0xab, 0x1a, 0x75, 0xa7, 0x86, 0x4c, 0x5b, 0x5c, 0x13, 0xc4, 0xca, 0x3f,
-	0x05, 0xb7, 0x2d, 0x5d, 0x77, 0x9a, 0xb6, 0x27, 0x84, 0xc0, 0x3c, 0x48, 0xa1, 0x6a, 0xd5, 0xc5,
-	0x84, 0x30, 0x76, 0x99, 0x92, 0xbf, 0xbc, 0x98, 0x7e, 0xf0, 0xa4, 0x38, 0xf2, 0xd3, 0x93, 0xe2,
-	0x88, 0xa2, 0x83, 0xa9, 0xf0, 0x55, 0x81, 0x24, 0x0f, 0x52, 0x15, 0x54, 0x47, 0xb6, 0x8e, 0xfd,
-	0xbb, 0x62, 0x09, 0xff, 0x0a, 0x32, 0xba, 0x53, 0xc5, 0xe5, 0x1a, 0x22, 0xb5, 0xfc, 0x28, 0x3b,
-	0x4b, 0xd3, 0x8d, 0xcb, 0x88, 0xd4, 0xe0, 0x14, 0x18, 0xb3, 0x1d, 0x7a, 0x29, 0x31, 0x2f, 0xad,
-	0x24, 0x4b, 0x7c, 0xa1, 0xfc, 0x1b, 0xcc, 0x88, 0xd7, 0xd2, 0xc7, 0xfc, 0x0a, 0x94, 0xf7, 0x25,
-	0x20, 0xf7, 0xe3, 0x20, 0xc0, 0x2e, 0x82, 0x63, 0x5c, 0x4f, 0xe5, 0x30, 0xa7, 0x49, 0xbe, 0xbb,
-	0xc5, 0x37, 0xa1, 0x0c, 0xd2, 0x84, 0x0a, 0xa5, 0xf8, 0x46, 0x19, 0xbe, 0xce, 0x9a, 0xb2, 0x40,
-	0x9c, 0x6b, 0xd9, 0x6e, 0x5a, 0x15, 0xec, 0x8a, 0x17, 0x4c, 0x8a, 0xdd, 0xff, 0xb1, 0x4d, 0xe5,
-	0x2a, 0x98, 0x65, 0x38, 0xde, 0x44, 0x75, 0xb3, 0x8a, 0x3c, 0xc7, 0xed, 0x79, 0xcc, 0x69, 0x30,
-	0xa1, 0x3b, 0x76, 0x2f, 0x8e, 0x2c, 0xdd, 0xdb, 0x8a, 0xbc, 0xea, 0x91, 0x04, 0xe6, 0x62, 0xb8,
-	0x89, 0x87, 0x2d, 0x83, 0xe3, 0x3e, 0xaa, 0x30, 0x47, 0x1f, 0xec, 0x6b, 0x7c, 0x9a, 0xef, 0x44,
-	0xdb, 0xdc, 0xce, 0xaf, 0x62, 0x9e, 0xf3, 0xc2, 0x89, 0x3a, 0x57, 0x87, 0x39, 0x91, 0x72, 0x55,
-	0x08, 0x7b, 0xc3, 0x73, 0x5c, 0x64, 0x0c, 0x17, 0x06, 0x73, 0x20, 0x71, 0x80, 0x0f, 0x85, 0xbf,
-	0xd1, 0xcf, 0x80, 0xf8, 0x75, 0x21, 0xbe, 0xc3, 0x4c, 0x88, 0x9f, 0x02, 0x63, 0x2d, 0x54, 0x6f,
-	0xfa, 0xc2, 0xf9, 0x42, 0xf9, 0x07, 0xc8, 0x09, 0x57, 0xaa, 0xbe, 0xd2, 0x23, 0x97, 0xc1, 0x89,
-	0xc0, 0x3d, 0x21, 0x02, 0x82, 0x24, 0xf5, 0x7d, 0x76, 0x6b, 0xa2, 0xc4, 0xbe, 0x95, 0xdb, 0x22,
-	0xe2, 0xf7, 0xdb, 0xd7, 0x1c, 0x83, 0xf8, 0x22, 0x20, 0x48, 0xb2, 0x88, 0xe1, 0xfc, 0xd9, 0x37,
-	0xbc, 0x04, 0x40, 0x37, 0x77, 0xb1, 0xb7, 0x65, 0x37, 0x97, 0xfc, 0x90, 0xa7, 0x89, 0x4e, 0xe5,
-	0x69, 0x52, 0x24, 0x3a, 0xf5, 0x46, 0x57, 0x55, 0xa5, 0xc0, 0xcd, 0x00, 0xc8, 0x87, 0x92, 0x50,
-	0xac, 0x2f, 0x5c, 0xe0, 0x5c, 0x05, 0xc9, 0xba, 0x63, 0xd0, 0xd7, 0x25, 0x56, 0xb2, 0x9b, 0xd3,
-	0xd1, 0xb4, 0x72, 0xcd, 0x31, 0x4a, 0x8c, 0x04, 0xee, 0xf5, 0x01, 0xb5, 0x3c, 0x14, 0x14, 0x97,
-	0x13, 0x44, 0xd5, 0xc9, 0x7c, 0x37, 0x90, 0x8b, 0x2c, 0x5f, 0x0f, 0x4a, 0x49, 0x00, 0xf4, 0x77,
-	0x05, 0xc0, 0x7f, 0x81, 0xf1, 0x06, 0xdb, 0x11, 0x99, 0x2f, 0x1f, 0x85, 0xc8, 0x6f, 0x6c, 0x67,
-	0x9e, 0x3e, 0x2f, 0x8e, 0x7c, 0xf6, 0xe3, 0x17, 0x6b, 0x52, 0x49, 0x5c, 0x51, 0xbe, 0x96, 0xc0,
-	0xb1, 0x5d, 0xaf, 0xb6, 0x83, 0xea, 0xf5, 0x80, 0xba, 0x91, 0x6b, 0x10, 0xdf, 0x30, 0xf4, 0x1b,
-	0x9e, 0x02, 0x29, 0x03, 0x91, 0xb2, 0x8e, 0x1a, 0x22, 0x46, 0xc6, 0x0d, 0x44, 0x76, 0x50, 0x03,
-	0xbe, 0x0d, 0x72, 0x0d, 0xd7, 0x69, 0x38, 0x04, 0xbb, 0x9d, 0x38, 0xa3, 0x31, 0x32, 0xb1, 0xbd,
-	0xf9, 0xf3, 0xf3, 0xa2, 0x6a, 0x98, 0x5e, 0xad, 0x59, 0x51, 0x75, 0xc7, 0xd2, 0x44, 0xf1, 0xe0,
-	0xff, 0x9d, 0x23, 0xd5, 0x03, 0xcd, 0x3b, 0x6c, 0x60, 0xa2, 0xee, 0x74, 0x03, 0xbc, 0x74, 0xdc,
-	0xe7, 0xe5, 0x07, 0xe7, 0x0c, 0x48, 0xeb, 0x34, 0x6b, 0x97, 0xcd, 0x6a, 0x3e, 0x39, 0x2f, 0xad,
-	0x24, 0x4a, 0x29, 0xb6, 0xbe, 0x52, 0x85, 0xb3, 0x20, 0xe3, 0xb4, 0xb0, 0xeb, 0x9a, 0x55, 0x4c,
-	0xf2, 0x63, 0x0c, 0x6b, 0x77, 0x43, 0xd9, 0x07, 0x27, 0x77, 0x89, 0x67, 0x5a, 0xc8, 0xc3, 0x7b,
-	0xa8, 0xab, 0xab, 0x1c, 0x48, 0x18, 0x88, 0x3f, 0x2d, 0x59, 0xa2, 0x9f, 0x74, 0xc7, 0xc5, 0x1e,
-	0x7b, 0xd5, 0x44, 0x89, 0x7e, 0x52, 0x99, 0x2d, 0xab, 0x8c, 0x5d, 0xd7, 0xe1, 0xe1, 0x9e, 0x29,
-	0xa5, 0x5a, 0xd6, 0x2e, 0x5d, 0x2a, 0x0f, 0x93, 0xbe, 0x8f, 0xb8, 0x48, 0xc7, 0xfb, 0x6d, 0x5f,
-	0x65, 0x1b, 0x20, 0x61, 0x11, 0xbf, 0xf2, 0x14, 0xa3, 0xfa, 0xbf, 0x4e, 0x8c, 0x5d, 0xaf, 0x86,
-	0x5d, 0xdc, 0xb4, 0xf6, 0xdb, 0x25, 0x4a, 0x0b, 0xff, 0x03, 0x26, 0x3c, 0xca, 0xa4, 0x2c, 0xaa,
-	0x56, 0x22, 0xae, 0x6a, 0x31, 0x51, 0xa2, 0x6a, 0x65, 0xbd, 0xee, 0x02, 0xee, 0x80, 0x89, 0x86,
-	0x8b, 0xab, 0x58, 0xc7, 0x84, 0x38, 0x2e, 0xc9, 0x27, 0x99, 0x83, 0x0e, 0x95, 0x1e, 0xba, 0x44,
-	0xb3, 0x6e, 0xa5, 0xee, 0xe8, 0x07, 0x7e, 0x7e, 0x1b, 0x63, 0x4a, 0xce, 0xb2, 0x3d, 0x9e, 0xdd,
-	0xe0, 0x1c, 0x00, 0x9c, 0x84, 0x05, 0xe1, 0x38, 0xd3, 0x48, 0x86, 0xed, 0xb0, 0xba, 0x75, 0xd9,
-	0x3f, 0xa6, 0xe5, 0x3b, 0x9f, 0x62, 0xcf, 0x90, 0x55, 0x5e, 0xdb, 0x55, 0xbf, 0xb6, 0xab, 0xfb,
-	0x7e, 0x6d, 0xdf, 0x9e, 0xa4, 0x4e, 0xf8, 0xf8, 0xbb, 0xa2, 0xc4, 0x1d, 0x91, 0x73, 0xa2, 0xc7,
-	0x7d, 0x7d, 0x29, 0xfd, 0xdb, 0xf8, 0x52, 0x26, 0xec, 0x4b, 0x0a, 0x98, 0xe4, 0x6f, 0xb0, 0x50,
-	0xbb, 0x4c, 0x1d, 0x04, 0x04, 0xd4, 0x70, 0x1d, 0xb5, 0xf7, 0x10, 0xf9, 0x6f, 0x32, 0x3d, 0x9a,
-	0x4b, 0x94, 0xd2, 0x5e, 0xbb, 0x6c, 0xda, 0x55, 0xdc, 0x56, 0xd6, 0x44, 0xea, 0xec, 0xb8, 0x42,
-	0x37, 0xaf, 0x55, 0x91, 0x87, 0xfc, 0xf0, 0xa1, 0xdf, 0xca, 0x97, 0x09, 0xf0, 0x97, 0x2e, 0xf1,
-	0x36, 0xe5, 0x1a, 0x70, 0x1d, 0xaf, 0xed, 0x67, 0x97, 0xe1, 0xae, 0xe3, 0xb5, 0xc9, 0x6b, 0x70,
-	0x9d, 0x3f, 0xad, 0x7e, 0x44, 0xab, 0x2b, 0xe7, 0xc0, 0xa9, 0x88, 0xe1, 0x06, 0x18, 0xfa, 0xc3,
-	0x04, 0x98, 0xee, 0xd2, 0xff, 0x51, 0xb3, 0x6a, 0xaf, 0x03, 0x25, 0x7f, 0x07, 0x07, 0xda, 0x79,
-	0x45, 0x07, 0x4a, 0xfb, 0x0e, 0x14, 0xf4, 0x9d, 0xa0, 0x71, 0xd3, 0x21, 0xe3, 0x2a, 0xeb, 0xc1,
-	0x88, 0xe3, 0x86, 0x18, 0x60, 0xb7, 0xe9, 0x4e, 0x07, 0x47, 0xf0, 0x25, 0x8c, 0xbb, 0xb3, 0xc6,
-	0x54, 0x78, 0x5b, 0xb0, 0xb8, 0x00, 0xd2, 0xb4, 0x9c, 0x97, 0x6f, 0x62, 0xd1, 0x21, 0x6d, 0xcf,
-	0x7c, 0xfb, 0xbc, 0x38, 0xcd, 0xf5, 0x47, 0xaa, 0x07, 0xaa, 0xe9, 0x68, 0x16, 0xf2, 0x6a, 0xea,
-	0x15, 0xdb, 0xa3, 0x9d, 0x1b, 0xbb, 0xad, 0x14, 0x45, 0xcf, 0xba, 0x57, 0x77, 0x2a, 0xa8, 0x7e,
-	0xdd, 0xb4, 0xf7, 0x10, 0xb9, 0xe1, 0x9a, 0x9d, 0x86, 0x51, 0xd1, 0x41, 0x21, 0x8e, 0x40, 0x08,
-	0xde, 0x02, 0x93, 0x96, 0x69, 0x53, 0x67, 0x2d, 0x37, 0xe8, 0x81, 0x90, 0x3e, 0x47, 0x95, 0x13,
-	0x8f, 0x20, 0x6b, 0x75, 0x59, 0x6d, 0x7e, 0x94, 0x03, 0x63, 0x4c, 0x0a, 0xfc, 0x40, 0x02, 0x29,
-	0xd1, 0x36, 0xc3, 0xc5, 0xa8, 0xf1, 0xfb, 0xcc, 0x45, 0xf2, 0xd2, 0x30, 0x32, 0x8e, 0x53, 0x39,
-	0xfb, 0xde, 0x57, 0x3f, 0x7c, 0x3c, 0xba, 0x08, 0xcf, 0x68, 0x91, 0x99, 0x51, 0xb4, 0xce, 0xda,
-	0x1d, 0xe1, 0xd8, 0xf7, 0xe0, 0x27, 0x12, 0x98, 0x0c, 0x4d, 0x27, 0xf0, 0x6c, 0x8c, 0x98, 0x7e,
-	0x53, 0x90, 0xbc, 0x7e, 0x34, 0x62, 0x81, 0x6c, 0x93, 0x21, 0x5b, 0x87, 0x6b, 0x51, 0x64, 0xfe,
-	0x20, 0x14, 0x01, 0xf8, 0xb9, 0x04, 0x72, 0xbd, 0x83, 0x06, 0x54, 0x63, 0xc4, 0xc6, 0xcc, 0x37,
-	0xb2, 0x76, 0x64, 0x7a, 0x81, 0xf4, 0x22, 0x43, 0x7a, 0x01, 0x6e, 0x46, 0x91, 0xb6, 0xfc, 0x3b,
-	0x5d, 0xb0, 0xc1, 0xd9, 0xe9, 0x1e, 0xbc, 0x2f, 0x81, 0x94, 0x18, 0x29, 0x62, 0x4d, 0x1b, 0x9e,
-	0x56, 0x62, 0x4d, 0xdb, 0x33, 0x99, 0x28, 0xeb, 0x0c, 0xd6, 0x12, 0x5c, 0x88, 0xc2, 0x12, 0x23,
-	0x0a, 0x09, 0xa8, 0xee, 0x91, 0x04, 0x52, 0x62, 0xb8, 0x88, 0x05, 0x12, 0x9e, 0x64, 0x62, 0x81,
-	0xf4, 0xcc, 0x28, 0xca, 0x06, 0x03, 0x72, 0x16, 0xae, 0x46, 0x81, 0x10, 0x4e, 0xda, 0xc5, 0xa1,
-	0xdd, 0x39, 0xc0, 0x87, 0xf7, 0xe0, 0x6d, 0x90, 0xa4, 0x33, 0x08, 0x54, 0x62, 0x5d, 0xa6, 0x33,
-	0xd8, 0xc8, 0x67, 0x06, 0xd2, 0x08, 0x0c, 0xab, 0x0c, 0xc3, 0x19, 0x78, 0xba, 0x9f, 0x37, 0x55,
-	0x43, 0x9a, 0x78, 0x07, 0x8c, 0xf3, 0x36, 0x1c, 0x2e, 0xc4, 0x70, 0x0e, 0x75, 0xfb, 0xf2, 0xe2,
-	0x10, 0x2a, 0x81, 0x60, 0x9e, 0x21, 0x90, 0x61, 0x3e, 0x8a, 0x80, 0xb7, 0xf8, 0xb0, 0x0d, 0x52,
-	0xa2, 0xc3, 0x87, 0xf3, 0x51, 0x9e, 0xe1, 0xe6, 0x5f, 0x5e, 0x1e, 0xd6, 0x81, 0xf8, 0x72, 0x15,
-	0x26, 0x77, 0x16, 0xca, 0x51, 0xb9, 0xd8, 0xab, 0x95, 0x75, 0x2a, 0xee, 0x5d, 0x90, 0x0d, 0x34,
-	0xe1, 0x47, 0x90, 0xde, 0xe7, 0xcd, 0x7d, 0xba, 0x78, 0x65, 0x89, 0xc9, 0x9e, 0x87, 0x85, 0x3e,
-	0xb2, 0x05, 0x39, 0x4d, 0x91, 0xf0, 0x2e, 0x48, 0x89, 0xee, 0x2c, 0xd6, 0xf7, 0xc2, 0x8d, 0x7c,
-	0xac, 0xef, 0xf5, 0x34, 0x79, 0x83, 0x5e, 0xcf, 0x2b, 0xab, 0xd7, 0x86, 0x0f, 0x24, 0x00, 0xba,
-	0x6d, 0x03, 0x5c, 0x19, 0xc4, 0x3a, 0xd8, 0x12, 0xca, 0xab, 0x47, 0xa0, 0x14, 0x38, 0x16, 0x19,
-	0x8e, 0x22, 0x9c, 0x8b, 0xc3, 0xc1, 0x6a, 0x25, 0x7c, 0x5f, 0x02, 0x99, 0x4e, 0x21, 0x84, 0xcb,
-	0x83, 0xf8, 0x07, 0xcd, 0xb1, 0x32, 0x9c, 0x50, 0xe0, 0x58, 0x60, 0x38, 0x0a, 0x70, 0x36, 0x0e,
-	0x07, 0xf3, 0x87, 0xbb, 0x34, 0x29, 0xb1, 0x5a, 0x38, 0x20, 0x29, 0x05, 0x0b, 0xf0, 0x80, 0xa4,
-	0x14, 0x2a, 0xc8, 0x83, 0xec, 0xe1, 0x17, 0x6a, 0x1a, 0x80, 0xa2, 0x7b, 0x59, 0x88, 0x0d, 0xed,
-	0xc0, 0x0f, 0x8d, 0xb1, 0x01, 0x18, 0xfe, 0xe1, 0x71, 0x50, 0x00, 0xf2, 0xf6, 0x0a, 0x7e, 0x2a,
-	0x81, 0x13, 0x91, 0x92, 0x0e, 0xe3, 0xea, 0x41, 0x5c, 0x77, 0x20, 0x9f, 0x3f, 0xfa, 0x05, 0x01,
-	0x6d, 0x99, 0x41, 0x3b, 0x0d, 0x8b, 0x51, 0x68, 0xa1, 0x2e, 0x62, 0xfb, 0xe2, 0xd3, 0x17, 0x05,
-	0xe9, 0xd9, 0x8b, 0x82, 0xf4, 0xfd, 0x8b, 0x82, 0xf4, 0xf8, 0x65, 0x61, 0xe4, 0xd9, 0xcb, 0xc2,
-	0xc8, 0x37, 0x2f, 0x0b, 0x23, 0x6f, 0xcd, 0x47, 0x7b, 0x4d, 0xca, 0xa4, 0x4d, 0xd9, 0xb0, 0x4e,
-	0xb3, 0x32, 0xce, 0x9a, 0xb5, 0xbf, 0xfd, 0x12, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xe7, 0xce, 0x3f,
-	0xa9, 0x16, 0x00, 0x00,
+	// 2407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xd7, 0x98, 0xb4, 0x48, 0x1e, 0x49, 0xb6, 0x74, 0x2d, 0xd9, 0xf4, 0x58, 0x12, 0xe5, 0xb1,
+	0xf5, 0xb0, 0x6c, 0x93, 0x96, 0xec, 0x7c, 0xc0, 0xe7, 0xb4, 0x48, 0x2d, 0xc1, 0xaf, 0xc6, 0x6e,
+	0x1d, 0xda, 0x08, 0xd0, 0x02, 0x01, 0x71, 0x35, 0xbc, 0x26, 0x07, 0xe2, 0xcc, 0xd0, 0x33, 0x97,
+	0x2c, 0xed, 0xc4, 0x29, 0x50, 0x34, 0x2f, 0x64, 0x13, 0xa0, 0x9b, 0xa2, 0x8b, 0x36, 0x28, 0x10,
+	0xa0, 0x5d, 0xb5, 0xbb, 0xa2, 0xab, 0xee, 0x8a, 0x2c, 0x03, 0x14, 0x05, 0x8a, 0x2e, 0xdc, 0xc2,
+	0x2e, 0xd0, 0xfe, 0x0d, 0x5d, 0x15, 0xf7, 0x45, 0xce, 0x70, 0x66, 0x38, 0x74, 0xa0, 0x20, 0x5d,
+	0x14, 0x20, 0xa4, 0xb9, 0x77, 0xce, 0xb9, 0xe7, 0x77, 0x1e, 0x73, 0xef, 0x39, 0xe7, 0xc2, 0xa2,
+	0xe9, 0xfa, 0xb6, 0xeb, 0x57, 0x48, 0xd7, 0xae, 0xb0, 0xdf, 0x56, 0xe5, 0x51, 0x87, 0x78, 0x8f,
+	0xcb, 0x6d, 0xcf, 0xa5, 0x2e, 0x9a, 0x15, 0x6f, 0xcb, 0xa4, 0x6b, 0x97, 0xd9, 0x6f, 0x4b, 0x9f,
+	0xc3, 0xb6, 0xe5, 0xb8, 0x15, 0xfe, 0x57, 0x10, 0xe9, 0x9b, 0x72, 0x89, 0x3d, 0xec, 0x13, 0xc1,
+	0x5d, 0xe9, 0x6e, 0xed, 0x11, 0x8a, 0xb7, 0x2a, 0x6d, 0xdc, 0xb0, 0x1c, 0x4c, 0x2d, 0xd7, 0x91,
+	0xb4, 0x7a, 0x44, 0x1c, 0x5b, 0x5a, 0xbc, 0x3b, 0x19, 0x79, 0x47, 0x7b, 0xf2, 0xd5, 0x7c, 0xc3,
+	0x6d, 0xb8, 0xfc, 0xb1, 0xc2, 0x9e, 0xe4, 0xec, 0x62, 0xc3, 0x75, 0x1b, 0x2d, 0x52, 0xc1, 0x6d,
+	0xab, 0x82, 0x1d, 0xc7, 0xa5, 0x5c, 0x92, 0x2f, 0xdf, 0x96, 0xe4, 0x5b, 0x3e, 0xda, 0xeb, 0x3c,
+	0xac, 0x50, 0xcb, 0x26, 0x3e, 0xc5, 0x76, 0x5b, 0x10, 0x18, 0xf3, 0x80, 0xde, 0x60, 0x68, 0x77,
+	0x5d, 0xe7, 0xa1, 0xd5, 0xa8, 0x92, 0x47, 0x1d, 0xe2, 0x53, 0xe3, 0x8f, 0x1a, 0x1c, 0x0b, 0x4d,
+	0xfb, 0x6d, 0xd7, 0xf1, 0x09, 0x7a, 0x05, 0x26, 0x4d, 0x3e, 0x53, 0xd4, 0x56, 0xb4, 0x8d, 0xa9,
+	0xed, 0xa5, 0xf2, 0xb0, 0x6d, 0xca, 0xbb, 0x4d, 0x6c, 0x39, 0x92, 0x4d, 0x12, 0x23, 0x03, 0x66,
+	0x6c, 0xdc, 0xab, 0x99, 0x6e, 0x9d, 0xd4, 0x7c, 0xeb, 0x09, 0x29, 0x1e, 0x5a, 0xd1, 0x36, 0xb2,
+	0xd5, 0x29, 0x1b, 0xf7, 0x76, 0xdd, 0x3a, 0xb9, 0x6f, 0x3d, 0x21, 0xe8, 0x3c, 0x20, 0x46, 0x63,
+	0x39, 0x16, 0x0d, 0x10, 0x66, 0x38, 0xe1, 0x51, 0x1b, 0xf7, 0x6e, 0x3b, 0x16, 0xed, 0x13, 0xaf,
+	0xc3, 0x51, 0x8f, 0x3c, 0xec, 0x38, 0xf5, 0xda, 0xa3, 0x8e, 0x4b, 0x2d, 0xe2, 0xd0, 0x62, 0x96,
+	0x53, 0x1e, 0x11, 0xd3, 0x6f, 0xc8, 0x59, 0xe3, 0x04, 0x2c, 0x70, 0x3d, 0x6e, 0x61, 0xaf, 0x7e,
+	0xc3, 0xf5, 0xf6, 0x7d, 0xa5, 0xe1, 0xf7, 0xe0, 0xf8, 0xf0, 0x0b, 0xa9, 0xe3, 0x6b, 0x00, 0x4d,
+	0xec, 0xd5, 0x6b, 0x0f, 0xd9, 0x6c, 0x51, 0x5b, 0xc9, 0x6c, 0x4c, 0x6d, 0xeb, 0x51, 0x3d, 0x15,
+	0xe3, 0x4e, 0xf6, 0xf3, 0x67, 0xa5, 0x89, 0x6a, 0xa1, 0xa9, 0x16, 0x32, 0x4a, 0xb0, 0xc4, 0x97,
+	0xfe, 0x6e, 0x9b, 0xe9, 0x71, 0x13, 0xfb, 0xf7, 0xcd, 0x26, 0xa9, 0x77, 0x5a, 0x44, 0xc9, 0xf6,
+	0xa0, 0xd0, 0x7f, 0x87, 0x8e, 0xc3, 0xa4, 0xcb, 0x07, 0xdc, 0xa4, 0x85, 0xaa, 0x1c, 0xa1, 0xd3,
+	0x30, 0x6d, 0xba, 0x8e, 0x4f, 0xb1, 0x43, 0x6b, 0x0d, 0xec, 0x2b, 0x93, 0xa9, 0x39, 0xc6, 0xba,
+	0x09, 0x73, 0x0c, 0x64, 0x2d, 0x44, 0x27, 0x2d, 0xc6, 0x5e, 0xec, 0x0e, 0x68, 0x8d, 0xb7, 0x60,
+	0x39, 0x09, 0x94, 0xd4, 0xfb, 0x55, 0xc8, 0x09, 0xd1, 0x4a, 0xe9, 0x53, 0x51, 0xa5, 0xfb, 0xdc,
+	0x52, 0x6b, 0xc5, 0x61, 0x7c, 0x13, 0x4e, 0xab, 0x78, 0xa1, 0x1e, 0x36, 0xe9, 0x35, 0xd3, 0x24,
+	0xbe, 0xcf, 0x47, 0x6e, 0x4b, 0xea, 0x8d, 0x8a, 0x90, 0xc3, 0xf5, 0xba, 0x47, 0x7c, 0x5f, 0xea,
+	0xaa, 0x86, 0xc6, 0x7b, 0x1a, 0x18, 0xa3, 0xf8, 0x25, 0xc4, 0x1a, 0x9c, 0x30, 0x25, 0x41, 0x0d,
+	0x73, 0x8a, 0x9a, 0x29, 0x48, 0x64, 0x3c, 0xae, 0xc7, 0xc4, 0x63, 0xec, 0x8a, 0x0b, 0x66, 0xdc,
+	0xb4, 0xf1, 0xff, 0x32, 0xec, 0xaf, 0x99, 0xa6, 0xdb, 0x71, 0x68, 0x2a, 0xf0, 0xab, 0xf9, 0x0f,
+	0x3f, 0x2d, 0x4d, 0xfc, 0xeb, 0xd3, 0xd2, 0x84, 0x61, 0xc2, 0x7c, 0x98, 0x55, 0x62, 0x2e, 0x42,
+	0x6e, 0x0f, 0xb7, 0xb0, 0x63, 0x2a, 0x07, 0xab, 0x21, 0x3a, 0x05, 0x05, 0x1e, 0xe8, 0x4d, 0xec,
+	0x37, 0xb9, 0x7b, 0x0b, 0xd5, 0x3c, 0x9b, 0xb8, 0x85, 0xfd, 0x26, 0x9a, 0x87, 0xc3, 0x8e, 0xcb,
+	0x98, 0x84, 0x3f, 0xc5, 0xc0, 0x78, 0x0d, 0x4e, 0x4a, 0x33, 0x31, 0x2d, 0xbf, 0x04, 0xca, 0xf7,
+	0x35, 0xd0, 0xe3, 0x56, 0x90, 0x60, 0x57, 0xe1, 0x88, 0x30, 0x60, 0x2d, 0xbc, 0xd2, 0x8c, 0x98,
+	0xbd, 0x26, 0x26, 0x91, 0x0e, 0x79, 0x9f, 0x09, 0x65, 0xf8, 0x44, 0x5c, 0xf6, 0xc7, 0x6c, 0x09,
+	0x2c, 0x56, 0xad, 0x39, 0x1d, 0x7b, 0x8f, 0x78, 0x52, 0x83, 0x19, 0x39, 0xfb, 0x1d, 0x3e, 0x69,
+	0xbc, 0x0e, 0x8b, 0x1c, 0xc7, 0x9b, 0xb8, 0x65, 0xd5, 0x31, 0x75, 0xbd, 0x21, 0x65, 0x64, 0xf8,
+	0x0f, 0xe1, 0xe0, 0xe1, 0x7f, 0x2d, 0xa2, 0xd5, 0xc7, 0x1a, 0x2c, 0x25, 0xac, 0x26, 0x15, 0x5b,
+	0x87, 0xa3, 0x0a, 0x55, 0x78, 0x45, 0x05, 0xf6, 0x00, 0x55, 0x53, 0x41, 0xb4, 0x23, 0xfc, 0xfc,
+	0x32, 0xee, 0xb9, 0x04, 0xf3, 0x61, 0xd6, 0xb4, 0x20, 0x32, 0x5e, 0x97, 0xc2, 0xee, 0x53, 0xd7,
+	0xc3, 0x8d, 0x74, 0x61, 0x68, 0x16, 0x32, 0xfb, 0xe4, 0xb1, 0x8c, 0x37, 0xf6, 0x18, 0x10, 0x7f,
+	0x01, 0xe6, 0xc3, 0x8b, 0x49, 0xf1, 0xf3, 0x70, 0xb8, 0x8b, 0x5b, 0x1d, 0x25, 0x5c, 0x0c, 0x8c,
+	0xff, 0x83, 0x59, 0x19, 0x4a, 0xf5, 0x97, 0x52, 0x72, 0x1d, 0xe6, 0x02, 0x7c, 0x52, 0x04, 0x82,
+	0x6c, 0x7f, 0x13, 0x9c, 0xae, 0xf2, 0x67, 0x16, 0xac, 0xc5, 0x10, 0x1e, 0xec, 0x8c, 0xa3, 0xe1,
+	0x0d, 0x80, 0xc1, 0x91, 0xcb, 0x15, 0x9d, 0xda, 0x5e, 0x53, 0x1b, 0x03, 0x3b, 0x9f, 0xcb, 0xe2,
+	0x74, 0x97, 0xe7, 0x73, 0xf9, 0xde, 0xc0, 0x6e, 0xd5, 0x00, 0x67, 0x00, 0xf1, 0x2f, 0x35, 0x38,
+	0x19, 0x03, 0x44, 0x42, 0xff, 0x06, 0xe4, 0x7c, 0x31, 0x2f, 0x37, 0xce, 0x13, 0xd1, 0x5d, 0xe8,
+	0x3e, 0xc5, 0x94, 0xec, 0x14, 0xd8, 0xa6, 0xf9, 0xab, 0x7f, 0xfe, 0x76, 0x53, 0xab, 0x2a, 0x16,
+	0x74, 0x33, 0x06, 0xed, 0x7a, 0x2a, 0x5a, 0x21, 0x3a, 0x08, 0x97, 0xed, 0xa1, 0xa1, 0x1d, 0x48,
+	0x1d, 0x75, 0x43, 0xf6, 0xd0, 0xbe, 0xac, 0x3d, 0xd8, 0x7e, 0xe5, 0xef, 0x5b, 0x6d, 0x7e, 0x3a,
+	0x73, 0xa0, 0xf9, 0x6a, 0x9e, 0x4d, 0x30, 0x3f, 0x06, 0x8c, 0xf5, 0x81, 0x06, 0x70, 0x9d, 0x36,
+	0x25, 0x8a, 0x11, 0x7e, 0x0a, 0x04, 0xf5, 0xa1, 0xf0, 0xce, 0x18, 0xbb, 0xf9, 0x85, 0xf7, 0xcb,
+	0xec, 0xd0, 0x7e, 0xa9, 0xe2, 0xe7, 0x70, 0x20, 0x7e, 0x3e, 0xd3, 0xe4, 0xe9, 0x3f, 0xb0, 0x88,
+	0x74, 0xd9, 0x2e, 0xe4, 0xe5, 0x37, 0xab, 0x0e, 0xbb, 0xc5, 0xa8, 0xcf, 0x06, 0x4a, 0x04, 0x1d,
+	0xd7, 0x67, 0x3c, 0x38, 0xcf, 0x3d, 0x91, 0x39, 0xd8, 0x83, 0xde, 0x1d, 0xb7, 0xd1, 0x77, 0x1b,
+	0x82, 0x2c, 0xd7, 0x54, 0x58, 0x8d, 0x3f, 0x7f, 0x05, 0xa1, 0xfd, 0x91, 0xca, 0xf4, 0x94, 0x70,
+	0x69, 0xa1, 0x73, 0x90, 0x6d, 0xb9, 0x0d, 0x65, 0x9d, 0x85, 0xa8, 0x75, 0xee, 0xb8, 0x8d, 0x2a,
+	0x27, 0x39, 0x38, 0x3b, 0xa8, 0x5c, 0xf4, 0x1e, 0xf6, 0xb0, 0xdd, 0xcf, 0xd4, 0xaa, 0x70, 0x2c,
+	0x34, 0xdb, 0x4f, 0x57, 0x26, 0xdb, 0x7c, 0x46, 0x46, 0x74, 0x31, 0x0a, 0x51, 0x70, 0x04, 0x9d,
+	0x27, 0x59, 0x8c, 0x3f, 0x6b, 0x70, 0xe4, 0x3a, 0x6d, 0xee, 0xe2, 0x56, 0x2b, 0x60, 0x6e, 0xec,
+	0x35, 0x7c, 0xb5, 0x01, 0xb1, 0x67, 0x74, 0x02, 0x72, 0x0d, 0xec, 0xd7, 0x4c, 0xdc, 0x96, 0x67,
+	0xc1, 0x64, 0x03, 0xfb, 0xbb, 0xb8, 0x8d, 0xde, 0x82, 0xd9, 0xb6, 0xe7, 0xb6, 0x5d, 0x9f, 0x78,
+	0xfd, 0xf3, 0x84, 0xc5, 0xea, 0xf4, 0xce, 0xf6, 0xbf, 0x9f, 0x95, 0xca, 0x0d, 0x8b, 0x36, 0x3b,
+	0x7b, 0x65, 0xd3, 0xb5, 0x2b, 0x32, 0x9d, 0x17, 0xff, 0x2e, 0xfa, 0xf5, 0xfd, 0x0a, 0x7d, 0xdc,
+	0x26, 0x7e, 0x79, 0x77, 0x70, 0x90, 0x55, 0x8f, 0xaa, 0xb5, 0xe4, 0x04, 0x3a, 0x09, 0x79, 0x93,
+	0xa5, 0xd1, 0x35, 0xab, 0xce, 0x03, 0x3d, 0x53, 0xcd, 0xf1, 0xf1, 0xed, 0x3a, 0x5a, 0x84, 0x82,
+	0xdb, 0x25, 0x9e, 0x67, 0xb1, 0x3c, 0x4d, 0x04, 0xfb, 0x60, 0xc2, 0x78, 0x00, 0xc7, 0xae, 0xfb,
+	0xd4, 0xb2, 0x31, 0x65, 0x49, 0x5a, 0xdf, 0x56, 0xb3, 0x90, 0x69, 0x60, 0xa1, 0x5a, 0xb6, 0xca,
+	0x1e, 0xd9, 0x8c, 0x47, 0x28, 0xd7, 0x6a, 0xba, 0xca, 0x1e, 0x99, 0xcc, 0xae, 0x5d, 0x23, 0x9e,
+	0xe7, 0x8a, 0x63, 0xad, 0x50, 0xcd, 0x75, 0xed, 0xeb, 0x6c, 0x68, 0xfc, 0x5e, 0x83, 0xb9, 0xfb,
+	0x96, 0xdd, 0x69, 0x61, 0x4a, 0xde, 0xdc, 0x0a, 0x18, 0xcc, 0x6d, 0xd3, 0xbe, 0xc1, 0xd8, 0xf3,
+	0xd7, 0x66, 0xb0, 0x22, 0xe4, 0x58, 0x61, 0xe3, 0x76, 0xa8, 0xdc, 0x18, 0xd4, 0xd0, 0xd8, 0x00,
+	0x14, 0x84, 0x3e, 0x38, 0x6d, 0xea, 0x98, 0x62, 0x85, 0x9d, 0x3d, 0x1b, 0xbf, 0xce, 0xc0, 0x51,
+	0x16, 0x10, 0x77, 0xb1, 0xf3, 0x38, 0x70, 0xc8, 0xec, 0x75, 0x9c, 0x7a, 0x8b, 0x28, 0x35, 0xd5,
+	0x30, 0xec, 0x87, 0x43, 0x43, 0x7e, 0x08, 0xda, 0x21, 0x93, 0x6a, 0x87, 0xec, 0xc1, 0xd9, 0x61,
+	0x17, 0xa6, 0xdb, 0x1e, 0xa9, 0x13, 0x93, 0xf8, 0xbe, 0xeb, 0xb1, 0x00, 0x61, 0x5f, 0x6f, 0x29,
+	0xfa, 0x69, 0xdc, 0xf5, 0x1b, 0xd7, 0x69, 0x93, 0x78, 0xa4, 0x63, 0x3f, 0xe8, 0x55, 0x43, 0x4c,
+	0x2c, 0xf5, 0xda, 0x6b, 0xb9, 0xe6, 0xbe, 0x4a, 0x72, 0x26, 0x79, 0x04, 0x4e, 0xf1, 0x39, 0x91,
+	0xe2, 0xa0, 0x5b, 0x00, 0x82, 0x84, 0x99, 0xb9, 0x98, 0xe3, 0x1f, 0xa0, 0x5e, 0x16, 0xb5, 0x66,
+	0x59, 0xd5, 0x9a, 0xe5, 0x07, 0xaa, 0xd6, 0xdc, 0x99, 0x61, 0x9f, 0xe0, 0x27, 0x7f, 0x2b, 0x69,
+	0xe2, 0x33, 0x2c, 0x70, 0x66, 0xf6, 0x9a, 0xe5, 0x5b, 0x5d, 0x91, 0xb4, 0x91, 0x62, 0x5e, 0x9c,
+	0x29, 0x6a, 0x1c, 0xf4, 0x6a, 0x21, 0xec, 0xd5, 0x35, 0x98, 0x1d, 0xb8, 0x6a, 0x84, 0x4f, 0x3f,
+	0xca, 0xaa, 0xdd, 0xcd, 0xc3, 0x26, 0x79, 0xd0, 0x53, 0x7e, 0xdd, 0x82, 0x8c, 0xed, 0xab, 0x22,
+	0x36, 0xd5, 0x3c, 0x8c, 0x16, 0x7d, 0x0b, 0xa6, 0x59, 0xc1, 0x40, 0x6a, 0xb2, 0x00, 0xce, 0x24,
+	0x15, 0xc0, 0x5c, 0x94, 0x2c, 0x80, 0xa7, 0xe8, 0x60, 0x10, 0x71, 0x4e, 0xf6, 0x20, 0x9c, 0x73,
+	0x38, 0xea, 0x9c, 0x25, 0xe5, 0x1c, 0x7e, 0x7c, 0x4c, 0x72, 0xcb, 0x09, 0x8b, 0xf3, 0x93, 0xf2,
+	0xe0, 0x7c, 0x17, 0x17, 0xcc, 0xf9, 0xaf, 0x66, 0x17, 0x2c, 0x84, 0x77, 0x41, 0x03, 0x66, 0x84,
+	0x0e, 0xac, 0x65, 0xc0, 0xb6, 0x36, 0x08, 0x98, 0xe1, 0x2e, 0xee, 0xdd, 0xc4, 0xfe, 0xb7, 0xb3,
+	0xf9, 0x43, 0xb3, 0x99, 0x6a, 0x9e, 0xb2, 0x9e, 0x42, 0x9d, 0xf4, 0x8c, 0x4d, 0x99, 0x1e, 0xf5,
+	0x43, 0x61, 0x44, 0xdc, 0xfc, 0x2e, 0x03, 0xc7, 0x07, 0xc4, 0x3b, 0x6c, 0xd5, 0x40, 0xe8, 0xd0,
+	0x9e, 0x3a, 0x17, 0xd3, 0x43, 0x87, 0xf6, 0xfc, 0x03, 0x08, 0x9d, 0xff, 0x79, 0x7d, 0x4c, 0xaf,
+	0x1b, 0x17, 0xe1, 0x44, 0xc4, 0x71, 0x23, 0x1c, 0xfd, 0x41, 0x06, 0x16, 0x06, 0xf4, 0xff, 0xad,
+	0xf9, 0xc0, 0x70, 0x00, 0x65, 0xbf, 0x86, 0x00, 0xda, 0x7d, 0xc9, 0x00, 0xca, 0xab, 0x00, 0x0a,
+	0xc6, 0x4e, 0xd0, 0xb9, 0xf9, 0x90, 0x73, 0x8d, 0x0b, 0x70, 0x7c, 0xd8, 0x11, 0x23, 0xfc, 0xb6,
+	0xd0, 0xaf, 0xb1, 0x7d, 0x72, 0x83, 0xf4, 0x3b, 0x6b, 0x77, 0x60, 0x3e, 0x3c, 0x2d, 0x97, 0xb8,
+	0x02, 0x79, 0x96, 0x88, 0xd6, 0x1e, 0x12, 0x59, 0xc3, 0xee, 0x9c, 0xfc, 0xeb, 0xb3, 0xd2, 0x82,
+	0xb0, 0x9f, 0x5f, 0xdf, 0x2f, 0x5b, 0x6e, 0xc5, 0xc6, 0xb4, 0x59, 0xbe, 0xed, 0x50, 0x56, 0x86,
+	0x70, 0xee, 0x7e, 0x23, 0xef, 0x66, 0xcb, 0xdd, 0xc3, 0xad, 0xbb, 0x96, 0x73, 0x13, 0xfb, 0xf7,
+	0x3c, 0xab, 0x5f, 0xd2, 0x1b, 0x26, 0x2c, 0x27, 0x11, 0x48, 0xc1, 0xd7, 0x60, 0xc6, 0xb6, 0x1c,
+	0x16, 0xac, 0xb5, 0x36, 0x7b, 0x21, 0xa5, 0x2f, 0x31, 0xe3, 0x24, 0x23, 0x98, 0xb2, 0x07, 0x4b,
+	0x6d, 0xff, 0xb4, 0x08, 0x87, 0xb9, 0x14, 0xf4, 0x9e, 0x06, 0x39, 0x55, 0x56, 0xad, 0x46, 0x9d,
+	0x1f, 0xd3, 0xb9, 0xd2, 0xd7, 0xd2, 0xc8, 0x04, 0x4e, 0xe3, 0xfc, 0x8f, 0xfe, 0xf4, 0x8f, 0x9f,
+	0x1c, 0x5a, 0x45, 0x67, 0x2a, 0x91, 0xfe, 0xb3, 0xac, 0x77, 0x2a, 0x6f, 0xcb, 0xc0, 0x7e, 0x8a,
+	0x7e, 0xae, 0xc1, 0x4c, 0xa8, 0x7f, 0x84, 0xce, 0x27, 0x88, 0x89, 0xeb, 0x53, 0xe9, 0x17, 0xc6,
+	0x23, 0x96, 0xc8, 0xb6, 0x39, 0xb2, 0x0b, 0x68, 0x33, 0x8a, 0x4c, 0xb5, 0xaa, 0x22, 0x00, 0x7f,
+	0xa3, 0xc1, 0xec, 0x70, 0x2b, 0x08, 0x95, 0x13, 0xc4, 0x26, 0x74, 0xa0, 0xf4, 0xca, 0xd8, 0xf4,
+	0x12, 0xe9, 0x55, 0x8e, 0xf4, 0x0a, 0xda, 0x8e, 0x22, 0xed, 0x2a, 0x9e, 0x01, 0xd8, 0x60, 0x77,
+	0xeb, 0x29, 0x7a, 0x5f, 0x83, 0x9c, 0x6c, 0xfa, 0x24, 0xba, 0x36, 0xdc, 0x4f, 0xd2, 0xd7, 0xd2,
+	0xc8, 0x24, 0xac, 0x0b, 0x1c, 0xd6, 0x1a, 0x3a, 0x1b, 0x85, 0x25, 0xeb, 0x6d, 0x3f, 0x60, 0xba,
+	0x8f, 0x35, 0xc8, 0xc9, 0x2e, 0x47, 0x22, 0x90, 0x70, 0xaf, 0x49, 0x5f, 0x4b, 0x23, 0x93, 0x40,
+	0xb6, 0x38, 0x90, 0xf3, 0xe8, 0x5c, 0x14, 0x88, 0x6c, 0x86, 0x0c, 0x70, 0x54, 0xde, 0xde, 0x27,
+	0x8f, 0x9f, 0xa2, 0x27, 0x90, 0x65, 0xdd, 0x05, 0x64, 0x24, 0x86, 0x4c, 0xbf, 0xf5, 0xa4, 0x9f,
+	0x19, 0x49, 0x23, 0x31, 0x9c, 0xe3, 0x18, 0xce, 0xa0, 0xd3, 0x71, 0xd1, 0x54, 0x0f, 0x59, 0xe2,
+	0x67, 0x1a, 0x4c, 0x07, 0xfb, 0x3d, 0x68, 0x33, 0x45, 0xcf, 0x40, 0x77, 0x4a, 0x3f, 0x3f, 0x16,
+	0xed, 0xd8, 0x86, 0xa9, 0x79, 0x8c, 0x21, 0x00, 0xee, 0x87, 0x90, 0x57, 0x4d, 0x0d, 0x94, 0xf2,
+	0x8d, 0xab, 0x42, 0x5a, 0x5f, 0x4f, 0xa5, 0x93, 0x78, 0x0c, 0x8e, 0x67, 0x11, 0xe9, 0x89, 0x9b,
+	0x81, 0x8f, 0x7e, 0x00, 0x93, 0xa2, 0xbc, 0x46, 0x67, 0x13, 0x96, 0x0d, 0x55, 0xf1, 0xfa, 0x6a,
+	0x0a, 0x95, 0x14, 0xbd, 0xc2, 0x45, 0xeb, 0xa8, 0x18, 0x15, 0x2d, 0x4a, 0x77, 0xd4, 0x83, 0x9c,
+	0xac, 0xdc, 0xd1, 0x4a, 0x6c, 0xcf, 0x26, 0x70, 0x88, 0xeb, 0xeb, 0x69, 0xf9, 0xd9, 0x18, 0x2a,
+	0x13, 0xda, 0xac, 0x99, 0x4c, 0xdc, 0xbb, 0x30, 0x15, 0x28, 0xae, 0xc7, 0x90, 0x1e, 0xa3, 0x73,
+	0x4c, 0x75, 0x6e, 0xac, 0x71, 0xd9, 0x2b, 0x68, 0x39, 0x46, 0xb6, 0x24, 0x67, 0x07, 0x08, 0x7a,
+	0x17, 0x60, 0x50, 0xca, 0xa2, 0x98, 0x70, 0x8f, 0xd4, 0xe8, 0xfa, 0xd9, 0xd1, 0x44, 0x12, 0xc0,
+	0x2a, 0x07, 0x50, 0x42, 0x4b, 0x31, 0xf1, 0x27, 0xa9, 0x6b, 0xdd, 0x2d, 0xd4, 0x85, 0xbc, 0x2a,
+	0xba, 0xd0, 0xe9, 0xe8, 0xc2, 0x43, 0xb5, 0xb3, 0x6e, 0x8c, 0x22, 0x91, 0x92, 0xcf, 0x70, 0xc9,
+	0x4b, 0xe8, 0x54, 0xcc, 0xe7, 0x88, 0x5b, 0xad, 0x9a, 0xcd, 0x64, 0xbd, 0x03, 0x39, 0x99, 0xb3,
+	0x27, 0xee, 0x48, 0xe1, 0xf2, 0x4e, 0x5f, 0x4b, 0x23, 0x4b, 0xf7, 0xba, 0xc8, 0xb7, 0x68, 0x0f,
+	0x7d, 0xa8, 0x01, 0x0c, 0x92, 0x49, 0xb4, 0x31, 0x6a, 0xe9, 0x60, 0xa1, 0xa0, 0x9f, 0x1b, 0x83,
+	0x32, 0xdd, 0x01, 0x02, 0x07, 0xcf, 0xa0, 0xd0, 0x8f, 0x35, 0x28, 0xf4, 0xd3, 0x23, 0xb4, 0x3e,
+	0x6a, 0xfd, 0x60, 0x18, 0x6e, 0xa4, 0x13, 0x4a, 0x1c, 0x67, 0x39, 0x8e, 0x65, 0xb4, 0x98, 0x84,
+	0x83, 0x7f, 0x07, 0xef, 0xb0, 0xa3, 0x8a, 0x67, 0x48, 0x23, 0x8e, 0xaa, 0x60, 0x5a, 0xa6, 0xaf,
+	0xa5, 0x91, 0xa5, 0xfb, 0x43, 0xa5, 0x6f, 0x6c, 0xe3, 0x91, 0x39, 0xed, 0xd9, 0xc4, 0x0d, 0x3f,
+	0x70, 0x95, 0xad, 0xaf, 0xa6, 0x50, 0xa5, 0x6f, 0x3c, 0xf2, 0x12, 0x9b, 0x59, 0xbf, 0x7f, 0x5b,
+	0x9c, 0x68, 0xfd, 0xe1, 0x8b, 0x66, 0x7d, 0x23, 0x9d, 0x30, 0xdd, 0xfa, 0x83, 0x0b, 0x69, 0xf4,
+	0x99, 0x06, 0x73, 0x91, 0x4b, 0x5c, 0x94, 0x94, 0xac, 0x24, 0xdd, 0x41, 0xeb, 0x97, 0xc6, 0x67,
+	0x90, 0xf0, 0x2e, 0x72, 0x78, 0xeb, 0x68, 0x35, 0x0a, 0x4f, 0xdc, 0x02, 0xf3, 0x2c, 0xd7, 0x57,
+	0x88, 0xfe, 0xa0, 0xc1, 0x42, 0xec, 0xdd, 0x2b, 0xba, 0x9c, 0xec, 0x91, 0xc4, 0xbb, 0x63, 0xfd,
+	0xca, 0xcb, 0x31, 0x49, 0xcc, 0xaf, 0x72, 0xcc, 0xaf, 0xa0, 0xcb, 0xb1, 0x5e, 0x8d, 0xbb, 0x48,
+	0x0e, 0x9c, 0xb1, 0xbf, 0xd0, 0x60, 0x2e, 0x92, 0xd9, 0x27, 0x5a, 0x3a, 0xa9, 0x48, 0xd0, 0x2f,
+	0x8d, 0xcf, 0x20, 0x51, 0xaf, 0x73, 0xd4, 0xa7, 0x51, 0x29, 0x8a, 0x3a, 0x54, 0x4c, 0xec, 0x5c,
+	0xfd, 0xfc, 0xf9, 0xb2, 0xf6, 0xc5, 0xf3, 0x65, 0xed, 0xef, 0xcf, 0x97, 0xb5, 0x4f, 0x5e, 0x2c,
+	0x4f, 0x7c, 0xf1, 0x62, 0x79, 0xe2, 0x2f, 0x2f, 0x96, 0x27, 0xbe, 0xbf, 0x12, 0x2d, 0x39, 0xd9,
+	0x22, 0x3d, 0xb6, 0x0c, 0x2f, 0x38, 0xf7, 0x26, 0x79, 0xcd, 0x76, 0xf9, 0x3f, 0x03, 0x00, 0x52,
+	0x84, 0x28, 0xb3, 0xfc, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1820,12 +2806,20 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// StorageRange queries the storage of a single account, ordered by key.
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// Accounts queries the Ethereum accounts, ordered by address.
+	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
+	// CallMany implements the `eth_callMany` and `eth_callBundle` rpc apis
+	CallMany(ctx context.Context, in *CallManyRequest, opts ...grpc.CallOption) (*CallManyResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -1839,6 +2833,15 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// HardForks queries the EVM hard forks of the chain config, either active or
+	// scheduled, ordered by activation.
+	HardForks(ctx context.Context, in *QueryHardForksRequest, opts ...grpc.CallOption) (*QueryHardForksResponse, error)
+	// OpcodeGasSchedule queries the constant gas of the opcodes overridden by the
+	// params, along with their constant gas in the current hard fork.
+	OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error)
+	// ContractAccessControl queries the permission policies for calling a target
+	// contract and its functions.
+	ContractAccessControl(ctx context.Context, in *QueryContractAccessControlRequest, opts ...grpc.CallOption) (*QueryContractAccessControlResponse, error)
 	// GlobalMinGasPrice queries the MinGasPrice
 	// it's similar to feemarket module's method,
 	// but makes the conversion to 18 decimals
@@ -1908,6 +2911,24 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Params", in, out, opts...)
@@ -1935,6 +2956,24 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CallMany(ctx context.Context, in *CallManyRequest, opts ...grpc.CallOption) (*CallManyResponse, error) {
+	out := new(CallManyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/CallMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
@@ -1980,20 +3019,47 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
-func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error) {
-	out := new(QueryGlobalMinGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice", in, out, opts...)
+func (c *queryClient) HardForks(ctx context.Context, in *QueryHardForksRequest, opts ...grpc.CallOption) (*QueryHardForksResponse, error) {
+	out := new(QueryHardForksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/HardForks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Account queries an Ethereum account.
-	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
-	// CosmosAccount queries an Ethereum account's Cosmos Address.
+func (c *queryClient) OpcodeGasSchedule(ctx context.Context, in *QueryOpcodeGasScheduleRequest, opts ...grpc.CallOption) (*QueryOpcodeGasScheduleResponse, error) {
+	out := new(QueryOpcodeGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/OpcodeGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractAccessControl(ctx context.Context, in *QueryContractAccessControlRequest, opts ...grpc.CallOption) (*QueryContractAccessControlResponse, error) {
+	out := new(QueryContractAccessControlResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/ContractAccessControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error) {
+	out := new(QueryGlobalMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Account queries an Ethereum account.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
+	// CosmosAccount queries an Ethereum account's Cosmos Address.
 	CosmosAccount(context.Context, *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error)
 	// ValidatorAccount queries an Ethereum account's from a validator consensus
 	// Address.
@@ -2005,12 +3071,20 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// StorageRange queries the storage of a single account, ordered by key.
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// Accounts queries the Ethereum accounts, ordered by address.
+	Accounts(context.Context, *QueryAccountsRequest) (*QueryAccountsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
+	// CallMany implements the `eth_callMany` and `eth_callBundle` rpc apis
+	CallMany(context.Context, *CallManyRequest) (*CallManyResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -2024,6 +3098,15 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// HardForks queries the EVM hard forks of the chain config, either active or
+	// scheduled, ordered by activation.
+	HardForks(context.Context, *QueryHardForksRequest) (*QueryHardForksResponse, error)
+	// OpcodeGasSchedule queries the constant gas of the opcodes overridden by the
+	// params, along with their constant gas in the current hard fork.
+	OpcodeGasSchedule(context.Context, *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error)
+	// ContractAccessControl queries the permission policies for calling a target
+	// contract and its functions.
+	ContractAccessControl(context.Context, *QueryContractAccessControlRequest) (*QueryContractAccessControlResponse, error)
 	// GlobalMinGasPrice queries the MinGasPrice
 	// it's similar to feemarket module's method,
 	// but makes the conversion to 18 decimals
@@ -2053,6 +3136,12 @@ func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageR
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) StorageRange(ctx context.Context, req *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccountsRequest) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2062,6 +3151,12 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
+func (*UnimplementedQueryServer) CallMany(ctx context.Context, req *CallManyRequest) (*CallManyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallMany not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -2077,6 +3172,15 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedQueryServer) HardForks(ctx context.Context, req *QueryHardForksRequest) (*QueryHardForksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HardForks not implemented")
+}
+func (*UnimplementedQueryServer) OpcodeGasSchedule(ctx context.Context, req *QueryOpcodeGasScheduleRequest) (*QueryOpcodeGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpcodeGasSchedule not implemented")
+}
+func (*UnimplementedQueryServer) ContractAccessControl(ctx context.Context, req *QueryContractAccessControlRequest) (*QueryContractAccessControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAccessControl not implemented")
+}
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
@@ -2193,6 +3297,42 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2247,6 +3387,42 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CallMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CallMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/CallMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CallMany(ctx, req.(*CallManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2337,6 +3513,60 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HardForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHardForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HardForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/HardForks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HardForks(ctx, req.(*QueryHardForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpcodeGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpcodeGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/OpcodeGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpcodeGasSchedule(ctx, req.(*QueryOpcodeGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractAccessControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/ContractAccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAccessControl(ctx, req.(*QueryContractAccessControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGlobalMinGasPriceRequest)
 	if err := dec(in); err != nil {
@@ -2384,6 +3614,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2396,6 +3634,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
+		{
+			MethodName: "CallMany",
+			Handler:    _Query_CallMany_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2416,6 +3662,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "HardForks",
+			Handler:    _Query_HardForks_Handler,
+		},
+		{
+			MethodName: "OpcodeGasSchedule",
+			Handler:    _Query_OpcodeGasSchedule_Handler,
+		},
+		{
+			MethodName: "ContractAccessControl",
+			Handler:    _Query_ContractAccessControl_Handler,
+		},
 		{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
@@ -2468,6 +3726,21 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundQuotient != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RefundQuotient))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxInitCodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxInitCodeSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Config != nil {
 		{
 			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
@@ -2483,7 +3756,7 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHardForksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2493,27 +3766,20 @@ func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHardForksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHardForksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHardForksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2523,39 +3789,34 @@ func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHardForksResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHardForksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.HardForks) > 0 {
+		for iNdEx := len(m.HardForks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardForks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOpcodeGasScheduleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2565,27 +3826,20 @@ func (m *QueryCosmosAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOpcodeGasScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCosmosAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOpcodeGasScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *OpcodeGas) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2595,37 +3849,37 @@ func (m *QueryCosmosAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *OpcodeGas) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCosmosAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OpcodeGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AccountNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+	if m.ForkConstantGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ForkConstantGas))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+	if m.ConstantGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConstantGas))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.CosmosAddress) > 0 {
-		i -= len(m.CosmosAddress)
-		copy(dAtA[i:], m.CosmosAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
+	if len(m.Opcode) > 0 {
+		i -= len(m.Opcode)
+		copy(dAtA[i:], m.Opcode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opcode)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOpcodeGasScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2635,27 +3889,34 @@ func (m *QueryValidatorAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOpcodeGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOpcodeGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConsAddress) > 0 {
-		i -= len(m.ConsAddress)
-		copy(dAtA[i:], m.ConsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Opcodes) > 0 {
+		for iNdEx := len(m.Opcodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Opcodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractAccessControlRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2665,37 +3926,27 @@ func (m *QueryValidatorAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractAccessControlRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractAccessControlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AccountNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractAccessControlResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2705,27 +3956,32 @@ func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractAccessControlResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractAccessControlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.ContractAccessControl != nil {
+		{
+			size, err := m.ContractAccessControl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2735,27 +3991,27 @@ func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2765,34 +4021,39 @@ func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2802,27 +4063,27 @@ func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCosmosAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2832,27 +4093,37 @@ func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCosmosAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmosAddress) > 0 {
+		i -= len(m.CosmosAddress)
+		copy(dAtA[i:], m.CosmosAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2862,27 +4133,27 @@ func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2892,39 +4163,37 @@ func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2934,46 +4203,27 @@ func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2983,20 +4233,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3006,30 +4263,34 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3039,51 +4300,27 @@ func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Overrides) > 0 {
-		i -= len(m.Overrides)
-		copy(dAtA[i:], m.Overrides)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GasCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Args) > 0 {
-		i -= len(m.Args)
-		copy(dAtA[i:], m.Args)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3093,39 +4330,27 @@ func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3135,95 +4360,27 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Predecessors) > 0 {
-		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3233,27 +4390,39 @@ func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3263,56 +4432,19 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TraceConfig != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3320,12 +4452,12 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3339,7 +4471,7 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3349,27 +4481,42 @@ func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if m.SkipCode {
+		i--
+		if m.SkipCode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+func (m *EthAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3379,76 +4526,53 @@ func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x40
-	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintQuery(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.GasCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Args) > 0 {
-		i -= len(m.Args)
-		copy(dAtA[i:], m.Args)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3458,27 +4582,46 @@ func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3488,20 +4631,39 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3511,32 +4673,46 @@ func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BaseFee != nil {
+	if m.Pagination != nil {
 		{
-			size := m.BaseFee.Size()
-			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3546,12 +4722,12 @@ func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3559,7 +4735,7 @@ func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3569,22 +4745,22 @@ func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.MinGasPrice.Size()
-		i -= size
-		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
@@ -3592,525 +4768,3033 @@ func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if len(m.Timeout) > 0 {
+		i -= len(m.Timeout)
+		copy(dAtA[i:], m.Timeout)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timeout)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CallManyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *CallManyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CallManyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Timeout) > 0 {
+		i -= len(m.Timeout)
+		copy(dAtA[i:], m.Timeout)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timeout)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Validate {
+		i--
+		if m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *QueryTxLogsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	var l int
-	_ = l
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bundles) > 0 {
+		i -= len(m.Bundles)
+		copy(dAtA[i:], m.Bundles)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bundles)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CallManyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *CallManyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthCallRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CallManyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Args)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	l = len(m.Overrides)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *QueryTraceTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
 	}
 	if len(m.Predecessors) > 0 {
-		for _, e := range m.Predecessors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
-	}
-	return n
+func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Args)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCodeSize))
+	}
+	if m.MaxInitCodeSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxInitCodeSize))
+	}
+	if m.RefundQuotient != 0 {
+		n += 1 + sovQuery(uint64(m.RefundQuotient))
+	}
+	return n
+}
+
+func (m *QueryHardForksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHardForksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HardForks) > 0 {
+		for _, e := range m.HardForks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOpcodeGasScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OpcodeGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opcode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConstantGas != 0 {
+		n += 1 + sovQuery(uint64(m.ConstantGas))
+	}
+	if m.ForkConstantGas != 0 {
+		n += 1 + sovQuery(uint64(m.ForkConstantGas))
+	}
+	return n
+}
+
+func (m *QueryOpcodeGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Opcodes) > 0 {
+		for _, e := range m.Opcodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractAccessControlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractAccessControlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractAccessControl != nil {
+		l = m.ContractAccessControl.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCosmosAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SkipCode {
+		n += 2
+	}
+	return n
+}
+
+func (m *EthAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EthCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CallManyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bundles)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Validate {
+		n += 2
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CallManyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ChainConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInitCodeSize", wireType)
+			}
+			m.MaxInitCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInitCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
+			}
+			m.RefundQuotient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundQuotient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHardForksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHardForksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHardForksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHardForksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHardForksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHardForksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardForks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardForks = append(m.HardForks, HardFork{})
+			if err := m.HardForks[len(m.HardForks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpcodeGasScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpcodeGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpcodeGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpcodeGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConstantGas", wireType)
+			}
+			m.ConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkConstantGas", wireType)
+			}
+			m.ForkConstantGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForkConstantGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOpcodeGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpcodeGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opcodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opcodes = append(m.Opcodes, OpcodeGas{})
+			if err := m.Opcodes[len(m.Opcodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractAccessControlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAccessControlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAccessControlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractAccessControlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAccessControlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAccessControlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractAccessControl == nil {
+				m.ContractAccessControl = &ContractAccessControl{}
+			}
+			if err := m.ContractAccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,12 +7817,44 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4160,7 +7876,7 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4183,17 +7899,17 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4203,27 +7919,55 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ChainConfig{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4246,7 +7990,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,15 +8013,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4305,7 +8049,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4328,7 +8072,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,15 +8095,15 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4387,13 +8131,63 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4403,43 +8197,26 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4461,7 +8238,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4484,17 +8261,49 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4504,23 +8313,27 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4543,7 +8356,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4566,17 +8379,17 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4586,29 +8399,31 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4618,30 +8433,28 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AccountNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4663,7 +8476,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4686,17 +8499,17 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4706,24 +8519,48 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4745,7 +8582,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *EthAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4768,15 +8605,15 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4804,13 +8641,13 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4820,16 +8657,29 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.AccountNumber = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4839,11 +8689,77 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4865,7 +8781,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4875,30 +8791,64 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Accounts = append(m.Accounts, EthAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4908,23 +8858,27 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4947,7 +8901,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4970,15 +8924,15 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5006,7 +8960,43 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5029,7 +9019,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5052,17 +9042,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5072,29 +9062,31 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5104,23 +9096,27 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5143,7 +9139,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5166,17 +9162,67 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5186,23 +9232,24 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5225,7 +9272,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5248,17 +9295,70 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5268,77 +9368,48 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5365,9 +9436,9 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5391,7 +9462,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5414,17 +9485,36 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5434,29 +9524,31 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5466,27 +9558,23 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5509,7 +9597,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5532,17 +9620,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5552,31 +9640,50 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5586,78 +9693,58 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5679,7 +9766,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5702,17 +9789,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5722,23 +9809,24 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5762,7 +9850,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *CallManyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5785,15 +9873,15 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallManyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallManyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundles", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5820,12 +9908,46 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
+			m.Bundles = append(m.Bundles[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bundles == nil {
+				m.Bundles = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
@@ -5844,7 +9966,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5878,11 +10000,45 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.ChainId = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5892,16 +10048,16 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.BlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5911,25 +10067,76 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
-			if m.Overrides == nil {
-				m.Overrides = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Validate = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5952,7 +10159,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *CallManyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5975,34 +10182,15 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallManyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallManyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6029,42 +10217,10 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
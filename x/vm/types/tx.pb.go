@@ -357,6 +357,206 @@ func (m *MsgRegisterPreinstallsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterPreinstallsResponse proto.InternalMessageInfo
 
+// MsgScheduleHardFork defines a Msg for scheduling the activation of an EVM
+// hard fork.
+type MsgScheduleHardFork struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name of the hard fork, as the chain config field without its block or time
+	// suffix (e.g. "osaka").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// activation is the block height or, for the time based hard forks, the
+	// block timestamp from which the hard fork is active. It must be in the
+	// future.
+	Activation uint64 `protobuf:"varint,3,opt,name=activation,proto3" json:"activation,omitempty"`
+}
+
+func (m *MsgScheduleHardFork) Reset()         { *m = MsgScheduleHardFork{} }
+func (m *MsgScheduleHardFork) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleHardFork) ProtoMessage()    {}
+func (*MsgScheduleHardFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{7}
+}
+func (m *MsgScheduleHardFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleHardFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleHardFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleHardFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleHardFork.Merge(m, src)
+}
+func (m *MsgScheduleHardFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleHardFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleHardFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleHardFork proto.InternalMessageInfo
+
+func (m *MsgScheduleHardFork) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleHardFork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgScheduleHardFork) GetActivation() uint64 {
+	if m != nil {
+		return m.Activation
+	}
+	return 0
+}
+
+// MsgScheduleHardForkResponse defines the response structure for executing a
+// MsgScheduleHardFork message.
+type MsgScheduleHardForkResponse struct {
+}
+
+func (m *MsgScheduleHardForkResponse) Reset()         { *m = MsgScheduleHardForkResponse{} }
+func (m *MsgScheduleHardForkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleHardForkResponse) ProtoMessage()    {}
+func (*MsgScheduleHardForkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{8}
+}
+func (m *MsgScheduleHardForkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleHardForkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleHardForkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleHardForkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleHardForkResponse.Merge(m, src)
+}
+func (m *MsgScheduleHardForkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleHardForkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleHardForkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleHardForkResponse proto.InternalMessageInfo
+
+// MsgUpdateChainConfig defines a Msg for replacing the EVM chain config.
+type MsgUpdateChainConfig struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// config defines the chain config to store. The chain ID must match the
+	// current one, while the denom and the decimals are ignored.
+	Config ChainConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateChainConfig) Reset()         { *m = MsgUpdateChainConfig{} }
+func (m *MsgUpdateChainConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfig) ProtoMessage()    {}
+func (*MsgUpdateChainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{9}
+}
+func (m *MsgUpdateChainConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfig.Merge(m, src)
+}
+func (m *MsgUpdateChainConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateChainConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateChainConfig) GetConfig() ChainConfig {
+	if m != nil {
+		return m.Config
+	}
+	return ChainConfig{}
+}
+
+// MsgUpdateChainConfigResponse defines the response structure for executing a
+// MsgUpdateChainConfig message.
+type MsgUpdateChainConfigResponse struct {
+}
+
+func (m *MsgUpdateChainConfigResponse) Reset()         { *m = MsgUpdateChainConfigResponse{} }
+func (m *MsgUpdateChainConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateChainConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateChainConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{10}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateChainConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateChainConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateChainConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateChainConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateChainConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "cosmos.evm.vm.v1.MsgEthereumTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "cosmos.evm.vm.v1.ExtensionOptionsEthereumTx")
@@ -365,58 +565,72 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRegisterPreinstalls)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstalls")
 	proto.RegisterType((*MsgRegisterPreinstallsResponse)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstallsResponse")
+	proto.RegisterType((*MsgScheduleHardFork)(nil), "cosmos.evm.vm.v1.MsgScheduleHardFork")
+	proto.RegisterType((*MsgScheduleHardForkResponse)(nil), "cosmos.evm.vm.v1.MsgScheduleHardForkResponse")
+	proto.RegisterType((*MsgUpdateChainConfig)(nil), "cosmos.evm.vm.v1.MsgUpdateChainConfig")
+	proto.RegisterType((*MsgUpdateChainConfigResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateChainConfigResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/tx.proto", fileDescriptor_77a8ac5e8c9c4850) }
 
 var fileDescriptor_77a8ac5e8c9c4850 = []byte{
-	// 736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0x26, 0xdb, 0x36, 0x99, 0x56, 0x1b, 0xd7, 0xd6, 0x6e, 0x97, 0x36, 0x49, 0x17, 0xb5,
-	0x69, 0xc1, 0xac, 0x8d, 0x20, 0x18, 0x4f, 0x06, 0x8a, 0x1a, 0x0c, 0x96, 0xb5, 0xbd, 0x88, 0x10,
-	0xa6, 0xcd, 0x38, 0x59, 0xcc, 0xec, 0xac, 0x3b, 0x93, 0x98, 0x1e, 0x04, 0x29, 0x1e, 0xc4, 0x83,
-	0x08, 0xfe, 0x01, 0x8f, 0x1e, 0x7b, 0xf0, 0xe4, 0x2f, 0xe8, 0xb1, 0x28, 0x88, 0x88, 0x14, 0x69,
-	0x85, 0xfe, 0x0d, 0x99, 0xd9, 0x4d, 0x93, 0x34, 0x0b, 0x15, 0x61, 0x59, 0x66, 0xdf, 0xe7, 0x79,
-	0x9f, 0xf7, 0x73, 0x16, 0xcc, 0x6e, 0x51, 0x46, 0x28, 0xb3, 0x50, 0x9b, 0x58, 0xe2, 0x59, 0xb1,
-	0x78, 0xa7, 0xe0, 0xf9, 0x94, 0x53, 0x2d, 0x1d, 0x40, 0x05, 0xd4, 0x26, 0x05, 0xf1, 0xac, 0x18,
-	0x17, 0x20, 0x71, 0x5c, 0x6a, 0xc9, 0x77, 0x40, 0x32, 0x8c, 0x21, 0x7f, 0x41, 0x0f, 0xb0, 0x99,
-	0x10, 0x23, 0x0c, 0x0b, 0x80, 0x30, 0x1c, 0x02, 0x61, 0xd0, 0x9a, 0xfc, 0xb2, 0xc2, 0x30, 0x01,
-	0x34, 0x85, 0x29, 0xa6, 0x81, 0x5d, 0x9c, 0x42, 0xeb, 0x1c, 0xa6, 0x14, 0x37, 0x91, 0x05, 0x3d,
-	0xc7, 0x82, 0xae, 0x4b, 0x39, 0xe4, 0x0e, 0x75, 0x43, 0x1f, 0xf3, 0xb5, 0x02, 0xce, 0x55, 0x19,
-	0x5e, 0xe5, 0x0d, 0xe4, 0xa3, 0x16, 0x59, 0xef, 0x68, 0x1a, 0x50, 0x9f, 0xfa, 0x94, 0xe8, 0x23,
-	0x39, 0x25, 0x3f, 0x61, 0xcb, 0xb3, 0x76, 0x19, 0x24, 0x7c, 0xf8, 0x42, 0x1f, 0x15, 0xa6, 0xb2,
-	0xb6, 0x77, 0x90, 0x8d, 0xfd, 0x3c, 0xc8, 0x82, 0x9e, 0x93, 0x2d, 0xe0, 0xd2, 0xc2, 0x9b, 0x8f,
-	0xd9, 0xd8, 0xdb, 0xe3, 0xdd, 0x65, 0xbd, 0xaf, 0xb0, 0x01, 0xf1, 0x8a, 0x9a, 0x54, 0xd2, 0xf1,
-	0x8a, 0x9a, 0x8c, 0xa7, 0x13, 0x15, 0x35, 0x99, 0x48, 0xab, 0x15, 0x35, 0xa9, 0xa6, 0x47, 0x4c,
-	0x13, 0x18, 0xab, 0x1d, 0x8e, 0x5c, 0xe6, 0x50, 0xf7, 0xa1, 0x27, 0x13, 0xec, 0x79, 0x95, 0x54,
-	0x21, 0x6c, 0xbe, 0x8b, 0x83, 0xe9, 0x01, 0x35, 0x1b, 0x31, 0x8f, 0xba, 0x0c, 0x89, 0x94, 0x1b,
-	0x90, 0x35, 0x74, 0x25, 0xa7, 0xe4, 0x53, 0xb6, 0x3c, 0x6b, 0x4b, 0x40, 0x6d, 0x52, 0xcc, 0xf4,
-	0x78, 0x2e, 0x91, 0x1f, 0x2f, 0x4e, 0x17, 0x4e, 0x0f, 0xa4, 0xf0, 0x80, 0x62, 0x5b, 0x52, 0xb4,
-	0x34, 0x48, 0xf8, 0x88, 0xeb, 0x09, 0x59, 0xb0, 0x38, 0x6a, 0xb3, 0x20, 0xd9, 0x26, 0x35, 0xe4,
-	0xfb, 0xd4, 0xd7, 0x55, 0x29, 0x3a, 0xd6, 0x26, 0xab, 0xe2, 0x53, 0x40, 0x18, 0xb2, 0x5a, 0x8b,
-	0xa1, 0xba, 0x6c, 0x91, 0x6a, 0x8f, 0x61, 0xc8, 0x36, 0x18, 0xaa, 0x6b, 0x39, 0x30, 0x41, 0x60,
-	0x47, 0x42, 0x35, 0x0c, 0x99, 0x6c, 0x97, 0x6a, 0x03, 0x02, 0x3b, 0x02, 0xbe, 0x0b, 0x99, 0x36,
-	0x0f, 0xc0, 0x66, 0x93, 0x6e, 0x3d, 0xab, 0xc9, 0x74, 0xc7, 0x64, 0xc0, 0x94, 0xb4, 0xdc, 0x13,
-	0x39, 0x2f, 0x82, 0xc9, 0x00, 0xe6, 0x0e, 0x41, 0x8c, 0x43, 0xe2, 0xe9, 0x49, 0xa9, 0x71, 0x5e,
-	0x9a, 0xd7, 0xbb, 0xd6, 0xb0, 0x21, 0x5f, 0x14, 0x30, 0x59, 0x65, 0x78, 0xc3, 0xab, 0x43, 0x8e,
-	0xd6, 0xa0, 0x0f, 0x09, 0xd3, 0x6e, 0x82, 0x14, 0x6c, 0xf1, 0x06, 0xf5, 0x1d, 0xbe, 0x1d, 0xf4,
-	0xa3, 0xac, 0x7f, 0xfd, 0x7c, 0x6d, 0x2a, 0x2c, 0xff, 0x4e, 0xbd, 0xee, 0x23, 0xc6, 0x1e, 0x71,
-	0xdf, 0x71, 0xb1, 0xdd, 0xa3, 0x6a, 0xb7, 0xc1, 0xa8, 0x27, 0x15, 0xf4, 0x78, 0x4e, 0xc9, 0x8f,
-	0x17, 0xf5, 0xe1, 0x86, 0x05, 0x11, 0xca, 0x29, 0x31, 0xfe, 0x4f, 0xc7, 0xbb, 0xcb, 0x8a, 0x1d,
-	0xba, 0x94, 0x8a, 0x3b, 0xc7, 0xbb, 0xcb, 0x3d, 0x31, 0xb1, 0x02, 0xd9, 0xbe, 0x15, 0xe8, 0x58,
-	0xc1, 0x1e, 0xf4, 0x27, 0x6a, 0xce, 0x82, 0x99, 0x53, 0xa6, 0xee, 0x38, 0xcd, 0xef, 0x0a, 0xb8,
-	0x54, 0x65, 0xd8, 0x46, 0xd8, 0x61, 0x1c, 0xf9, 0x6b, 0x3e, 0x72, 0x5c, 0xc6, 0x61, 0xb3, 0xf9,
-	0xff, 0xe5, 0xdd, 0x07, 0xe3, 0x5e, 0x4f, 0x26, 0x5c, 0x8a, 0xb9, 0x88, 0x1a, 0x4f, 0x48, 0xfd,
-	0x75, 0xf6, 0xfb, 0x96, 0x6e, 0x0d, 0x17, 0x7b, 0x35, 0xa2, 0xd8, 0x88, 0xec, 0xcd, 0x1c, 0xc8,
-	0x44, 0x23, 0xdd, 0xd2, 0x8b, 0xbf, 0xe2, 0x20, 0x51, 0x65, 0x58, 0x7b, 0x09, 0xfa, 0x6e, 0x97,
-	0x96, 0x1d, 0x4e, 0x74, 0xe0, 0x22, 0x18, 0x8b, 0x67, 0x10, 0x4e, 0x5a, 0x7b, 0x65, 0xe7, 0xdb,
-	0x9f, 0x0f, 0xf1, 0xac, 0x39, 0x6f, 0x0d, 0xff, 0x7b, 0x42, 0x76, 0x8d, 0x77, 0xb4, 0x27, 0x60,
-	0x62, 0x60, 0xab, 0x16, 0x22, 0xf5, 0xfb, 0x29, 0xc6, 0xd2, 0x99, 0x94, 0x93, 0xeb, 0xfa, 0x1c,
-	0x5c, 0x8c, 0x9a, 0x6d, 0x3e, 0x52, 0x21, 0x82, 0x69, 0x5c, 0xff, 0x57, 0x66, 0x37, 0xa4, 0x31,
-	0xf2, 0x4a, 0x0c, 0xb2, 0x5c, 0xda, 0x3b, 0xcc, 0x28, 0xfb, 0x87, 0x19, 0xe5, 0xf7, 0x61, 0x46,
-	0x79, 0x7f, 0x94, 0x89, 0xed, 0x1f, 0x65, 0x62, 0x3f, 0x8e, 0x32, 0xb1, 0xc7, 0x39, 0xec, 0xf0,
-	0x46, 0x6b, 0xb3, 0xb0, 0x45, 0x89, 0x75, 0x7a, 0x9a, 0x7c, 0xdb, 0x43, 0x6c, 0x73, 0x54, 0xfe,
-	0x30, 0x6f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xb8, 0x53, 0x9d, 0xf6, 0x05, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0x8f, 0x13, 0xb7, 0x4d, 0x4e, 0x0b, 0xcb, 0xbc, 0x8e, 0xb9, 0xa6, 0x75, 0x32, 0xb3, 0x3f,
+	0x59, 0xa5, 0xc5, 0xac, 0x48, 0x43, 0x84, 0x17, 0xe8, 0x54, 0x18, 0x15, 0x11, 0x93, 0xb7, 0xbd,
+	0x20, 0xa4, 0xe8, 0x36, 0xb9, 0xbb, 0xb1, 0x9a, 0xeb, 0x6b, 0x7c, 0x6f, 0x42, 0xf6, 0x80, 0x84,
+	0x26, 0x1e, 0x10, 0x0f, 0x08, 0x89, 0x2f, 0xc0, 0x23, 0x8f, 0x95, 0xe0, 0x05, 0x3e, 0xc1, 0x78,
+	0x9b, 0x40, 0x42, 0x88, 0x87, 0x09, 0xb5, 0x48, 0xfd, 0x1a, 0xe8, 0x5e, 0xbb, 0x89, 0x13, 0x1b,
+	0x56, 0x55, 0x8a, 0xa2, 0xeb, 0x73, 0x7e, 0xe7, 0x77, 0xce, 0xef, 0x9c, 0xe3, 0x6b, 0x58, 0xeb,
+	0x32, 0x4e, 0x19, 0x77, 0xf1, 0x88, 0xba, 0xf2, 0x77, 0xcb, 0x15, 0xe3, 0x66, 0x18, 0x31, 0xc1,
+	0x8c, 0x6a, 0xec, 0x6a, 0xe2, 0x11, 0x6d, 0xca, 0xdf, 0x2d, 0xeb, 0x3c, 0xa2, 0x7e, 0xc0, 0x5c,
+	0xf5, 0x1f, 0x83, 0x2c, 0x2b, 0x13, 0x2f, 0xe1, 0xb1, 0xef, 0x52, 0xe2, 0xa3, 0x9c, 0x48, 0x07,
+	0xe5, 0x24, 0x71, 0x24, 0x49, 0x3b, 0xea, 0xc9, 0x4d, 0xd2, 0xc4, 0xae, 0x55, 0xc2, 0x08, 0x8b,
+	0xed, 0xf2, 0x94, 0x58, 0xd7, 0x09, 0x63, 0x64, 0x80, 0x5d, 0x14, 0xfa, 0x2e, 0x0a, 0x02, 0x26,
+	0x90, 0xf0, 0x59, 0x90, 0xc4, 0x38, 0x5f, 0x6a, 0xf0, 0x52, 0x9b, 0x93, 0x1d, 0xd1, 0xc7, 0x11,
+	0x1e, 0xd2, 0x07, 0x63, 0xc3, 0x00, 0xfd, 0x51, 0xc4, 0xa8, 0xb9, 0x50, 0xd7, 0x1a, 0x2b, 0x9e,
+	0x3a, 0x1b, 0x57, 0xa0, 0x14, 0xa1, 0xcf, 0xcc, 0x45, 0x69, 0xda, 0x36, 0x9e, 0x3e, 0xaf, 0x15,
+	0xfe, 0x7a, 0x5e, 0x83, 0x69, 0x90, 0x27, 0xdd, 0xad, 0xcb, 0x5f, 0x7d, 0x5f, 0x2b, 0x7c, 0x7d,
+	0x7c, 0xb0, 0x69, 0xa6, 0x84, 0xcd, 0x90, 0xef, 0xea, 0x65, 0xad, 0x5a, 0xdc, 0xd5, 0xcb, 0xc5,
+	0x6a, 0x69, 0x57, 0x2f, 0x97, 0xaa, 0xfa, 0xae, 0x5e, 0xd6, 0xab, 0x0b, 0x8e, 0x03, 0xd6, 0xce,
+	0x58, 0xe0, 0x80, 0xfb, 0x2c, 0xf8, 0x28, 0x54, 0x05, 0x4e, 0xa3, 0x5a, 0xba, 0x24, 0x76, 0xbe,
+	0x29, 0xc2, 0xc5, 0x19, 0x36, 0x0f, 0xf3, 0x90, 0x05, 0x1c, 0xcb, 0x92, 0xfb, 0x88, 0xf7, 0x4d,
+	0xad, 0xae, 0x35, 0x2a, 0x9e, 0x3a, 0x1b, 0x37, 0x40, 0x1f, 0x30, 0xc2, 0xcd, 0x62, 0xbd, 0xd4,
+	0x58, 0xde, 0xba, 0xd8, 0x9c, 0x1f, 0x48, 0xf3, 0x43, 0x46, 0x3c, 0x05, 0x31, 0xaa, 0x50, 0x8a,
+	0xb0, 0x30, 0x4b, 0x4a, 0xb0, 0x3c, 0x1a, 0x6b, 0x50, 0x1e, 0xd1, 0x0e, 0x8e, 0x22, 0x16, 0x99,
+	0xba, 0x22, 0x5d, 0x1a, 0xd1, 0x1d, 0xf9, 0x28, 0x5d, 0x04, 0xf1, 0xce, 0x90, 0xe3, 0x9e, 0x6a,
+	0x91, 0xee, 0x2d, 0x11, 0xc4, 0x1f, 0x72, 0xdc, 0x33, 0xea, 0xb0, 0x42, 0xd1, 0x58, 0xb9, 0x3a,
+	0x04, 0x71, 0xd5, 0x2e, 0xdd, 0x03, 0x8a, 0xc6, 0xd2, 0xfd, 0x3e, 0xe2, 0xc6, 0x06, 0xc0, 0xde,
+	0x80, 0x75, 0xf7, 0x3b, 0xaa, 0xdc, 0x25, 0x95, 0xb0, 0xa2, 0x2c, 0x77, 0x65, 0xcd, 0xd7, 0xe1,
+	0x5c, 0xec, 0x16, 0x3e, 0xc5, 0x5c, 0x20, 0x1a, 0x9a, 0x65, 0xc5, 0xf1, 0xb2, 0x32, 0x3f, 0x38,
+	0xb1, 0x26, 0x0d, 0xf9, 0x45, 0x83, 0x73, 0x6d, 0x4e, 0x1e, 0x86, 0x3d, 0x24, 0xf0, 0x3d, 0x14,
+	0x21, 0xca, 0x8d, 0xdb, 0x50, 0x41, 0x43, 0xd1, 0x67, 0x91, 0x2f, 0x1e, 0xc7, 0xfd, 0xd8, 0x36,
+	0x7f, 0xfb, 0xe9, 0xe6, 0x6a, 0x22, 0xff, 0xdd, 0x5e, 0x2f, 0xc2, 0x9c, 0xdf, 0x17, 0x91, 0x1f,
+	0x10, 0x6f, 0x0a, 0x35, 0xde, 0x86, 0xc5, 0x50, 0x31, 0x98, 0xc5, 0xba, 0xd6, 0x58, 0xde, 0x32,
+	0xb3, 0x0d, 0x8b, 0x33, 0x6c, 0x57, 0xe4, 0xf8, 0x7f, 0x38, 0x3e, 0xd8, 0xd4, 0xbc, 0x24, 0xa4,
+	0xb5, 0xf5, 0xe4, 0xf8, 0x60, 0x73, 0x4a, 0x26, 0x57, 0xa0, 0x96, 0x5a, 0x81, 0xb1, 0x1b, 0xef,
+	0x41, 0xba, 0x50, 0x67, 0x0d, 0x2e, 0xcd, 0x99, 0x4e, 0xc6, 0xe9, 0xfc, 0xa1, 0xc1, 0x2b, 0x6d,
+	0x4e, 0x3c, 0x4c, 0x7c, 0x2e, 0x70, 0x74, 0x2f, 0xc2, 0x7e, 0xc0, 0x05, 0x1a, 0x0c, 0xce, 0x2e,
+	0xef, 0x03, 0x58, 0x0e, 0xa7, 0x34, 0xc9, 0x52, 0xac, 0xe7, 0x68, 0x9c, 0x80, 0xd2, 0x3a, 0xd3,
+	0xb1, 0xad, 0xb7, 0xb2, 0x62, 0xaf, 0xe5, 0x88, 0xcd, 0xa9, 0xde, 0xa9, 0x83, 0x9d, 0xef, 0x99,
+	0x48, 0xff, 0x59, 0x83, 0x0b, 0x6d, 0x4e, 0xee, 0x77, 0xfb, 0xb8, 0x37, 0x1c, 0xe0, 0xbb, 0x28,
+	0xea, 0xbd, 0xc7, 0xa2, 0xfd, 0x33, 0xeb, 0x36, 0x40, 0x0f, 0x10, 0xc5, 0x6a, 0xa8, 0x15, 0x4f,
+	0x9d, 0x0d, 0x1b, 0x00, 0x75, 0x85, 0x3f, 0x52, 0xf7, 0x80, 0xda, 0x7a, 0xdd, 0x4b, 0x59, 0x5a,
+	0xb7, 0xb3, 0x02, 0x5f, 0xcb, 0x11, 0x38, 0x5f, 0xa3, 0xb3, 0x01, 0xaf, 0xe6, 0x98, 0x27, 0xd2,
+	0x7e, 0xd5, 0x60, 0x75, 0x32, 0xf1, 0x3b, 0x7d, 0xe4, 0x07, 0x77, 0x58, 0xf0, 0xc8, 0x27, 0x67,
+	0xd6, 0xf6, 0x0e, 0x2c, 0x76, 0x15, 0x43, 0xb2, 0xb2, 0x1b, 0xd9, 0x71, 0xa6, 0xd2, 0xcc, 0xec,
+	0x6d, 0x1c, 0xd7, 0x7a, 0x33, 0xab, 0xf4, 0xca, 0x7f, 0xee, 0x6d, 0x8a, 0xcb, 0xb1, 0x61, 0x3d,
+	0xcf, 0x7e, 0xa2, 0x75, 0xeb, 0x47, 0x1d, 0x4a, 0x6d, 0x4e, 0x8c, 0xcf, 0x21, 0x75, 0x49, 0x1a,
+	0xb5, 0x6c, 0x81, 0x33, 0xf7, 0x99, 0x75, 0xfd, 0x05, 0x80, 0x49, 0x2f, 0xaf, 0x3e, 0xf9, 0xfd,
+	0x9f, 0xef, 0x8a, 0x35, 0x67, 0xc3, 0xcd, 0x7e, 0x42, 0x12, 0x74, 0x47, 0x8c, 0x8d, 0x4f, 0x60,
+	0x65, 0xe6, 0x72, 0xb8, 0x9c, 0xcb, 0x9f, 0x86, 0x58, 0x37, 0x5e, 0x08, 0x99, 0xdc, 0xba, 0x9f,
+	0xc2, 0x85, 0xbc, 0x57, 0xb4, 0x91, 0xcb, 0x90, 0x83, 0xb4, 0x5e, 0x3f, 0x2d, 0x72, 0x92, 0xb2,
+	0x0f, 0xd5, 0xcc, 0xab, 0x71, 0x35, 0x97, 0x65, 0x1e, 0x66, 0xdd, 0x3c, 0x15, 0x6c, 0x92, 0x69,
+	0x1f, 0xce, 0x67, 0x37, 0xf5, 0xda, 0xff, 0x34, 0x27, 0x85, 0xb3, 0x9a, 0xa7, 0xc3, 0x9d, 0x24,
+	0xb3, 0x16, 0xbe, 0x90, 0x6b, 0xb9, 0xdd, 0x7a, 0x7a, 0x68, 0x6b, 0xcf, 0x0e, 0x6d, 0xed, 0xef,
+	0x43, 0x5b, 0xfb, 0xf6, 0xc8, 0x2e, 0x3c, 0x3b, 0xb2, 0x0b, 0x7f, 0x1e, 0xd9, 0x85, 0x8f, 0xeb,
+	0xc4, 0x17, 0xfd, 0xe1, 0x5e, 0xb3, 0xcb, 0xa8, 0x3b, 0xbf, 0xa0, 0xe2, 0x71, 0x88, 0xf9, 0xde,
+	0xa2, 0xfa, 0x9c, 0xbf, 0xf1, 0xef, 0x00, 0xa9, 0x5a, 0xf1, 0xf8, 0x94, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,6 +655,14 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleHardFork defines a governance operation for scheduling the
+	// activation of an EVM hard fork in the chain config. The authority is the
+	// same as is used for Params updates.
+	ScheduleHardFork(ctx context.Context, in *MsgScheduleHardFork, opts ...grpc.CallOption) (*MsgScheduleHardForkResponse, error)
+	// UpdateChainConfig defines a governance operation for replacing the EVM
+	// chain config. Only the hard forks that are not active yet can be changed.
+	// The authority is the same as is used for Params updates.
+	UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error)
 }
 
 type msgClient struct {
@@ -478,6 +700,24 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) ScheduleHardFork(ctx context.Context, in *MsgScheduleHardFork, opts ...grpc.CallOption) (*MsgScheduleHardForkResponse, error) {
+	out := new(MsgScheduleHardForkResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/ScheduleHardFork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateChainConfig(ctx context.Context, in *MsgUpdateChainConfig, opts ...grpc.CallOption) (*MsgUpdateChainConfigResponse, error) {
+	out := new(MsgUpdateChainConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/UpdateChainConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -490,6 +730,14 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// ScheduleHardFork defines a governance operation for scheduling the
+	// activation of an EVM hard fork in the chain config. The authority is the
+	// same as is used for Params updates.
+	ScheduleHardFork(context.Context, *MsgScheduleHardFork) (*MsgScheduleHardForkResponse, error)
+	// UpdateChainConfig defines a governance operation for replacing the EVM
+	// chain config. Only the hard forks that are not active yet can be changed.
+	// The authority is the same as is used for Params updates.
+	UpdateChainConfig(context.Context, *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -505,6 +753,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RegisterPreinstalls(ctx context.Context, req *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleHardFork(ctx context.Context, req *MsgScheduleHardFork) (*MsgScheduleHardForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleHardFork not implemented")
+}
+func (*UnimplementedMsgServer) UpdateChainConfig(ctx context.Context, req *MsgUpdateChainConfig) (*MsgUpdateChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChainConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -564,6 +818,42 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleHardFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleHardFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleHardFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/ScheduleHardFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleHardFork(ctx, req.(*MsgScheduleHardFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateChainConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/UpdateChainConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateChainConfig(ctx, req.(*MsgUpdateChainConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Msg",
@@ -581,6 +871,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "ScheduleHardFork",
+			Handler:    _Msg_ScheduleHardFork_Handler,
+		},
+		{
+			MethodName: "UpdateChainConfig",
+			Handler:    _Msg_UpdateChainConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -859,67 +1157,195 @@ func (m *MsgRegisterPreinstallsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleHardFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleHardFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleHardFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Activation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Activation))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Raw.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleHardForkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleHardForkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleHardForkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEthereumTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateChainConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateChainConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Raw.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEthereumTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
 	}
 	if m.MaxUsedGas != 0 {
 		n += 1 + sovTx(uint64(m.MaxUsedGas))
@@ -986,6 +1412,59 @@ func (m *MsgRegisterPreinstallsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleHardFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Activation != 0 {
+		n += 1 + sovTx(uint64(m.Activation))
+	}
+	return n
+}
+
+func (m *MsgScheduleHardForkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateChainConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1109,7 +1588,445 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsedGas", wireType)
+			}
+			m.MaxUsedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUsedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			m.BlockTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1132,10 +2049,10 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1159,7 +2076,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,15 +2099,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPreinstalls: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,11 +2135,11 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1249,168 +2166,61 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Preinstalls = append(m.Preinstalls, Preinstall{})
+			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUsedGas", wireType)
-			}
-			m.MaxUsedGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxUsedGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
-			}
-			m.BlockTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1432,7 +2242,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleHardFork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1455,10 +2265,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleHardFork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleHardFork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1495,9 +2305,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1507,25 +2317,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activation", wireType)
+			}
+			m.Activation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Activation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1547,7 +2375,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleHardForkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1570,10 +2398,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleHardForkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleHardForkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1597,7 +2425,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1620,10 +2448,10 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPreinstalls: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPreinstalls: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1660,7 +2488,7 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preinstalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1687,8 +2515,7 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Preinstalls = append(m.Preinstalls, Preinstall{})
-			if err := m.Preinstalls[len(m.Preinstalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1713,7 +2540,7 @@ func (m *MsgRegisterPreinstalls) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateChainConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,10 +2563,10 @@ func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPreinstallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateChainConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23,6 +23,7 @@ import (
 	types2 "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	types4 "github.com/cosmos/evm/x/feemarket/types"
+	types6 "github.com/cosmos/evm/x/vm/types"
 	common "github.com/ethereum/go-ethereum/common"
 	core "github.com/ethereum/go-ethereum/core"
 	types5 "github.com/ethereum/go-ethereum/core/types"
@@ -665,6 +666,120 @@ func (mr *MockEvmHooksMockRecorder) PostTxProcessing(ctx, sender, msg, receipt a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostTxProcessing", reflect.TypeOf((*MockEvmHooks)(nil).PostTxProcessing), ctx, sender, msg, receipt)
 }
 
+// MockPreTxHooks is a mock of PreTxHooks interface.
+type MockPreTxHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreTxHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockPreTxHooksMockRecorder is the mock recorder for MockPreTxHooks.
+type MockPreTxHooksMockRecorder struct {
+	mock *MockPreTxHooks
+}
+
+// NewMockPreTxHooks creates a new mock instance.
+func NewMockPreTxHooks(ctrl *gomock.Controller) *MockPreTxHooks {
+	mock := &MockPreTxHooks{ctrl: ctrl}
+	mock.recorder = &MockPreTxHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreTxHooks) EXPECT() *MockPreTxHooksMockRecorder {
+	return m.recorder
+}
+
+// PreTxProcessing mocks base method.
+func (m *MockPreTxHooks) PreTxProcessing(ctx types.Context, msg core.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreTxProcessing", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PreTxProcessing indicates an expected call of PreTxProcessing.
+func (mr *MockPreTxHooksMockRecorder) PreTxProcessing(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreTxProcessing", reflect.TypeOf((*MockPreTxHooks)(nil).PreTxProcessing), ctx, msg)
+}
+
+// MockPostCallHooks is a mock of PostCallHooks interface.
+type MockPostCallHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockPostCallHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockPostCallHooksMockRecorder is the mock recorder for MockPostCallHooks.
+type MockPostCallHooksMockRecorder struct {
+	mock *MockPostCallHooks
+}
+
+// NewMockPostCallHooks creates a new mock instance.
+func NewMockPostCallHooks(ctrl *gomock.Controller) *MockPostCallHooks {
+	mock := &MockPostCallHooks{ctrl: ctrl}
+	mock.recorder = &MockPostCallHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPostCallHooks) EXPECT() *MockPostCallHooksMockRecorder {
+	return m.recorder
+}
+
+// PostCallProcessing mocks base method.
+func (m *MockPostCallHooks) PostCallProcessing(ctx types.Context, msg core.Message, res *types6.MsgEthereumTxResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCallProcessing", ctx, msg, res)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostCallProcessing indicates an expected call of PostCallProcessing.
+func (mr *MockPostCallHooksMockRecorder) PostCallProcessing(ctx, msg, res any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCallProcessing", reflect.TypeOf((*MockPostCallHooks)(nil).PostCallProcessing), ctx, msg, res)
+}
+
+// MockPrioritizedEvmHooks is a mock of PrioritizedEvmHooks interface.
+type MockPrioritizedEvmHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrioritizedEvmHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockPrioritizedEvmHooksMockRecorder is the mock recorder for MockPrioritizedEvmHooks.
+type MockPrioritizedEvmHooksMockRecorder struct {
+	mock *MockPrioritizedEvmHooks
+}
+
+// NewMockPrioritizedEvmHooks creates a new mock instance.
+func NewMockPrioritizedEvmHooks(ctrl *gomock.Controller) *MockPrioritizedEvmHooks {
+	mock := &MockPrioritizedEvmHooks{ctrl: ctrl}
+	mock.recorder = &MockPrioritizedEvmHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrioritizedEvmHooks) EXPECT() *MockPrioritizedEvmHooksMockRecorder {
+	return m.recorder
+}
+
+// Priority mocks base method.
+func (m *MockPrioritizedEvmHooks) Priority() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Priority")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// Priority indicates an expected call of Priority.
+func (mr *MockPrioritizedEvmHooksMockRecorder) Priority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Priority", reflect.TypeOf((*MockPrioritizedEvmHooks)(nil).Priority))
+}
+
 // MockBankWrapper is a mock of BankWrapper interface.
 type MockBankWrapper struct {
 	ctrl     *gomock.Controller